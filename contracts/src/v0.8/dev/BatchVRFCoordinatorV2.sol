@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.0;
+
+import "./VRF.sol";
+import "./VRFCoordinatorV2.sol";
+import "../interfaces/TypeAndVersionInterface.sol";
+
+/**
+ * @title BatchVRFCoordinatorV2
+ * @notice The BatchVRFCoordinatorV2 contract fulfills several VRF v2 requests
+ * @notice in a single transaction, which saves the base transaction cost of
+ * @notice each fulfillment. Any fulfillment reverting reverts the whole batch,
+ * @notice so that the node can remove the offending proof and retry.
+ */
+contract BatchVRFCoordinatorV2 is TypeAndVersionInterface {
+  VRFCoordinatorV2 public immutable COORDINATOR;
+
+  error ArgumentLengthMismatch(uint256 proofs, uint256 commitments);
+
+  constructor(address coordinatorAddr) {
+    COORDINATOR = VRFCoordinatorV2(coordinatorAddr);
+  }
+
+  /**
+   * @notice fulfills the given requests, in order, through the coordinator
+   * @param proofs the randomness proofs generated by the VRF provider
+   * @param rcs the request commitments corresponding to the proofs
+   */
+  function fulfillRandomWords(VRF.Proof[] memory proofs, VRFCoordinatorV2.RequestCommitment[] memory rcs) external {
+    if (proofs.length != rcs.length) {
+      revert ArgumentLengthMismatch(proofs.length, rcs.length);
+    }
+    for (uint256 i = 0; i < proofs.length; i++) {
+      COORDINATOR.fulfillRandomWords(proofs[i], rcs[i]);
+    }
+  }
+
+  function typeAndVersion() external pure virtual override returns (string memory) {
+    return "BatchVRFCoordinatorV2 1.0.0";
+  }
+}
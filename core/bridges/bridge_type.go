@@ -21,6 +21,12 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// OutgoingToken is optional, a random token is generated if it is not
+	// given. It may be a secret:// reference, which is resolved on each run.
+	OutgoingToken string `json:"outgoingToken"`
+	// SendOutgoingToken opts the bridge in to receiving the outgoing token as
+	// an Authorization: Bearer header on each request.
+	SendOutgoingToken bool `json:"sendOutgoingToken"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -59,6 +65,7 @@ type BridgeType struct {
 	IncomingTokenHash      string
 	Salt                   string
 	OutgoingToken          string
+	SendOutgoingToken      bool
 	MinimumContractPayment *assets.Link
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
@@ -69,7 +76,10 @@ type BridgeType struct {
 func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 	*BridgeType, error) {
 	incomingToken := utils.NewSecret(24)
-	outgoingToken := utils.NewSecret(24)
+	outgoingToken := btr.OutgoingToken
+	if outgoingToken == "" {
+		outgoingToken = utils.NewSecret(24)
+	}
 	salt := utils.NewSecret(24)
 
 	hash, err := incomingTokenHash(incomingToken, salt)
@@ -91,6 +101,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			IncomingTokenHash:      hash,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
+			SendOutgoingToken:      btr.SendOutgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
 		}, nil
 }
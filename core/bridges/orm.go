@@ -80,8 +80,8 @@ func (o *orm) BridgeTypes(offset int, limit int) (bridges []BridgeType, count in
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, send_outgoing_token, minimum_contract_payment, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :send_outgoing_token, :minimum_contract_payment, now(), now())
 	RETURNING *;`
 	err := o.q.Transaction(func(tx pg.Queryer) error {
 		stmt, err := tx.PrepareNamed(stmt)
@@ -93,11 +93,12 @@ func (o *orm) CreateBridgeType(bt *BridgeType) error {
 	return errors.Wrap(err, "CreateBridgeType failed")
 }
 
-// UpdateBridgeType updates the bridge type.
+// UpdateBridgeType updates the bridge type. The outgoing token is only updated
+// if the request gives one.
 func (o *orm) UpdateBridgeType(bt *BridgeType,
 	btr *BridgeTypeRequest) error {
-	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	sql := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, outgoing_token = COALESCE(NULLIF($4, ''), outgoing_token), send_outgoing_token = $5 WHERE name = $6 RETURNING *"
+	return o.q.Get(bt, sql, btr.URL, btr.Confirmations, btr.MinimumContractPayment, btr.OutgoingToken, btr.SendOutgoingToken, bt.Name)
 }
 
 // --- External Initiator
@@ -11,6 +11,8 @@ import (
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/jackc/pgconn"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/sqlx"
@@ -20,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -35,7 +38,11 @@ const (
 	TransmitCheckTimeout = 2 * time.Second
 )
 
-var errEthTxRemoved = errors.New("eth_tx removed")
+var (
+	errEthTxRemoved = errors.New("eth_tx removed")
+
+	tracer = tracing.Tracer("bulletprooftxmanager")
+)
 
 // TransmitCheckerFactory creates a transmit checker based on a spec.
 type TransmitCheckerFactory interface {
@@ -361,11 +368,20 @@ func getInProgressEthTx(q pg.Q, fromAddress gethCommon.Address) (etx *EthTx, err
 
 // There can be at most one in_progress transaction per address.
 // Here we complete the job that we didn't finish last time.
-func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) error {
+func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt, initialBroadcastAt time.Time) (err error) {
 	if etx.State != EthTxInProgress {
 		return errors.Errorf("invariant violation: expected transaction %v to be in_progress, it was %s", etx.ID, etx.State)
 	}
-	parentCtx := context.TODO()
+	parentCtx, span := tracer.Start(etx.TraceContext(context.Background()), "EthBroadcaster.handleInProgressEthTx", trace.WithAttributes(
+		attribute.Int64("eth_tx.id", etx.ID),
+		attribute.String("eth_tx.from_address", etx.FromAddress.Hex()),
+		attribute.String("eth_tx_attempt.hash", attempt.Hash.Hex()),
+		attribute.String("evm.chain_id", eb.chainID.String()),
+	))
+	defer func() {
+		tracing.RecordError(span, err)
+		span.End()
+	}()
 
 	checkerSpec, err := etx.GetChecker()
 	if err != nil {
@@ -19,6 +19,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -28,6 +31,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
@@ -191,7 +195,15 @@ func (ec *EthConfirmer) ProcessHead(ctx context.Context, head *evmtypes.Head) er
 	ctx, cancel := context.WithTimeout(ctx, processHeadTimeout)
 	defer cancel()
 
-	return ec.processHead(ctx, head)
+	ctx, span := tracer.Start(ctx, "EthConfirmer.ProcessHead", trace.WithAttributes(
+		attribute.Int64("head.number", head.Number),
+		attribute.String("evm.chain_id", ec.chainID.String()),
+	))
+	defer span.End()
+
+	err := ec.processHead(ctx, head)
+	tracing.RecordError(span, err)
+	return err
 }
 
 // NOTE: This SHOULD NOT be run concurrently or it could behave badly
@@ -455,12 +467,37 @@ func (ec *EthConfirmer) batchFetchReceipts(ctx context.Context, attempts []EthTx
 			promNumSuccessfulTxs.WithLabelValues(ec.chainID.String()).Add(1)
 		}
 
+		ec.traceConfirmation(attempt, *receipt)
+
 		receipts = append(receipts, *receipt)
 	}
 
 	return
 }
 
+// traceConfirmation records a span covering the time between the transaction
+// first being broadcast and its receipt being fetched, attached to the trace
+// of the pipeline run that created it.
+func (ec *EthConfirmer) traceConfirmation(attempt EthTxAttempt, receipt evmtypes.Receipt) {
+	etx := attempt.EthTx
+	if etx.BroadcastAt == nil {
+		return
+	}
+	_, span := tracer.Start(etx.TraceContext(context.Background()), "EthConfirmer.awaitReceipt",
+		trace.WithTimestamp(*etx.BroadcastAt),
+		trace.WithAttributes(
+			attribute.Int64("eth_tx.id", etx.ID),
+			attribute.String("eth_tx_attempt.hash", attempt.Hash.Hex()),
+			attribute.Int64("receipt.block_number", receipt.BlockNumber.Int64()),
+			attribute.String("evm.chain_id", ec.chainID.String()),
+		),
+	)
+	if receipt.Status == 0 {
+		span.SetStatus(codes.Error, "transaction reverted on-chain")
+	}
+	span.End()
+}
+
 func (ec *EthConfirmer) saveFetchedReceipts(receipts []evmtypes.Receipt) (err error) {
 	if len(receipts) == 0 {
 		return nil
@@ -2,6 +2,7 @@ package bulletprooftxmanager
 
 import (
 	"bytes"
+	"context"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
@@ -18,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
 	cnull "github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/services/pg/datatypes"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -25,12 +27,19 @@ type EthTxMeta struct {
 	JobID         int32
 	RequestID     common.Hash
 	RequestTxHash common.Hash
+	// Used for VRFv2 batch fulfillments - the IDs of all the requests
+	// fulfilled by this tx
+	RequestIDs []common.Hash `json:",omitempty"`
 	// Used for the VRFv2 - max link this tx will bill
 	// should it get bumped
 	MaxLink string
 	// Used for the VRFv2 - the subscription ID of the
 	// requester of the VRF.
 	SubID uint64 `json:"SubId"`
+	// Used for tracing - the serialized trace context of the pipeline run
+	// that created this transaction, so that broadcast and confirmation
+	// spans can be attached to the same trace.
+	TraceContext map[string]string `json:",omitempty"`
 }
 
 // TransmitCheckerSpec defines the check that should be performed before a transaction is submitted
@@ -188,6 +197,16 @@ func (e EthTx) GetMeta() (*EthTxMeta, error) {
 	return &m, errors.Wrap(json.Unmarshal(*e.Meta, &m), "unmarshalling meta")
 }
 
+// TraceContext returns ctx carrying the trace context of the pipeline run that
+// created this EthTx, if one was recorded in its meta.
+func (e EthTx) TraceContext(ctx context.Context) context.Context {
+	meta, err := e.GetMeta()
+	if err != nil || meta == nil {
+		return ctx
+	}
+	return tracing.ContextFromCarrier(ctx, meta.TraceContext)
+}
+
 // GetChecker returns an EthTx's transmit checker spec in struct form, unmarshalling it from JSON
 // first.
 func (e EthTx) GetChecker() (TransmitCheckerSpec, error) {
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/url"
 	"sync"
+	"time"
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -13,10 +14,15 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 )
 
+var tracer = tracing.Tracer("evmclient")
+
 //go:generate mockery --name Node --output ../mocks/ --case=underscore
 type Node interface {
 	Dial(ctx context.Context) error
@@ -65,6 +71,25 @@ const (
 	NodeStateClosed
 )
 
+func (s NodeState) String() string {
+	switch s {
+	case NodeStateUndialed:
+		return "Undialed"
+	case NodeStateDialed:
+		return "Dialed"
+	case NodeStateInvalidChainID:
+		return "InvalidChainID"
+	case NodeStateAlive:
+		return "Alive"
+	case NodeStateDead:
+		return "Dead"
+	case NodeStateClosed:
+		return "Closed"
+	default:
+		return fmt.Sprintf("NodeState(%d)", s)
+	}
+}
+
 // Node represents one ethereum node.
 // It must have a ws url and may have a http url
 type node struct {
@@ -116,7 +141,7 @@ func (n *node) Dial(ctx context.Context) error {
 	uri := n.ws.uri.String()
 	wsrpc, err := rpc.DialWebsocket(ctx, uri, "")
 	if err != nil {
-		n.state = NodeStateDead
+		n.setState(NodeStateDead)
 		return errors.Wrapf(err, "error while dialing websocket: %v", uri)
 	}
 
@@ -125,12 +150,12 @@ func (n *node) Dial(ctx context.Context) error {
 		uri := n.http.uri.String()
 		httprpc, err = rpc.DialHTTP(uri)
 		if err != nil {
-			n.state = NodeStateDead
+			n.setState(NodeStateDead)
 			return errors.Wrapf(err, "error while dialing HTTP: %v", uri)
 		}
 	}
 
-	n.state = NodeStateDialed
+	n.setState(NodeStateDialed)
 	n.ws.rpc = wsrpc
 	n.ws.geth = ethclient.NewClient(wsrpc)
 
@@ -145,7 +170,7 @@ func (n *node) Dial(ctx context.Context) error {
 func (n *node) Close() {
 	n.mu.Lock()
 	defer n.mu.Unlock()
-	n.state = NodeStateClosed
+	n.setState(NodeStateClosed)
 	if n.ws.rpc != nil {
 		n.ws.rpc.Close()
 	}
@@ -167,10 +192,10 @@ func (n *node) Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 
 	var chainID *big.Int
 	if chainID, err = n.ws.geth.ChainID(ctx); err != nil {
-		n.state = NodeStateInvalidChainID
+		n.setState(NodeStateInvalidChainID)
 		return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
 	} else if chainID.Cmp(expectedChainID) != 0 {
-		n.state = NodeStateInvalidChainID
+		n.setState(NodeStateInvalidChainID)
 		return errors.Errorf(
 			"websocket rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
 			chainID.String(),
@@ -180,10 +205,10 @@ func (n *node) Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 	}
 	if n.http != nil {
 		if chainID, err = n.http.geth.ChainID(ctx); err != nil {
-			n.state = NodeStateInvalidChainID
+			n.setState(NodeStateInvalidChainID)
 			return errors.Wrapf(err, "failed to verify chain ID for node %s", n.name)
 		} else if chainID.Cmp(expectedChainID) != 0 {
-			n.state = NodeStateInvalidChainID
+			n.setState(NodeStateInvalidChainID)
 			return errors.Errorf(
 				"http rpc ChainID doesn't match local chain ID: RPC ID=%s, local ID=%s, node name=%s",
 				chainID.String(),
@@ -192,7 +217,7 @@ func (n *node) Verify(ctx context.Context, expectedChainID *big.Int) (err error)
 			)
 		}
 	}
-	n.state = NodeStateAlive
+	n.setState(NodeStateAlive)
 	return nil
 }
 
@@ -202,6 +227,16 @@ func (n *node) State() NodeState {
 	return n.state
 }
 
+// setState must be called with n.mu held
+func (n *node) setState(state NodeState) {
+	if n.state == state {
+		return
+	}
+	change := NodeStateChange{Name: n.name, From: n.state, To: state, At: time.Now()}
+	n.state = state
+	nodeStateObservers.notify(change)
+}
+
 // RPC wrappers
 
 // TODO: Handle state below
@@ -210,6 +245,8 @@ func (n *node) State() NodeState {
 func (n *node) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "CallContext", attribute.String("rpc.method", method))
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#Call(...)",
 		"method", method,
@@ -217,28 +254,32 @@ func (n *node) CallContext(ctx context.Context, result interface{}, method strin
 		"mode", switching(n),
 	)
 	if n.http != nil {
-		return n.wrapHTTP(n.http.rpc.CallContext(ctx, result, method, args...))
+		return n.wrapHTTP(ctx, n.http.rpc.CallContext(ctx, result, method, args...))
 	}
-	return n.wrapWS(n.ws.rpc.CallContext(ctx, result, method, args...))
+	return n.wrapWS(ctx, n.ws.rpc.CallContext(ctx, result, method, args...))
 }
 
 func (n *node) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "BatchCallContext")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#BatchCall(...)",
 		"nBatchElems", len(b),
 		"mode", switching(n),
 	)
 	if n.http != nil {
-		return n.wrapHTTP(n.http.rpc.BatchCallContext(ctx, b))
+		return n.wrapHTTP(ctx, n.http.rpc.BatchCallContext(ctx, b))
 	}
-	return n.wrapWS(n.ws.rpc.BatchCallContext(ctx, b))
+	return n.wrapWS(ctx, n.ws.rpc.BatchCallContext(ctx, b))
 }
 
 func (n *node) EthSubscribe(ctx context.Context, channel interface{}, args ...interface{}) (ethereum.Subscription, error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "EthSubscribe")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#EthSubscribe", "mode", "websocket")
 	return n.ws.rpc.EthSubscribe(ctx, channel, args...)
@@ -249,6 +290,8 @@ func (n *node) EthSubscribe(ctx context.Context, channel interface{}, args ...in
 func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (receipt *types.Receipt, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "TransactionReceipt")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#TransactionReceipt(...)",
 		"txHash", txHash,
@@ -257,10 +300,10 @@ func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (rece
 
 	if n.http != nil {
 		receipt, err = n.http.geth.TransactionReceipt(ctx, txHash)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		receipt, err = n.ws.geth.TransactionReceipt(ctx, txHash)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 
 	return
@@ -269,6 +312,8 @@ func (n *node) TransactionReceipt(ctx context.Context, txHash common.Hash) (rece
 func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *types.Header, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "HeaderByNumber")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#HeaderByNumber(...)",
 		"number", n,
@@ -276,10 +321,10 @@ func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *typ
 	)
 	if n.http != nil {
 		header, err = n.http.geth.HeaderByNumber(ctx, number)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		header, err = n.ws.geth.HeaderByNumber(ctx, number)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -287,20 +332,24 @@ func (n *node) HeaderByNumber(ctx context.Context, number *big.Int) (header *typ
 func (n *node) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "SendTransaction")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#SendTransaction(...)",
 		"tx", tx,
 		"mode", switching(n),
 	)
 	if n.http != nil {
-		return n.wrapHTTP(n.http.geth.SendTransaction(ctx, tx))
+		return n.wrapHTTP(ctx, n.http.geth.SendTransaction(ctx, tx))
 	}
-	return n.wrapWS(n.ws.geth.SendTransaction(ctx, tx))
+	return n.wrapWS(ctx, n.ws.geth.SendTransaction(ctx, tx))
 }
 
 func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonce uint64, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "PendingNonceAt")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#PendingNonceAt(...)",
 		"account", account,
@@ -308,10 +357,10 @@ func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonc
 	)
 	if n.http != nil {
 		nonce, err = n.http.geth.PendingNonceAt(ctx, account)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		nonce, err = n.ws.geth.PendingNonceAt(ctx, account)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -319,6 +368,8 @@ func (n *node) PendingNonceAt(ctx context.Context, account common.Address) (nonc
 func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (nonce uint64, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "NonceAt")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#NonceAt(...)",
 		"account", account,
@@ -327,10 +378,10 @@ func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber
 	)
 	if n.http != nil {
 		nonce, err = n.http.geth.NonceAt(ctx, account, blockNumber)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		nonce, err = n.ws.geth.NonceAt(ctx, account, blockNumber)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -338,6 +389,8 @@ func (n *node) NonceAt(ctx context.Context, account common.Address, blockNumber
 func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code []byte, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "PendingCodeAt")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#PendingCodeAt(...)",
 		"account", account,
@@ -345,10 +398,10 @@ func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code
 	)
 	if n.http != nil {
 		code, err = n.http.geth.PendingCodeAt(ctx, account)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		code, err = n.ws.geth.PendingCodeAt(ctx, account)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -356,6 +409,8 @@ func (n *node) PendingCodeAt(ctx context.Context, account common.Address) (code
 func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) (code []byte, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "CodeAt")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#CodeAt(...)",
 		"account", account,
@@ -364,10 +419,10 @@ func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *
 	)
 	if n.http != nil {
 		code, err = n.http.geth.CodeAt(ctx, account, blockNumber)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		code, err = n.ws.geth.CodeAt(ctx, account, blockNumber)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -375,6 +430,8 @@ func (n *node) CodeAt(ctx context.Context, account common.Address, blockNumber *
 func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint64, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "EstimateGas")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#EstimateGas(...)",
 		"call", call,
@@ -382,10 +439,10 @@ func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint
 	)
 	if n.http != nil {
 		gas, err = n.http.geth.EstimateGas(ctx, call)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		gas, err = n.ws.geth.EstimateGas(ctx, call)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -393,26 +450,30 @@ func (n *node) EstimateGas(ctx context.Context, call ethereum.CallMsg) (gas uint
 func (n *node) SuggestGasPrice(ctx context.Context) (price *big.Int, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "SuggestGasPrice")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#SuggestGasPrice()", "mode", "websocket")
 	price, err = n.ws.geth.SuggestGasPrice(ctx)
-	err = n.wrapWS(err)
+	err = n.wrapWS(ctx, err)
 	return
 }
 
 func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) (val []byte, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "CallContract")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#CallContract()",
 		"mode", switching(n),
 	)
 	if n.http != nil {
 		val, err = n.http.geth.CallContract(ctx, msg, blockNumber)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		val, err = n.ws.geth.CallContract(ctx, msg, blockNumber)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 
@@ -421,6 +482,8 @@ func (n *node) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumb
 func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Block, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "BlockByNumber")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#BlockByNumber(...)",
 		"number", number,
@@ -428,10 +491,10 @@ func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Blo
 	)
 	if n.http != nil {
 		b, err = n.http.geth.BlockByNumber(ctx, number)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		b, err = n.ws.geth.BlockByNumber(ctx, number)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -439,6 +502,8 @@ func (n *node) BlockByNumber(ctx context.Context, number *big.Int) (b *types.Blo
 func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (balance *big.Int, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "BalanceAt")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#BalanceAt(...)",
 		"account", account,
@@ -447,10 +512,10 @@ func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumbe
 	)
 	if n.http != nil {
 		balance, err = n.http.geth.BalanceAt(ctx, account, blockNumber)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		balance, err = n.ws.geth.BalanceAt(ctx, account, blockNumber)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -458,6 +523,8 @@ func (n *node) BalanceAt(ctx context.Context, account common.Address, blockNumbe
 func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []types.Log, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "FilterLogs")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#FilterLogs(...)",
 		"q", q,
@@ -465,10 +532,10 @@ func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []type
 	)
 	if n.http != nil {
 		l, err = n.http.geth.FilterLogs(ctx, q)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		l, err = n.ws.geth.FilterLogs(ctx, q)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -476,26 +543,30 @@ func (n *node) FilterLogs(ctx context.Context, q ethereum.FilterQuery) (l []type
 func (n *node) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (sub ethereum.Subscription, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "SubscribeFilterLogs")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#SubscribeFilterLogs(...)", "q", q, "mode", "websocket")
 	sub, err = n.ws.geth.SubscribeFilterLogs(ctx, q, ch)
-	err = n.wrapWS(err)
+	err = n.wrapWS(ctx, err)
 	return
 }
 
 func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "SuggestGasTipCap")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#SuggestGasTipCap(...)",
 		"mode", switching(n),
 	)
 	if n.http != nil {
 		tipCap, err = n.http.geth.SuggestGasTipCap(ctx)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		tipCap, err = n.ws.geth.SuggestGasTipCap(ctx)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
@@ -503,20 +574,23 @@ func (n *node) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error
 func (n *node) ChainID(ctx context.Context) (chainID *big.Int, err error) {
 	ctx, cancel := DefaultQueryCtx(ctx)
 	defer cancel()
+	ctx, span := n.newRPCSpan(ctx, "ChainID")
+	defer span.End()
 
 	n.log.Debugw("evmclient.Client#ChainID(...)")
 	if n.http != nil {
 		chainID, err = n.http.geth.ChainID(ctx)
-		err = n.wrapHTTP(err)
+		err = n.wrapHTTP(ctx, err)
 	} else {
 		chainID, err = n.ws.geth.ChainID(ctx)
-		err = n.wrapWS(err)
+		err = n.wrapWS(ctx, err)
 	}
 	return
 }
 
-func (n *node) wrapWS(err error) error {
+func (n *node) wrapWS(ctx context.Context, err error) error {
 	err = wrap(err, fmt.Sprintf("primary websocket (%s)", n.ws.uri.String()))
+	tracing.RecordError(trace.SpanFromContext(ctx), err)
 	if err != nil {
 		n.log.Debugw("Call failed", "err", err)
 	} else {
@@ -525,8 +599,9 @@ func (n *node) wrapWS(err error) error {
 	return err
 }
 
-func (n *node) wrapHTTP(err error) error {
+func (n *node) wrapHTTP(ctx context.Context, err error) error {
 	err = wrap(err, fmt.Sprintf("primary http (%s)", n.http.uri.String()))
+	tracing.RecordError(trace.SpanFromContext(ctx), err)
 	if err != nil {
 		n.log.Debugw("Call failed", "err", err)
 	} else {
@@ -535,6 +610,15 @@ func (n *node) wrapHTTP(err error) error {
 	return err
 }
 
+// newRPCSpan starts a client span for a call to this node. The caller must end it.
+func (n *node) newRPCSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs,
+		attribute.String("rpc.system", "jsonrpc"),
+		attribute.String("evm.node", n.name),
+	)
+	return tracer.Start(ctx, "evmclient.Client#"+name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
 func wrap(err error, tp string) error {
 	if err == nil {
 		return nil
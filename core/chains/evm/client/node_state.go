@@ -0,0 +1,51 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// NodeStateChange describes the transition of a node from one state to another
+type NodeStateChange struct {
+	Name string
+	From NodeState
+	To   NodeState
+	At   time.Time
+}
+
+var nodeStateObservers = &stateObservers{fns: make(map[int]func(NodeStateChange))}
+
+type stateObservers struct {
+	mu     sync.RWMutex
+	fns    map[int]func(NodeStateChange)
+	nextID int
+}
+
+// OnNodeStateChange registers fn to be called whenever any node, on any chain,
+// changes state. The returned function unregisters it. Callbacks are invoked
+// synchronously while the node is locked, so they must not block or call back
+// into the node.
+func OnNodeStateChange(fn func(NodeStateChange)) (unregister func()) {
+	return nodeStateObservers.add(fn)
+}
+
+func (o *stateObservers) add(fn func(NodeStateChange)) func() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	id := o.nextID
+	o.nextID++
+	o.fns[id] = fn
+	return func() {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		delete(o.fns, id)
+	}
+}
+
+func (o *stateObservers) notify(change NodeStateChange) {
+	o.mu.RLock()
+	defer o.mu.RUnlock()
+	for _, fn := range o.fns {
+		fn(change)
+	}
+}
@@ -97,3 +97,24 @@ func Test_NodeStateTransitions(t *testing.T) {
 		assert.Equal(t, evmclient.NodeStateClosed, nValid.State())
 	})
 }
+
+func Test_OnNodeStateChange(t *testing.T) {
+	var changes []evmclient.NodeStateChange
+	unregister := evmclient.OnNodeStateChange(func(change evmclient.NodeStateChange) {
+		if change.Name == "observed node" {
+			changes = append(changes, change)
+		}
+	})
+
+	n := evmclient.NewNode(logger.TestLogger(t), *cltest.MustParseURL(t, "ws://example.invalid"), nil, "observed node")
+	require.Error(t, n.Dial(context.Background()))
+	n.Close()
+	unregister()
+
+	require.Len(t, changes, 2)
+	assert.Equal(t, evmclient.NodeStateUndialed, changes[0].From)
+	assert.Equal(t, evmclient.NodeStateDead, changes[0].To)
+	assert.Equal(t, evmclient.NodeStateDead, changes[1].From)
+	assert.Equal(t, evmclient.NodeStateClosed, changes[1].To)
+	assert.Equal(t, "Closed", changes[1].To.String())
+}
@@ -13,6 +13,8 @@ import (
 
 	commontypes "github.com/smartcontractkit/libocr/commontypes"
 
+	configfile "github.com/smartcontractkit/chainlink/core/config/configfile"
+
 	coreconfig "github.com/smartcontractkit/chainlink/core/config"
 
 	dialects "github.com/smartcontractkit/chainlink/core/store/dialects"
@@ -29,6 +31,8 @@ import (
 
 	p2pkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 
+	secrets "github.com/smartcontractkit/chainlink/core/secrets"
+
 	sessions "github.com/gin-gonic/contrib/sessions"
 
 	time "time"
@@ -91,6 +95,76 @@ func (_m *ChainScopedConfig) AdvisoryLockID() int64 {
 	return r0
 }
 
+// AlertsChannels provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsChannels() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AlertsCheckInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AlertsRepeatInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsRepeatInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsRules provides a mock function with given fields:
+func (_m *ChainScopedConfig) AlertsRules() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AllowOrigins provides a mock function with given fields:
 func (_m *ChainScopedConfig) AllowOrigins() string {
 	ret := _m.Called()
@@ -517,6 +591,20 @@ func (_m *ChainScopedConfig) ClientNodeURL() string {
 	return r0
 }
 
+// ConfigFile provides a mock function with given fields:
+func (_m *ChainScopedConfig) ConfigFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // Configure provides a mock function with given fields: _a0
 func (_m *ChainScopedConfig) Configure(_a0 types.ChainCfg) error {
 	ret := _m.Called(_a0)
@@ -773,6 +861,22 @@ func (_m *ChainScopedConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EffectiveConfigFile provides a mock function with given fields:
+func (_m *ChainScopedConfig) EffectiveConfigFile() *configfile.File {
+	ret := _m.Called()
+
+	var r0 *configfile.File
+	if rf, ok := ret.Get(0).(func() *configfile.File); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*configfile.File)
+		}
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -2412,6 +2516,20 @@ func (_m *ChainScopedConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineArchiveDir provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineArchiveDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -2426,6 +2544,48 @@ func (_m *ChainScopedConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineMetricsMaxBridges provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineMetricsMaxBridges() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineMetricsMaxJobs provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineMetricsMaxJobs() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineReaperErroredThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineReaperErroredThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -2468,6 +2628,20 @@ func (_m *ChainScopedConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
+// JobPipelineRetentionPolicies provides a mock function with given fields:
+func (_m *ChainScopedConfig) JobPipelineRetentionPolicies() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
 func (_m *ChainScopedConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	ret := _m.Called()
@@ -2666,6 +2840,22 @@ func (_m *ChainScopedConfig) LinkContractAddress() string {
 	return r0
 }
 
+// LoadedConfigFile provides a mock function with given fields:
+func (_m *ChainScopedConfig) LoadedConfigFile() *configfile.File {
+	ret := _m.Called()
+
+	var r0 *configfile.File
+	if rf, ok := ret.Get(0).(func() *configfile.File); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*configfile.File)
+		}
+	}
+
+	return r0
+}
+
 // LogFileDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) LogFileDir() string {
 	ret := _m.Called()
@@ -3662,6 +3852,20 @@ func (_m *ChainScopedConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// RelayPlugins provides a mock function with given fields:
+func (_m *ChainScopedConfig) RelayPlugins() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *ChainScopedConfig) RootDir() string {
 	ret := _m.Called()
@@ -3676,6 +3880,80 @@ func (_m *ChainScopedConfig) RootDir() string {
 	return r0
 }
 
+// SecretsCacheTTL provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// SecretsFileDir provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsFileDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsResolver provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsResolver() secrets.Resolver {
+	ret := _m.Called()
+
+	var r0 secrets.Resolver
+	if rf, ok := ret.Get(0).(func() secrets.Resolver); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(secrets.Resolver)
+		}
+	}
+
+	return r0
+}
+
+// SecretsVaultToken provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsVaultToken() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsVaultURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) SecretsVaultURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // SecureCookies provides a mock function with given fields:
 func (_m *ChainScopedConfig) SecureCookies() bool {
 	ret := _m.Called()
@@ -3802,6 +4080,34 @@ func (_m *ChainScopedConfig) SolanaEnabled() bool {
 	return r0
 }
 
+// StreamMaxStaleness provides a mock function with given fields:
+func (_m *ChainScopedConfig) StreamMaxStaleness() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// StreamSources provides a mock function with given fields:
+func (_m *ChainScopedConfig) StreamSources() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TLSCertPath provides a mock function with given fields:
 func (_m *ChainScopedConfig) TLSCertPath() string {
 	ret := _m.Called()
@@ -4000,6 +4306,90 @@ func (_m *ChainScopedConfig) TerraEnabled() bool {
 	return r0
 }
 
+// TerraGasBumpPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) TerraGasBumpPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// TerraMaxBroadcastAttempts provides a mock function with given fields:
+func (_m *ChainScopedConfig) TerraMaxBroadcastAttempts() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// TerraMaxGasPriceULuna provides a mock function with given fields:
+func (_m *ChainScopedConfig) TerraMaxGasPriceULuna() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingFilePath provides a mock function with given fields:
+func (_m *ChainScopedConfig) TracingFilePath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -0,0 +1,65 @@
+package evm
+
+import (
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/sqlx"
+)
+
+// ClobberDBFromConfigFile inserts or updates the chains declared by the config
+// file. Chains which declare nodes have all of their other nodes deleted.
+// Chains and nodes which are absent from the file are left untouched.
+func ClobberDBFromConfigFile(db *sqlx.DB, f *configfile.File, lggr logger.Logger) error {
+	if f == nil || len(f.EVM) == 0 {
+		return nil
+	}
+
+	return pg.SqlxTransactionWithDefaultCtx(db, lggr, func(tx pg.Queryer) error {
+		for _, chain := range f.EVM {
+			id, err := chain.ID()
+			if err != nil {
+				return err
+			}
+			cfg, err := chain.ChainCfg()
+			if err != nil {
+				return errors.Wrapf(err, "invalid config for evm chain %s", id)
+			}
+			lggr.Debugw("Config file declares chain, inserting/updating it", "evmChainID", id.String(), "enabled", chain.IsEnabled(), "nodes", len(chain.Nodes))
+
+			if _, err = tx.Exec(`INSERT INTO evm_chains (id, cfg, enabled, created_at, updated_at) VALUES ($1, $2, $3, NOW(), NOW())
+ON CONFLICT (id) DO UPDATE SET cfg = EXCLUDED.cfg, enabled = EXCLUDED.enabled, updated_at = NOW()`, id, cfg, chain.IsEnabled()); err != nil {
+				return errors.Wrapf(err, "failed to upsert evm chain %s", id)
+			}
+
+			if len(chain.Nodes) == 0 {
+				continue
+			}
+			names := make([]string, len(chain.Nodes))
+			for i, node := range chain.Nodes {
+				names[i] = strings.ToLower(node.Name)
+			}
+			if _, err = tx.Exec(`DELETE FROM evm_nodes WHERE evm_chain_id = $1 AND lower(name) != ALL($2)`, id, pq.Array(names)); err != nil {
+				return errors.Wrapf(err, "failed to delete nodes of evm chain %s", id)
+			}
+
+			// Node names are unique across chains, so a node may move between them
+			stmt := `INSERT INTO evm_nodes (name, evm_chain_id, ws_url, http_url, send_only, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+ON CONFLICT (lower(name)) DO UPDATE SET name = EXCLUDED.name, evm_chain_id = EXCLUDED.evm_chain_id, ws_url = EXCLUDED.ws_url, http_url = EXCLUDED.http_url, send_only = EXCLUDED.send_only, updated_at = NOW()`
+			for _, node := range chain.Nodes {
+				wsURL := null.NewString(node.WSURL, node.WSURL != "")
+				httpURL := null.NewString(node.HTTPURL, node.HTTPURL != "")
+				if _, err = tx.Exec(stmt, node.Name, id, wsURL, httpURL, node.SendOnly); err != nil {
+					return errors.Wrapf(err, "failed to upsert node %s", node.Name)
+				}
+			}
+		}
+		return nil
+	})
+}
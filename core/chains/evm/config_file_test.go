@@ -0,0 +1,78 @@
+package evm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func Test_ClobberDBFromConfigFile(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	var fixtureChains int64 = 2
+	var fixtureNodes int64 = 1
+
+	f, err := configfile.Parse([]byte(`
+[[EVM]]
+ChainID = "1337"
+
+[EVM.Config]
+MinIncomingConfirmations = 5
+
+[[EVM.Nodes]]
+Name = "eth-test-ws-only-0"
+WSURL = "ws://moved.example"
+
+[[EVM.Nodes]]
+Name = "sendonly-1337"
+HTTPURL = "http://sendonly.example"
+SendOnly = true
+
+[[EVM]]
+ChainID = "42"
+Enabled = false
+`))
+	require.NoError(t, err)
+
+	require.NoError(t, evm.ClobberDBFromConfigFile(db, f, logger.TestLogger(t)))
+
+	cltest.AssertCount(t, db, "evm_chains", fixtureChains+1)
+	cltest.AssertCount(t, db, "evm_nodes", fixtureNodes+1)
+
+	var chain evmtypes.Chain
+	require.NoError(t, db.Get(&chain, `SELECT * FROM evm_chains WHERE id = 1337`))
+	// Chains are enabled unless the file says otherwise
+	assert.True(t, chain.Enabled)
+	assert.Equal(t, null.IntFrom(5), chain.Cfg.MinIncomingConfirmations)
+
+	require.NoError(t, db.Get(&chain, `SELECT * FROM evm_chains WHERE id = 42`))
+	assert.False(t, chain.Enabled)
+
+	var nodes []evmtypes.Node
+	require.NoError(t, db.Select(&nodes, `SELECT * FROM evm_nodes ORDER BY name`))
+	require.Len(t, nodes, 2)
+
+	// The fixture node moved to the declaring chain
+	assert.Equal(t, "eth-test-ws-only-0", nodes[0].Name)
+	assert.Equal(t, "1337", nodes[0].EVMChainID.String())
+	assert.Equal(t, null.StringFrom("ws://moved.example"), nodes[0].WSURL)
+	assert.False(t, nodes[0].HTTPURL.Valid)
+
+	assert.Equal(t, "sendonly-1337", nodes[1].Name)
+	assert.True(t, nodes[1].SendOnly)
+	assert.False(t, nodes[1].WSURL.Valid)
+	assert.Equal(t, null.StringFrom("http://sendonly.example"), nodes[1].HTTPURL)
+
+	// Nodes missing from a chain which declares nodes are deleted
+	f.EVM[0].Nodes = f.EVM[0].Nodes[1:]
+	require.NoError(t, evm.ClobberDBFromConfigFile(db, f, logger.TestLogger(t)))
+	cltest.AssertCount(t, db, "evm_nodes", 1)
+}
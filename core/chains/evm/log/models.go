@@ -1,11 +1,13 @@
 package log
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/trace"
 )
 
 //go:generate mockery --name Broadcast --output ./mocks/ --case=underscore --structname Broadcast --filename broadcast.go
@@ -31,6 +33,7 @@ type (
 		rawLog            types.Log
 		jobID             int32
 		evmChainID        big.Int
+		spanContext       trace.SpanContext
 	}
 )
 
@@ -66,6 +69,15 @@ func (b *broadcast) EVMChainID() big.Int {
 	return b.evmChainID
 }
 
+// TraceContext returns ctx carrying the span under which b was delivered, so
+// that work done by the listener in response can be attached to the same trace.
+func TraceContext(ctx context.Context, b Broadcast) context.Context {
+	if bc, ok := b.(*broadcast); ok && bc.spanContext.IsValid() {
+		return trace.ContextWithSpanContext(ctx, bc.spanContext)
+	}
+	return ctx
+}
+
 func NewLogBroadcast(rawLog types.Log, evmChainID big.Int, decodedLog interface{}) Broadcast {
 	return &broadcast{
 		latestBlockNumber: 0,
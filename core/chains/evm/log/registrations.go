@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"fmt"
 	"math/big"
 	"sync"
@@ -8,14 +9,19 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 )
 
+var tracer = tracing.Tracer("log")
+
 // 1. Each listener being registered can specify a custom NumConfirmations - number of block confirmations required for any log being sent to it.
 //
 // 2. All received logs are kept in an array and deleted ONLY after they are outside the confirmation range for all subscribers
@@ -444,6 +450,16 @@ func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
+			_, span := tracer.Start(context.Background(), "LogBroadcaster.deliver", trace.WithAttributes(
+				attribute.Int64("job.id", int64(jobID)),
+				attribute.Int64("log.block_number", int64(logCopy.BlockNumber)),
+				attribute.String("log.block_hash", logCopy.BlockHash.Hex()),
+				attribute.String("log.tx_hash", logCopy.TxHash.Hex()),
+				attribute.String("log.address", logCopy.Address.Hex()),
+				attribute.Int64("head.number", int64(latestBlockNumber)),
+				attribute.String("evm.chain_id", r.evmChainID.String()),
+			))
+			defer span.End()
 			handleLog(&broadcast{
 				latestBlockNumber,
 				latestHead.Hash,
@@ -451,6 +467,7 @@ func (r *handler) sendLog(log types.Log, latestHead evmtypes.Head,
 				logCopy,
 				jobID,
 				r.evmChainID,
+				span.SpanContext(),
 			})
 		}()
 	}
@@ -46,7 +46,7 @@ type chain struct {
 }
 
 // NewChain returns a new chain backed by node.
-func NewChain(db *sqlx.DB, ks keystore.Terra, logCfg pg.LogConfig, eb pg.EventBroadcaster, dbchain db.Chain, orm types.ORM, lggr logger.Logger) (*chain, error) {
+func NewChain(db *sqlx.DB, ks keystore.Terra, txmCfg terratxm.Config, eb pg.EventBroadcaster, dbchain db.Chain, orm types.ORM, lggr logger.Logger) (*chain, error) {
 	cfg := terra.NewConfig(dbchain.Cfg, lggr)
 	lggr = lggr.With("terraChainID", dbchain.ID)
 	var ch = chain{
@@ -67,7 +67,7 @@ func NewChain(db *sqlx.DB, ks keystore.Terra, logCfg pg.LogConfig, eb pg.EventBr
 			}, nil
 		}),
 	}, lggr)
-	ch.txm = terratxm.NewTxm(db, tc, *gpe, dbchain.ID, cfg, ks, lggr, logCfg, eb)
+	ch.txm = terratxm.NewTxm(db, tc, *gpe, dbchain.ID, cfg, ks, lggr, txmCfg, eb)
 
 	return &ch, nil
 }
@@ -0,0 +1,48 @@
+package terratxm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// defaultMaxGasPriceULuna is used if TerraMaxGasPriceULuna is invalid.
+var defaultMaxGasPriceULuna = sdk.MustNewDecFromStr("0.15")
+
+// Config is the node wide configuration of the Txm.
+type Config interface {
+	pg.LogConfig
+	TerraGasBumpPercent() uint16
+	TerraMaxBroadcastAttempts() uint32
+	TerraMaxGasPriceULuna() string
+}
+
+// maxGasPrice returns the uluna gas price cap, falling back to the default if
+// the configured value is invalid.
+func maxGasPrice(cfg Config, lggr logger.Logger) sdk.Dec {
+	str := cfg.TerraMaxGasPriceULuna()
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil || !dec.IsPositive() {
+		lggr.Warnw("invalid TerraMaxGasPriceULuna, falling back to default", "value", str, "default", defaultMaxGasPriceULuna, "err", err)
+		return defaultMaxGasPriceULuna
+	}
+	return dec
+}
+
+// bumpGasPrice returns the gas price at which msgs previously broadcast at
+// the given prices are rebroadcast: the higher of the estimated price and the
+// highest previous price bumped by bumpPercent, capped at maxPrice.
+func bumpGasPrice(estimated sdk.Dec, previous []sdk.Dec, bumpPercent uint16, maxPrice sdk.Dec) sdk.Dec {
+	price := estimated
+	multiplier := sdk.NewDec(100 + int64(bumpPercent)).QuoInt64(100)
+	for _, prev := range previous {
+		if bumped := prev.Mul(multiplier); bumped.GT(price) {
+			price = bumped
+		}
+	}
+	if price.GT(maxPrice) {
+		return maxPrice
+	}
+	return price
+}
@@ -3,7 +3,10 @@ package terratxm
 import (
 	"database/sql"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink-terra/pkg/terra"
 	"github.com/smartcontractkit/chainlink-terra/pkg/terra/db"
@@ -13,6 +16,21 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 )
 
+// msgColumns are the columns of terra_msgs which make up a db.Msg
+const msgColumns = `id, terra_chain_id, contract_id, state, raw, tx_hash, created_at, updated_at`
+
+// QueuedMsg is a terra msg along with its broadcast history.
+type QueuedMsg struct {
+	db.Msg
+	// BroadcastCount is the number of times the msg was broadcast
+	BroadcastCount int
+	// GasPrice is the uluna gas price at which the msg was last broadcast
+	GasPrice null.String
+	// TimeoutHeight is the height after which the tx the msg was last
+	// broadcast in can no longer be included
+	TimeoutHeight null.Int
+}
+
 // ORM manages the data model for terra tx management.
 type ORM struct {
 	chainID string
@@ -32,7 +50,7 @@ func NewORM(chainID string, db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) *
 // InsertMsg inserts a terra msg, assumed to be a serialized terra ExecuteContractMsg.
 func (o *ORM) InsertMsg(contractID string, msg []byte) (int64, error) {
 	var tm terra.Msg
-	err := o.q.Get(&tm, `INSERT INTO terra_msgs (contract_id, raw, state, terra_chain_id, created_at, updated_at) VALUES ($1, $2, $3, $4, NOW(), NOW()) RETURNING *`, contractID, msg, db.Unstarted, o.chainID)
+	err := o.q.Get(&tm, `INSERT INTO terra_msgs (contract_id, raw, state, terra_chain_id, created_at, updated_at) VALUES ($1, $2, $3, $4, NOW(), NOW()) RETURNING `+msgColumns, contractID, msg, db.Unstarted, o.chainID)
 	if err != nil {
 		return 0, err
 	}
@@ -45,7 +63,7 @@ func (o *ORM) SelectMsgsWithState(state db.State, limit int64) (terra.Msgs, erro
 		return terra.Msgs{}, errors.New("limit must be greater than 0")
 	}
 	var msgs terra.Msgs
-	if err := o.q.Select(&msgs, `SELECT * FROM terra_msgs WHERE state = $1 AND terra_chain_id = $2 ORDER BY created_at LIMIT $3`, state, o.chainID, limit); err != nil {
+	if err := o.q.Select(&msgs, `SELECT `+msgColumns+` FROM terra_msgs WHERE state = $1 AND terra_chain_id = $2 ORDER BY created_at LIMIT $3`, state, o.chainID, limit); err != nil {
 		return nil, err
 	}
 	return msgs, nil
@@ -54,12 +72,38 @@ func (o *ORM) SelectMsgsWithState(state db.State, limit int64) (terra.Msgs, erro
 // SelectMsgsWithIDs selects messages the given ids
 func (o *ORM) SelectMsgsWithIDs(ids []int64) (terra.Msgs, error) {
 	var msgs terra.Msgs
-	if err := o.q.Select(&msgs, `SELECT * FROM terra_msgs WHERE id = ANY($1)`, ids); err != nil {
+	if err := o.q.Select(&msgs, `SELECT `+msgColumns+` FROM terra_msgs WHERE id = ANY($1)`, ids); err != nil {
 		return nil, err
 	}
 	return msgs, nil
 }
 
+// SelectQueuedMsgsWithIDs selects the messages with the given ids along with
+// their broadcast history.
+func (o *ORM) SelectQueuedMsgsWithIDs(ids []int64) ([]QueuedMsg, error) {
+	var msgs []QueuedMsg
+	if err := o.q.Select(&msgs, `SELECT * FROM terra_msgs WHERE id = ANY($1) ORDER BY created_at`, ids); err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+// SelectQueuedMsgs pages through the messages with any of the given states,
+// oldest first, along with their broadcast history.
+func (o *ORM) SelectQueuedMsgs(states []db.State, offset, limit int) (msgs []QueuedMsg, count int, err error) {
+	strStates := make([]string, len(states))
+	for i, s := range states {
+		strStates[i] = string(s)
+	}
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		if err = tx.Get(&count, `SELECT count(*) FROM terra_msgs WHERE terra_chain_id = $1 AND state = ANY($2)`, o.chainID, pq.Array(strStates)); err != nil {
+			return err
+		}
+		return tx.Select(&msgs, `SELECT * FROM terra_msgs WHERE terra_chain_id = $1 AND state = ANY($2) ORDER BY created_at, id LIMIT $3 OFFSET $4`, o.chainID, pq.Array(strStates), limit, offset)
+	}, pg.OptReadOnlyTx())
+	return
+}
+
 // UpdateMsgsWithState update the msgs with the given ids to the given state
 // Note state transitions are validated at the db level.
 func (o *ORM) UpdateMsgsWithState(ids []int64, state db.State, txHash *string, qopts ...pg.QOpt) error {
@@ -86,3 +130,22 @@ func (o *ORM) UpdateMsgsWithState(ids []int64, state db.State, txHash *string, q
 	}
 	return nil
 }
+
+// UpdateMsgsBroadcasted marks the msgs with the given ids as broadcasted in
+// the tx with txHash at gasPrice, which times out after timeoutHeight, and
+// increments their broadcast count.
+func (o *ORM) UpdateMsgsBroadcasted(ids []int64, txHash string, gasPrice sdk.Dec, timeoutHeight uint64, qopts ...pg.QOpt) error {
+	q := o.q.WithOpts(qopts...)
+	res, err := q.Exec(`UPDATE terra_msgs SET state = $1, updated_at = NOW(), tx_hash = $2, gas_price = $3, timeout_height = $4, broadcast_count = broadcast_count + 1 WHERE id = ANY($5)`, db.Broadcasted, txHash, gasPrice.String(), timeoutHeight, ids)
+	if err != nil {
+		return err
+	}
+	count, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if int(count) != len(ids) {
+		return errors.Errorf("expected %d records updated, got %d", len(ids), count)
+	}
+	return nil
+}
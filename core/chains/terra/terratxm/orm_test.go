@@ -5,8 +5,10 @@ import (
 	"math/rand"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
@@ -76,4 +78,33 @@ func TestORM(t *testing.T) {
 	confirmed, err := o.SelectMsgsWithState(Confirmed, 5)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(confirmed))
+
+	// Broadcast history
+	err = o.UpdateMsgsBroadcasted([]int64{mid2}, "456", sdk.MustNewDecFromStr("0.015"), 20)
+	require.NoError(t, err)
+	queued, err := o.SelectQueuedMsgsWithIDs([]int64{mid2})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(queued))
+	assert.Equal(t, Broadcasted, queued[0].State)
+	assert.Equal(t, 1, queued[0].BroadcastCount)
+	require.True(t, queued[0].GasPrice.Valid)
+	assert.True(t, sdk.MustNewDecFromStr("0.015").Equal(sdk.MustNewDecFromStr(queued[0].GasPrice.String)))
+	assert.Equal(t, null.IntFrom(20), queued[0].TimeoutHeight)
+
+	// Resubmit
+	err = o.UpdateMsgsWithState([]int64{mid2}, Unstarted, nil)
+	require.NoError(t, err)
+	queued, count, err := o.SelectQueuedMsgs([]State{Unstarted, Broadcasted}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Equal(t, 1, len(queued))
+	assert.Equal(t, mid2, queued[0].ID)
+	assert.Equal(t, Unstarted, queued[0].State)
+	assert.Equal(t, 1, queued[0].BroadcastCount)
+
+	queued, count, err = o.SelectQueuedMsgs([]State{Confirmed}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.Equal(t, 1, len(queued))
+	assert.Equal(t, mid, queued[0].ID)
 }
@@ -38,22 +38,24 @@ type Txm struct {
 	ks         keystore.Terra
 	stop, done chan struct{}
 	cfg        terra.Config
+	txmCfg     Config
 	gpe        terraclient.ComposedGasPriceEstimator
 }
 
 // NewTxm creates a txm. Uses simulation so should only be used to send txes to trusted contracts i.e. OCR.
-func NewTxm(db *sqlx.DB, tc func() (terraclient.ReaderWriter, error), gpe terraclient.ComposedGasPriceEstimator, chainID string, cfg terra.Config, ks keystore.Terra, lggr logger.Logger, logCfg pg.LogConfig, eb pg.EventBroadcaster) *Txm {
+func NewTxm(db *sqlx.DB, tc func() (terraclient.ReaderWriter, error), gpe terraclient.ComposedGasPriceEstimator, chainID string, cfg terra.Config, ks keystore.Terra, lggr logger.Logger, txmCfg Config, eb pg.EventBroadcaster) *Txm {
 	lggr = lggr.Named("Txm")
 	return &Txm{
 		starter: utils.StartStopOnce{},
 		eb:      eb,
-		orm:     NewORM(chainID, db, lggr, logCfg),
+		orm:     NewORM(chainID, db, lggr, txmCfg),
 		ks:      ks,
 		tc:      tc,
 		lggr:    lggr,
 		stop:    make(chan struct{}),
 		done:    make(chan struct{}),
 		cfg:     cfg,
+		txmCfg:  txmCfg,
 		gpe:     gpe,
 	}
 }
@@ -222,10 +224,21 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 	if err != nil {
 		// In the OCR context this should only happen upon stale report
 		txm.lggr.Warnw("unexpected failure after successful simulation", "err", err)
+		if len(simResults.Succeeded) > 1 {
+			// The msgs succeed individually but not together, so split the
+			// batch in two to isolate the offending msg.
+			txm.sendSplitMsgBatch(ctx, gasPrice, sender, key, msgs, simResults.Succeeded.GetSimMsgsIDs())
+		}
 		return
 	}
 	gasLimit := s.GasInfo.GasUsed
 
+	gasPrice, err = txm.bumpedGasPrice(gasPrice, simResults.Succeeded.GetSimMsgsIDs())
+	if err != nil {
+		txm.lggr.Errorw("unable to read previous gas prices", "err", err, "from", sender.String())
+		return
+	}
+
 	lb, err := tc.LatestBlock()
 	if err != nil {
 		txm.lggr.Warnw("unable to get latest block", "err", err, "from", sender.String())
@@ -246,7 +259,7 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 	var resp *txtypes.BroadcastTxResponse
 	err = txm.orm.q.Transaction(func(tx pg.Queryer) error {
 		txHash := strings.ToUpper(hex.EncodeToString(tmhash.Sum(signedTx)))
-		err = txm.orm.UpdateMsgsWithState(simResults.Succeeded.GetSimMsgsIDs(), db.Broadcasted, &txHash, pg.WithQueryer(tx))
+		err = txm.orm.UpdateMsgsBroadcasted(simResults.Succeeded.GetSimMsgsIDs(), txHash, gasPrice.Amount, timeoutHeight, pg.WithQueryer(tx))
 		if err != nil {
 			return err
 		}
@@ -281,6 +294,57 @@ func (txm *Txm) sendMsgBatchFromAddress(ctx context.Context, gasPrice sdk.DecCoi
 	}
 }
 
+// sendSplitMsgBatch sends the msgs with the given ids in two halves, one after
+// the other, each of which is simulated and possibly split again.
+func (txm *Txm) sendSplitMsgBatch(ctx context.Context, gasPrice sdk.DecCoin, sender sdk.AccAddress, key terrakey.Key, msgs terra.Msgs, ids []int64) {
+	byID := make(map[int64]terra.Msg, len(msgs))
+	for _, m := range msgs {
+		byID[m.ID] = m
+	}
+	var batch terra.Msgs
+	for _, id := range ids {
+		batch = append(batch, byID[id])
+	}
+	half := len(batch) / 2
+	txm.lggr.Infow("splitting batch", "from", sender, "first", batch[:half].GetIDs(), "second", batch[half:].GetIDs())
+	txm.sendMsgBatchFromAddress(ctx, gasPrice, sender, key, batch[:half])
+	if ctx.Err() != nil {
+		return
+	}
+	txm.sendMsgBatchFromAddress(ctx, gasPrice, sender, key, batch[half:])
+}
+
+// bumpedGasPrice returns the gas price at which to broadcast the msgs with the
+// given ids. Msgs which were broadcast before, and timed out, are rebroadcast
+// at a bumped price, up to TerraMaxGasPriceULuna.
+func (txm *Txm) bumpedGasPrice(estimated sdk.DecCoin, ids []int64) (sdk.DecCoin, error) {
+	queued, err := txm.orm.SelectQueuedMsgsWithIDs(ids)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	var previous []sdk.Dec
+	for _, m := range queued {
+		if !m.GasPrice.Valid {
+			continue
+		}
+		prev, err := sdk.NewDecFromStr(m.GasPrice.String)
+		if err != nil {
+			// Should never happen, we store sdk.Dec strings
+			txm.lggr.Errorw("invalid previous gas price, ignoring it", "err", err, "id", m.ID, "gasPrice", m.GasPrice.String)
+			continue
+		}
+		previous = append(previous, prev)
+	}
+	maxPrice := maxGasPrice(txm.txmCfg, txm.lggr)
+	price := bumpGasPrice(estimated.Amount, previous, txm.txmCfg.TerraGasBumpPercent(), maxPrice)
+	if len(previous) > 0 {
+		txm.lggr.Infow("bumping gas price of resubmitted msgs", "ids", ids, "estimated", estimated.Amount, "gasPrice", price, "maxGasPrice", maxPrice)
+	} else if estimated.Amount.GT(maxPrice) {
+		txm.lggr.Warnw("estimated gas price exceeds TerraMaxGasPriceULuna, capping it", "estimated", estimated.Amount, "maxGasPrice", maxPrice)
+	}
+	return sdk.NewDecCoinFromDec(estimated.Denom, price), nil
+}
+
 func (txm *Txm) confirmPollConfig() (maxPolls int, pollPeriod time.Duration) {
 	blocks := txm.cfg.BlocksUntilTxTimeout()
 	blockPeriod := txm.cfg.BlockRate()
@@ -300,8 +364,9 @@ func (txm *Txm) confirmTx(ctx context.Context, tc terraclient.Reader, txHash str
 	// Errored: we do not see the txhash onchain after waiting for N blocks worth
 	// of time (plus a small buffer to account for block time variance) where N
 	// is TimeoutHeight - HeightAtBroadcast. In other words, if we wait for that long
-	// and the tx is not confirmed, we know it has timed out.
-	for tries := 0; tries < maxPolls; tries++ {
+	// and the tx is not confirmed, it has most likely timed out, which we then
+	// check against the chain before resubmitting the msgs.
+	for tries := 0; ; tries++ {
 		// Jitter in-case we're confirming multiple txes in parallel for different keys
 		select {
 		case <-ctx.Done():
@@ -311,36 +376,108 @@ func (txm *Txm) confirmTx(ctx context.Context, tc terraclient.Reader, txHash str
 		// Confirm that this tx is onchain, ensuring the sequence number has incremented
 		// so we can build a new batch
 		tx, err := tc.Tx(txHash)
-		if err != nil {
-			if strings.Contains(err.Error(), "not found") {
-				txm.lggr.Infow("txhash not found yet, still confirming", "hash", txHash)
-			} else {
-				txm.lggr.Errorw("error looking for hash of tx", "err", err, "hash", txHash)
-			}
-			continue
+		if err == nil && tx.TxResponse != nil && tx.TxResponse.TxHash == txHash {
+			txm.lggr.Infow("successfully sent batch", "hash", txHash, "msgs", broadcasted)
+			// If confirmed mark these as completed.
+			return txm.orm.UpdateMsgsWithState(broadcasted, db.Confirmed, nil)
 		}
-		// Sanity check
-		if tx.TxResponse == nil || tx.TxResponse.TxHash != txHash {
+		if err == nil {
+			// Sanity check
 			txm.lggr.Errorw("error looking for hash of tx, unexpected response", "tx", tx, "hash", txHash)
+		} else if strings.Contains(err.Error(), "not found") {
+			txm.lggr.Infow("txhash not found yet, still confirming", "hash", txHash)
+		} else {
+			txm.lggr.Errorw("error looking for hash of tx", "err", err, "hash", txHash)
+		}
+		if tries+1 < maxPolls {
 			continue
 		}
 
-		txm.lggr.Infow("successfully sent batch", "hash", txHash, "msgs", broadcasted)
-		// If confirmed mark these as completed.
-		err = txm.orm.UpdateMsgsWithState(broadcasted, db.Confirmed, nil)
+		// If we are unable to confirm the tx after the timeout period, and
+		// the chain is past its timeout height, the tx can no longer be
+		// included, so resubmit these msgs at a higher gas price unless they
+		// have run out of attempts.
+		timedOut, err := txm.txTimedOut(tc, txHash, broadcasted)
 		if err != nil {
-			return err
+			txm.lggr.Warnw("unable to tell whether tx timed out, still confirming", "err", err, "hash", txHash)
+			continue
 		}
-		return nil
+		if timedOut {
+			return txm.resubmitOrError(txHash, broadcasted)
+		}
+	}
+}
+
+// txTimedOut returns true once the chain is past the timeout height of the tx
+// the msgs were broadcast in, and the tx is not onchain.
+func (txm *Txm) txTimedOut(tc terraclient.Reader, txHash string, broadcasted []int64) (bool, error) {
+	queued, err := txm.orm.SelectQueuedMsgsWithIDs(broadcasted)
+	if err != nil {
+		return false, errors.Wrap(err, "unable to read broadcasted msgs")
+	}
+	// Msgs broadcast before their timeout height was stored have none, and
+	// only need the tx to be missing
+	var timeoutHeight int64
+	for _, m := range queued {
+		if m.TimeoutHeight.Valid && m.TimeoutHeight.Int64 > timeoutHeight {
+			timeoutHeight = m.TimeoutHeight.Int64
+		}
+	}
+	lb, err := tc.LatestBlock()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get latest block")
+	}
+	if height := lb.Block.Header.Height; height <= timeoutHeight {
+		txm.lggr.Infow("tx not confirmed after timeout period, waiting for its timeout height", "hash", txHash, "height", height, "timeoutHeight", timeoutHeight)
+		return false, nil
 	}
-	txm.lggr.Errorw("unable to confirm tx after timeout period, marking errored", "hash", txHash)
-	// If we are unable to confirm the tx after the timeout period
-	// mark these msgs as errored
-	err := txm.orm.UpdateMsgsWithState(broadcasted, db.Errored, nil)
+	// Look the tx up once more, as it may have been included while the
+	// previous lookups failed
+	tx, err := tc.Tx(txHash)
+	if err == nil {
+		if tx.TxResponse != nil && tx.TxResponse.TxHash == txHash {
+			// Confirmed by the next poll
+			return false, nil
+		}
+		return false, errors.New("unexpected response looking for hash of tx")
+	}
+	if !strings.Contains(err.Error(), "not found") {
+		return false, errors.Wrap(err, "unable to look for hash of tx")
+	}
+	return true, nil
+}
+
+// resubmitOrError moves the msgs of a timed out tx back to unstarted, so that
+// they are simulated and broadcast again, or marks them errored if they were
+// already broadcast TerraMaxBroadcastAttempts times.
+func (txm *Txm) resubmitOrError(txHash string, broadcasted []int64) error {
+	queued, err := txm.orm.SelectQueuedMsgsWithIDs(broadcasted)
 	if err != nil {
-		txm.lggr.Errorw("unable to mark timed out txes as errored", "err", err, "txes", broadcasted, "num", len(broadcasted))
+		txm.lggr.Errorw("unable to read timed out msgs", "err", err, "hash", txHash)
 		return err
 	}
+	var resubmit, errored []int64
+	for _, m := range queued {
+		if uint32(m.BroadcastCount) < txm.txmCfg.TerraMaxBroadcastAttempts() {
+			resubmit = append(resubmit, m.ID)
+		} else {
+			errored = append(errored, m.ID)
+		}
+	}
+	if len(resubmit) > 0 {
+		txm.lggr.Warnw("unable to confirm tx after timeout period, resubmitting msgs", "hash", txHash, "msgs", resubmit)
+		if err = txm.orm.UpdateMsgsWithState(resubmit, db.Unstarted, nil); err != nil {
+			txm.lggr.Errorw("unable to resubmit timed out msgs", "err", err, "msgs", resubmit, "num", len(resubmit))
+			return err
+		}
+	}
+	if len(errored) > 0 {
+		txm.lggr.Errorw("unable to confirm tx after timeout period and out of broadcast attempts, marking errored", "hash", txHash, "msgs", errored)
+		if err = txm.orm.UpdateMsgsWithState(errored, db.Errored, nil); err != nil {
+			txm.lggr.Errorw("unable to mark timed out txes as errored", "err", err, "txes", errored, "num", len(errored))
+			return err
+		}
+	}
 	return nil
 }
 
@@ -24,6 +24,7 @@ import (
 	terradb "github.com/smartcontractkit/chainlink-terra/pkg/terra/db"
 
 	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/terratest"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -55,7 +56,7 @@ func TestTxm(t *testing.T) {
 	require.NoError(t, err)
 	contract, err := cosmostypes.AccAddressFromBech32("terra1pp76d50yv2ldaahsdxdv8mmzqfjr2ax97gmue8")
 	require.NoError(t, err)
-	logCfg := pgtest.NewPGCfg(true)
+	txmCfg := configtest.NewTestGeneralConfig(t)
 	chainID := fmt.Sprintf("Chainlinktest-%d", rand.Int31n(999999))
 	terratest.MustInsertChain(t, db, &terradb.Chain{ID: chainID})
 	require.NoError(t, err)
@@ -71,7 +72,7 @@ func TestTxm(t *testing.T) {
 	t.Run("single msg", func(t *testing.T) {
 		tc := new(tcmocks.ReaderWriter)
 		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, logCfg, nil)
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
 
 		// Enqueue a single msg, then send it in a batch
 		id1, err := txm.Enqueue(contract.String(), generateExecuteMsg(t, []byte(`1`), sender1, contract))
@@ -111,7 +112,7 @@ func TestTxm(t *testing.T) {
 	t.Run("two msgs different accounts", func(t *testing.T) {
 		tc := new(tcmocks.ReaderWriter)
 		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, pgtest.NewPGCfg(true), nil)
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
 
 		id1, err := txm.Enqueue(contract.String(), generateExecuteMsg(t, []byte(`0`), sender1, contract))
 		require.NoError(t, err)
@@ -171,25 +172,181 @@ func TestTxm(t *testing.T) {
 		tc.AssertExpectations(t)
 	})
 
+	latestBlock := func(height int64) *tmservicetypes.GetLatestBlockResponse {
+		return &tmservicetypes.GetLatestBlockResponse{Block: &tmtypes.Block{Header: tmtypes.Header{Height: height}}}
+	}
+
 	t.Run("failed to confirm", func(t *testing.T) {
 		tc := new(tcmocks.ReaderWriter)
+		tc.On("Tx", mock.Anything).Return(nil, errors.New("not found")).Times(3)
+		tc.On("LatestBlock").Return(latestBlock(11), nil).Once()
+		cfg := terra.NewConfig(terradb.ChainCfg{}, lggr)
+		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
+		i, err := txm.orm.InsertMsg("blah", []byte{0x01})
+		require.NoError(t, err)
+		txh := "0x123"
+		require.NoError(t, txm.orm.UpdateMsgsBroadcasted([]int64{i}, txh, cosmostypes.MustNewDecFromStr("0.01"), 10))
+		err = txm.confirmTx(testutils.Context(t), tc, txh, []int64{i}, 2, 1*time.Millisecond)
+		require.NoError(t, err)
+		m, err := txm.orm.SelectMsgsWithIDs([]int64{i})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(m))
+		assert.Equal(t, Unstarted, m[0].State, "timed out msg should be resubmitted")
+		tc.AssertExpectations(t)
+
+		// The msg cannot be unmarshalled, so leave it out of later batches
+		require.NoError(t, txm.orm.UpdateMsgsWithState([]int64{i}, Errored, nil))
+	})
+
+	t.Run("confirms until past the timeout height", func(t *testing.T) {
+		tc := new(tcmocks.ReaderWriter)
+		// The timeout period elapsed, but blocks are slow
+		tc.On("Tx", mock.Anything).Return(nil, errors.New("not found")).Once()
+		tc.On("LatestBlock").Return(latestBlock(10), nil).Once()
+		// The RPC fails while the tx is included
+		tc.On("Tx", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+		tc.On("LatestBlock").Return(latestBlock(11), nil).Once()
+		tc.On("Tx", mock.Anything).Return(nil, errors.New("connection refused")).Once()
 		tc.On("Tx", mock.Anything).Return(&txtypes.GetTxResponse{
 			Tx:         &txtypes.Tx{},
 			TxResponse: &cosmostypes.TxResponse{TxHash: "0x123"},
-		}, errors.New("not found")).Twice()
+		}, nil).Once()
 		cfg := terra.NewConfig(terradb.ChainCfg{}, lggr)
 		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, pgtest.NewPGCfg(true), nil)
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
 		i, err := txm.orm.InsertMsg("blah", []byte{0x01})
 		require.NoError(t, err)
 		txh := "0x123"
-		require.NoError(t, txm.orm.UpdateMsgsWithState([]int64{i}, Broadcasted, &txh))
-		err = txm.confirmTx(testutils.Context(t), tc, txh, []int64{i}, 2, 1*time.Millisecond)
-		require.NoError(t, err)
+		require.NoError(t, txm.orm.UpdateMsgsBroadcasted([]int64{i}, txh, cosmostypes.MustNewDecFromStr("0.01"), 10))
+		require.NoError(t, txm.confirmTx(testutils.Context(t), tc, txh, []int64{i}, 1, time.Millisecond))
 		m, err := txm.orm.SelectMsgsWithIDs([]int64{i})
 		require.NoError(t, err)
 		require.Equal(t, 1, len(m))
+		assert.Equal(t, Confirmed, m[0].State, "included msg should not be resubmitted")
+		tc.AssertExpectations(t)
+	})
+
+	t.Run("failed to confirm after max broadcast attempts", func(t *testing.T) {
+		tc := new(tcmocks.ReaderWriter)
+		tc.On("Tx", mock.Anything).Return(nil, errors.New("not found"))
+		tc.On("LatestBlock").Return(latestBlock(11), nil)
+		cfg := terra.NewConfig(terradb.ChainCfg{}, lggr)
+		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
+		i, err := txm.orm.InsertMsg("blah", []byte{0x01})
+		require.NoError(t, err)
+		txh := "0x123"
+		gasPrice := cosmostypes.MustNewDecFromStr("0.01")
+		for attempt := uint32(1); attempt <= txmCfg.TerraMaxBroadcastAttempts(); attempt++ {
+			require.NoError(t, txm.orm.UpdateMsgsBroadcasted([]int64{i}, txh, gasPrice, 10))
+			require.NoError(t, txm.confirmTx(testutils.Context(t), tc, txh, []int64{i}, 1, time.Millisecond))
+		}
+		m, err := txm.orm.SelectQueuedMsgsWithIDs([]int64{i})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(m))
 		assert.Equal(t, Errored, m[0].State)
+		assert.Equal(t, int(txmCfg.TerraMaxBroadcastAttempts()), m[0].BroadcastCount)
+	})
+
+	t.Run("resubmits at bumped gas price", func(t *testing.T) {
+		tc := new(tcmocks.ReaderWriter)
+		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
+
+		id1, err := txm.Enqueue(contract.String(), generateExecuteMsg(t, []byte(`1`), sender1, contract))
+		require.NoError(t, err)
+		// Previously broadcast at 0.02 uluna, which timed out
+		require.NoError(t, txm.orm.UpdateMsgsBroadcasted([]int64{id1}, "0x122", cosmostypes.MustNewDecFromStr("0.02"), 10))
+		require.NoError(t, txm.orm.UpdateMsgsWithState([]int64{id1}, Unstarted, nil))
+
+		tc.On("Account", mock.Anything).Return(uint64(0), uint64(0), nil)
+		tc.On("BatchSimulateUnsigned", mock.Anything, mock.Anything).Return(&terraclient.BatchSimResults{
+			Succeeded: terraclient.SimMsgs{{ID: id1, Msg: &wasmtypes.MsgExecuteContract{
+				Sender:     sender1.String(),
+				ExecuteMsg: []byte(`1`),
+			}}},
+		}, nil)
+		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
+			GasUsed: 1_000_000,
+		}}, nil)
+		tc.On("LatestBlock").Return(&tmservicetypes.GetLatestBlockResponse{Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: 1},
+		}}, nil)
+		// 0.02 bumped by 20% exceeds the estimated 0.01
+		bumped := cosmostypes.NewDecCoinFromDec("uluna", cosmostypes.MustNewDecFromStr("0.024"))
+		tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(gasPrice cosmostypes.DecCoin) bool {
+			return gasPrice.Denom == bumped.Denom && gasPrice.Amount.Equal(bumped.Amount)
+		}), mock.Anything, mock.Anything).Return([]byte{0x01}, nil)
+		tc.On("Broadcast", mock.Anything, mock.Anything).Return(&txtypes.BroadcastTxResponse{
+			TxResponse: &cosmostypes.TxResponse{TxHash: "0x123"},
+		}, nil)
+		tc.On("Tx", mock.Anything).Return(&txtypes.GetTxResponse{
+			Tx:         &txtypes.Tx{},
+			TxResponse: &cosmostypes.TxResponse{TxHash: "0x123"},
+		}, nil)
+		txm.sendMsgBatch(testutils.Context(t))
+
+		m, err := txm.orm.SelectQueuedMsgsWithIDs([]int64{id1})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(m))
+		assert.Equal(t, Confirmed, m[0].State)
+		assert.Equal(t, 2, m[0].BroadcastCount)
+		tc.AssertExpectations(t)
+	})
+
+	t.Run("splits batch when simulating it together fails", func(t *testing.T) {
+		tc := new(tcmocks.ReaderWriter)
+		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
+
+		id1, err := txm.Enqueue(contract.String(), generateExecuteMsg(t, []byte(`1`), sender1, contract))
+		require.NoError(t, err)
+		id2, err := txm.Enqueue(contract.String(), generateExecuteMsg(t, []byte(`2`), sender1, contract))
+		require.NoError(t, err)
+		simMsg := func(id int64, msg string) terraclient.SimMsg {
+			return terraclient.SimMsg{ID: id, Msg: &wasmtypes.MsgExecuteContract{
+				Sender:     sender1.String(),
+				ExecuteMsg: []byte(msg),
+				Contract:   contract.String(),
+			}}
+		}
+
+		tc.On("Account", mock.Anything).Return(uint64(0), uint64(0), nil)
+		// Together
+		tc.On("BatchSimulateUnsigned", terraclient.SimMsgs{simMsg(id1, `1`), simMsg(id2, `2`)}, mock.Anything).Return(&terraclient.BatchSimResults{
+			Succeeded: terraclient.SimMsgs{simMsg(id1, `1`), simMsg(id2, `2`)},
+		}, nil).Once()
+		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(nil, errors.New("out of gas")).Once()
+		// Split
+		tc.On("BatchSimulateUnsigned", terraclient.SimMsgs{simMsg(id1, `1`)}, mock.Anything).Return(&terraclient.BatchSimResults{
+			Succeeded: terraclient.SimMsgs{simMsg(id1, `1`)},
+		}, nil).Once()
+		tc.On("BatchSimulateUnsigned", terraclient.SimMsgs{simMsg(id2, `2`)}, mock.Anything).Return(&terraclient.BatchSimResults{
+			Failed: terraclient.SimMsgs{simMsg(id2, `2`)},
+		}, nil).Once()
+		tc.On("SimulateUnsigned", mock.Anything, mock.Anything).Return(&txtypes.SimulateResponse{GasInfo: &cosmostypes.GasInfo{
+			GasUsed: 1_000_000,
+		}}, nil).Once()
+		tc.On("LatestBlock").Return(&tmservicetypes.GetLatestBlockResponse{Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: 1},
+		}}, nil).Once()
+		tc.On("CreateAndSign", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte{0x01}, nil).Once()
+		tc.On("Broadcast", mock.Anything, mock.Anything).Return(&txtypes.BroadcastTxResponse{
+			TxResponse: &cosmostypes.TxResponse{TxHash: "0x123"},
+		}, nil).Once()
+		tc.On("Tx", mock.Anything).Return(&txtypes.GetTxResponse{
+			Tx:         &txtypes.Tx{},
+			TxResponse: &cosmostypes.TxResponse{TxHash: "0x123"},
+		}, nil).Once()
+		txm.sendMsgBatch(testutils.Context(t))
+
+		msgs, err := txm.orm.SelectMsgsWithIDs([]int64{id1, id2})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(msgs))
+		states := map[int64]State{msgs[0].ID: msgs[0].State, msgs[1].ID: msgs[1].State}
+		assert.Equal(t, Confirmed, states[id1])
+		assert.Equal(t, Errored, states[id2])
 		tc.AssertExpectations(t)
 	})
 
@@ -209,7 +366,7 @@ func TestTxm(t *testing.T) {
 			TxResponse: &cosmostypes.TxResponse{TxHash: txHash3},
 		}, nil).Once()
 		tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
-		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, pgtest.NewPGCfg(true), nil)
+		txm := NewTxm(db, tcFn, *gpe, chainID, cfg, ks.Terra(), lggr, txmCfg, nil)
 
 		// Insert and broadcast 3 msgs with different txhashes.
 		id1, err := txm.orm.InsertMsg("blah", []byte{0x01})
@@ -236,3 +393,26 @@ func TestTxm(t *testing.T) {
 		tc.AssertExpectations(t)
 	})
 }
+
+func TestBumpGasPrice(t *testing.T) {
+	dec := cosmostypes.MustNewDecFromStr
+	maxPrice := dec("0.1")
+
+	for _, tt := range []struct {
+		name      string
+		estimated cosmostypes.Dec
+		previous  []cosmostypes.Dec
+		want      cosmostypes.Dec
+	}{
+		{"never broadcast", dec("0.01"), nil, dec("0.01")},
+		{"estimate below bumped price", dec("0.01"), []cosmostypes.Dec{dec("0.02"), dec("0.015")}, dec("0.024")},
+		{"estimate above bumped price", dec("0.05"), []cosmostypes.Dec{dec("0.02")}, dec("0.05")},
+		{"bumped price capped", dec("0.01"), []cosmostypes.Dec{dec("0.09")}, maxPrice},
+		{"estimate capped", dec("0.2"), nil, maxPrice},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bumpGasPrice(tt.estimated, tt.previous, 20, maxPrice)
+			assert.True(t, tt.want.Equal(got), "want %s got %s", tt.want, got)
+		})
+	}
+}
@@ -80,7 +80,7 @@ func TestTxm_Integration(t *testing.T) {
 
 	tcFn := func() (terraclient.ReaderWriter, error) { return tc, nil }
 	// Start txm
-	txm := terratxm.NewTxm(db, tcFn, *gpe, chainID, chainCfg, ks.Terra(), lggr, pgtest.NewPGCfg(true), eb)
+	txm := terratxm.NewTxm(db, tcFn, *gpe, chainID, chainCfg, ks.Terra(), lggr, cfg, eb)
 	require.NoError(t, txm.Start())
 
 	// Change the contract state
@@ -142,6 +142,16 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show the node's environment variables",
 					Action: client.GetConfiguration,
 				},
+				{
+					Name:   "validate",
+					Usage:  "Validate the TOML config file given as argument, or set by CONFIG_FILE, without starting the node",
+					Action: client.ValidateConfigFile,
+				},
+				{
+					Name:   "dump",
+					Usage:  "Print the effective config, merged from the environment, the config file and defaults, as a TOML config file",
+					Action: client.DumpConfigFile,
+				},
 				{
 					Name:   "setgasprice",
 					Usage:  "Set the default gas price to use for outgoing transactions",
@@ -236,6 +246,54 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "replay",
+					Usage:  "Replay a finished run of a job in a sandbox which never submits transactions, and compare the results with the run. Takes the job ID and the run ID",
+					Action: client.ReplayPipelineRun,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "current-spec",
+							Usage: "replay the run with the current spec of the job, instead of the spec it was run with",
+						},
+					},
+				},
+				{
+					Name:   "test",
+					Usage:  "Execute the pipeline of a job spec locally, without a node. HTTP, bridge and ethcall tasks are answered from a fixtures file, and ethtx tasks never submit transactions",
+					Action: client.TestPipeline,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "fixtures",
+							Usage: "TOML file of the vars to run the pipeline with and the responses of its http, bridge and ethcall tasks",
+						},
+					},
+				},
+				{
+					Name:  "bhs-backwards",
+					Usage: "Commands for storing the hashes of historical blocks with a blockhash store job",
+					Subcommands: []cli.Command{
+						{
+							Name:   "create",
+							Usage:  "Store the hashes of the blocks from start-1 down to target, the hash of start must be stored already",
+							Action: client.CreateBHSBackwardsRun,
+							Flags: []cli.Flag{
+								cli.Int64Flag{
+									Name:  "start",
+									Usage: "block whose hash is stored, the run starts from",
+								},
+								cli.Int64Flag{
+									Name:  "target",
+									Usage: "lowest block whose hash to store",
+								},
+							},
+						},
+						{
+							Name:   "list",
+							Usage:  "List the backwards runs of a blockhash store job",
+							Action: client.IndexBHSBackwardsRuns,
+						},
+					},
+				},
 			},
 		},
 		{
@@ -751,11 +809,11 @@ func NewApp(client *Client) *cli.App {
 						},
 						cli.StringFlag{
 							Name:  "password, p",
-							Usage: "text file holding the password for the node's account",
+							Usage: "text file holding the password for the node's account, or a secret:// reference to it",
 						},
 						cli.StringFlag{
 							Name:  "vrfpassword, vp",
-							Usage: "text file holding the password for the vrf keys; enables Chainlink VRF oracle",
+							Usage: "text file holding the password for the vrf keys, or a secret:// reference to it; enables Chainlink VRF oracle",
 						},
 					},
 					Usage:  "Run the Chainlink node",
@@ -780,7 +838,7 @@ func NewApp(client *Client) *cli.App {
 						},
 						cli.StringFlag{
 							Name:  "password, p",
-							Usage: "text file holding the password for the node's account",
+							Usage: "text file holding the password for the node's account, or a secret:// reference to it",
 						},
 						cli.StringFlag{
 							Name:  "address, a",
@@ -796,6 +854,23 @@ func NewApp(client *Client) *cli.App {
 						},
 					},
 				},
+				{
+					Name:   "relay-plugin",
+					Usage:  "Serve a relayer as a plugin of the node which started this process, as configured by RELAY_PLUGINS",
+					Hidden: true,
+					Action: client.RelayPlugin,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "password, p",
+							Usage: "text file holding the password for the node's account, or a secret:// reference to it",
+						},
+						cli.StringFlag{
+							Name:  "network",
+							Usage: "network to relay to, only evm can run as a plugin",
+							Value: "evm",
+						},
+					},
+				},
 				{
 					Name:   "status",
 					Usage:  "Displays the health of various services running inside the node.",
@@ -884,6 +959,11 @@ func NewApp(client *Client) *cli.App {
 								},
 							},
 						},
+						{
+							Name:   "import-runs",
+							Usage:  "Import the pipeline runs of an <archive> written to JOB_PIPELINE_ARCHIVE_DIR back into the database. Imported runs are subject to the retention policies like any other, so consider importing into a separate database.",
+							Action: client.ImportPipelineRuns,
+						},
 					},
 				},
 			},
@@ -909,6 +989,11 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "List all external initiators",
 					Action: client.IndexExternalInitiators,
 				},
+				{
+					Name:   "resync",
+					Usage:  "Notify an external initiator by name of all the jobs it initiates",
+					Action: client.ResyncExternalInitiator,
+				},
 			},
 		},
 
@@ -1031,6 +1116,21 @@ func NewApp(client *Client) *cli.App {
 								},
 							},
 						},
+						{
+							Name:   "msgs",
+							Usage:  "List the msgs queued for a Terra chain",
+							Action: client.IndexTerraMsgs,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "state",
+									Usage: "comma separated list of states to list, defaults to unstarted,broadcasted",
+								},
+								cli.IntFlag{
+									Name:  "page",
+									Usage: "page of results to display",
+								},
+							},
+						},
 					},
 				},
 			},
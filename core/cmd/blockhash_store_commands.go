@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// BHSBackwardsRunPresenter implements TableRenderer for a
+// BHSBackwardsRunResource.
+type BHSBackwardsRunPresenter struct {
+	JAID
+	presenters.BHSBackwardsRunResource
+}
+
+// ToRow presents the BHSBackwardsRunResource as a slice of strings.
+func (p *BHSBackwardsRunPresenter) ToRow() []string {
+	var runErr string
+	if p.Error != nil {
+		runErr = *p.Error
+	}
+	return []string{
+		p.ID,
+		strconv.FormatInt(p.StartBlock, 10),
+		strconv.FormatInt(p.TargetBlock, 10),
+		strconv.FormatInt(p.NextBlock, 10),
+		strconv.FormatInt(p.Remaining, 10),
+		p.State,
+		runErr,
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+}
+
+var bhsBackwardsRunHeaders = []string{"ID", "Start Block", "Target Block", "Next Block", "Remaining", "State", "Error", "Created", "Updated"}
+
+// RenderTable implements TableRenderer
+func (p BHSBackwardsRunPresenter) RenderTable(rt RendererTable) error {
+	renderList(bhsBackwardsRunHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return nil
+}
+
+// BHSBackwardsRunPresenters implements TableRenderer for a slice of
+// BHSBackwardsRunPresenter.
+type BHSBackwardsRunPresenters []BHSBackwardsRunPresenter
+
+// RenderTable implements TableRenderer
+func (ps BHSBackwardsRunPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(bhsBackwardsRunHeaders, rows, rt.Writer)
+	return nil
+}
+
+// CreateBHSBackwardsRun starts storing the hashes of the blocks from start-1
+// down to target with a BlockhashStore feeder job.
+func (cli *Client) CreateBHSBackwardsRun(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the id of the job"))
+	}
+	if !c.IsSet("start") || !c.IsSet("target") {
+		return cli.errorOut(errors.New("must pass the start and target blocks"))
+	}
+
+	request, err := json.Marshal(web.CreateBHSBackwardsRunRequest{
+		StartBlock:  c.Int64("start"),
+		TargetBlock: c.Int64("target"),
+	})
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	resp, err := cli.HTTP.Post(bhsBackwardsRunsURI(c.Args().First()), bytes.NewReader(request))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &BHSBackwardsRunPresenter{}, "Backwards run created")
+}
+
+// IndexBHSBackwardsRuns lists the backwards runs of a BlockhashStore feeder
+// job, newest first.
+func (cli *Client) IndexBHSBackwardsRuns(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the id of the job"))
+	}
+
+	resp, err := cli.HTTP.Get(bhsBackwardsRunsURI(c.Args().First()))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &BHSBackwardsRunPresenters{})
+}
+
+func bhsBackwardsRunsURI(jobID string) string {
+	return fmt.Sprintf("/v2/jobs/%s/blockhash_store/backwards_runs", url.PathEscape(jobID))
+}
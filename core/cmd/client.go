@@ -137,11 +137,16 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig, db *sqlx.D
 		}
 	}
 
-	// Upsert EVM chains/nodes from ENV, necessary for backwards compatibility
+	// Upsert EVM chains/nodes from ENV, necessary for backwards compatibility,
+	// and from the config file
 	if cfg.EVMEnabled() {
 		if err = evm.ClobberDBFromEnv(db, cfg, appLggr); err != nil {
 			return nil, err
 		}
+		// Chains declared by the config file take precedence over ETH_URL
+		if err = evm.ClobberDBFromConfigFile(db, cfg.LoadedConfigFile(), appLggr); err != nil {
+			return nil, err
+		}
 	}
 
 	eventBroadcaster := pg.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration(), appLggr, cfg.AppID())
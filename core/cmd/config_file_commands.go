@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+)
+
+// ValidateConfigFile checks the config file given as argument, or else the one
+// set by CONFIG_FILE, without starting the node.
+func (cli *Client) ValidateConfigFile(c *clipkg.Context) error {
+	path := c.Args().First()
+	if path == "" {
+		path = cli.Config.ConfigFile()
+	}
+	if path == "" {
+		return cli.errorOut(errors.New("must pass the path of the config file, or set CONFIG_FILE"))
+	}
+
+	f, err := configfile.Load(path)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	fmt.Printf("Config file %s is valid: %d global settings, %d EVM chains\n", path, len(f.Global), len(f.EVM))
+	return nil
+}
+
+// DumpConfigFile prints the effective configuration, merged from the
+// environment, the config file and defaults, as a config file.
+func (cli *Client) DumpConfigFile(c *clipkg.Context) error {
+	b, err := cli.Config.EffectiveConfigFile().Marshal()
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to marshal config"))
+	}
+
+	fmt.Print(string(b))
+	return nil
+}
+
+// reloadConfigFileOnSIGHUP reloads the config file each time the process
+// receives SIGHUP, until ctx is done.
+func reloadConfigFileOnSIGHUP(ctx context.Context, app chainlink.Application, lggr logger.Logger) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+	defer signal.Stop(ch)
+
+	for {
+		select {
+		case <-ch:
+			lggr.Info("Received SIGHUP, reloading config file")
+			if _, err := app.ReloadConfigFile(); err != nil {
+				lggr.Errorw("Failed to reload config file", "err", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
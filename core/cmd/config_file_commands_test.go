@@ -0,0 +1,74 @@
+package cmd_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/cmd"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestClient_ValidateConfigFile(t *testing.T) {
+	t.Parallel()
+
+	client := cmd.Client{
+		Config: configtest.NewTestGeneralConfig(t),
+		Logger: logger.TestLogger(t),
+	}
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "valid.toml")
+	require.NoError(t, os.WriteFile(valid, []byte(`
+[Global]
+LOG_LEVEL = "debug"
+
+[[EVM]]
+ChainID = "4"
+
+[[EVM.Nodes]]
+Name = "rinkeby-primary"
+WSURL = "wss://rinkeby.example/ws"
+`), 0600))
+	invalid := filepath.Join(dir, "invalid.toml")
+	require.NoError(t, os.WriteFile(invalid, []byte(`
+[Global]
+LOG_LEVEL = "loud"
+`), 0600))
+
+	validate := func(args ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		require.NoError(t, set.Parse(args))
+		return client.ValidateConfigFile(cli.NewContext(nil, set, nil))
+	}
+
+	assert.NoError(t, validate(valid))
+
+	err := validate(invalid)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Global.LOG_LEVEL")
+
+	err = validate(filepath.Join(dir, "missing.toml"))
+	assert.Error(t, err)
+
+	// Without CONFIG_FILE there is nothing to validate
+	assert.Error(t, validate())
+}
+
+func TestClient_DumpConfigFile(t *testing.T) {
+	t.Parallel()
+
+	client := cmd.Client{
+		Config: configtest.NewTestGeneralConfig(t),
+		Logger: logger.TestLogger(t),
+	}
+
+	assert.NoError(t, client.DumpConfigFile(cltest.EmptyCLIContext()))
+}
@@ -1,17 +1,25 @@
 package cmd
 
 import (
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	clipkg "github.com/urfave/cli"
 )
 
+var externalInitiatorHeaders = []string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "Pending Notifications", "Last Notification Error", "CreatedAt", "UpdatedAt"}
+
 type ExternalInitiatorPresenter struct {
 	JAID
 	presenters.ExternalInitiatorResource
 }
 
 func (eip *ExternalInitiatorPresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
+	table := rt.newTable(externalInitiatorHeaders)
 	table.Append(eip.ToRow())
 	render("External Initiator:", table)
 	return nil
@@ -22,12 +30,21 @@ func (eip *ExternalInitiatorPresenter) ToRow() []string {
 	if eip.URL != nil {
 		urlS = eip.URL.String()
 	}
+	var pending, lastError string
+	if eip.Notifications != nil {
+		pending = fmt.Sprint(eip.Notifications.Pending)
+		if eip.Notifications.LastError != nil {
+			lastError = *eip.Notifications.LastError
+		}
+	}
 	return []string{
 		eip.ID,
 		eip.Name,
 		urlS,
 		eip.AccessKey,
 		eip.OutgoingToken,
+		pending,
+		lastError,
 		eip.CreatedAt.String(),
 		eip.UpdatedAt.String(),
 	}
@@ -36,7 +53,7 @@ func (eip *ExternalInitiatorPresenter) ToRow() []string {
 type ExternalInitiatorPresenters []ExternalInitiatorPresenter
 
 func (eips *ExternalInitiatorPresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"ID", "Name", "URL", "AccessKey", "OutgoingToken", "CreatedAt", "UpdatedAt"})
+	table := rt.newTable(externalInitiatorHeaders)
 	for _, eip := range *eips {
 		table.Append(eip.ToRow())
 	}
@@ -48,3 +65,24 @@ func (eips *ExternalInitiatorPresenters) RenderTable(rt RendererTable) error {
 func (cli *Client) IndexExternalInitiators(c *clipkg.Context) (err error) {
 	return cli.getPage("/v2/external_initiators", c.Int("page"), &ExternalInitiatorPresenters{})
 }
+
+// ResyncExternalInitiator notifies an external initiator of all the jobs it
+// initiates
+func (cli *Client) ResyncExternalInitiator(c *clipkg.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the name of the external initiator to resync"))
+	}
+
+	resp, err := cli.HTTP.Post("/v2/external_initiators/"+url.PathEscape(c.Args().First())+"/resync", nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var ei ExternalInitiatorPresenter
+	return cli.renderAPIResponse(resp, &ei)
+}
@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net/url"
+	"strings"
 	"time"
 
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
@@ -257,3 +261,179 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// PipelineRunReplayPresenter wraps the JSONAPI pipeline run replay resource,
+// rendering the results of the original run and the replay side by side.
+type PipelineRunReplayPresenter struct {
+	JAID
+	presenters.PipelineRunReplayResource
+}
+
+// ToRows presents the result of each task, followed by the final outputs.
+func (p PipelineRunReplayPresenter) ToRows() [][]string {
+	var rows [][]string
+	for _, tr := range p.TaskRuns {
+		rows = append(rows, []string{tr.DotID, string(tr.Type), taskRunResult(tr.Original), taskRunResult(tr.Replayed), changedMarker(tr.Changed)})
+	}
+	rows = append(rows, []string{"(outputs)", "", runResult(p.Original), runResult(p.Replayed), changedMarker(p.OutputsChanged)})
+	return rows
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineRunReplayPresenter) RenderTable(rt RendererTable) error {
+	spec := "the spec it was run with"
+	if p.CurrentSpec {
+		spec = "the current spec of the job"
+	}
+	table := rt.newTable([]string{"Task", "Type", "Original", "Replayed", "Changed"})
+	for _, r := range p.ToRows() {
+		table.Append(r)
+	}
+
+	render(fmt.Sprintf("Replay of run %s with %s", p.ID, spec), table)
+	return nil
+}
+
+func taskRunResult(tr *presenters.PipelineTaskRunResource) string {
+	switch {
+	case tr == nil:
+		return "-"
+	case tr.Error != nil:
+		return "error: " + *tr.Error
+	case tr.Output != nil:
+		return *tr.Output
+	default:
+		return "null"
+	}
+}
+
+func runResult(run presenters.PipelineRunResource) string {
+	results := make([]string, len(run.Outputs))
+	for i, output := range run.Outputs {
+		switch {
+		case i < len(run.FatalErrors) && run.FatalErrors[i] != nil:
+			results[i] = "error: " + *run.FatalErrors[i]
+		case output != nil:
+			results[i] = *output
+		default:
+			results[i] = "null"
+		}
+	}
+	return strings.Join(results, "\n")
+}
+
+func changedMarker(changed bool) string {
+	if changed {
+		return "yes"
+	}
+	return ""
+}
+
+// ReplayPipelineRun re-executes a run of a job in a sandbox which never
+// submits transactions, and shows the results next to those of the run.
+func (cli *Client) ReplayPipelineRun(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return cli.errorOut(errors.New("must pass the id of the job and the id of the run"))
+	}
+
+	uri := fmt.Sprintf("/v2/jobs/%s/runs/%s/replay", url.PathEscape(c.Args().Get(0)), url.PathEscape(c.Args().Get(1)))
+	if c.Bool("current-spec") {
+		uri += "?spec=current"
+	}
+	resp, err := cli.HTTP.Post(uri, nil)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &PipelineRunReplayPresenter{})
+}
+
+// PipelineTestRunPresenter renders the result of each task of a pipeline run
+// executed by TestPipeline.
+type PipelineTestRunPresenter struct {
+	presenters.PipelineRunResource
+}
+
+// ToRows presents the result and duration of each task, followed by the
+// final outputs.
+func (p PipelineTestRunPresenter) ToRows() [][]string {
+	var rows [][]string
+	for i := range p.TaskRuns {
+		tr := &p.TaskRuns[i]
+		var duration string
+		if !tr.FinishedAt.IsZero() {
+			duration = tr.FinishedAt.Sub(tr.CreatedAt).String()
+		}
+		rows = append(rows, []string{tr.DotID, string(tr.Type), taskRunResult(tr), duration})
+	}
+	rows = append(rows, []string{"(outputs)", "", runResult(p.PipelineRunResource), p.FinishedAt.Sub(p.CreatedAt).String()})
+	return rows
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineTestRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Result", "Duration"})
+	for _, r := range p.ToRows() {
+		table.Append(r)
+	}
+
+	render("Pipeline Test Run", table)
+	return nil
+}
+
+// TestPipeline executes the pipeline of a job spec in-process, without a node.
+// HTTP, bridge and ethcall tasks are answered from a fixtures file, and ethtx
+// tasks only show the transaction they would send. It errors if the run has
+// fatal errors, so that it can be used in CI.
+func (cli *Client) TestPipeline(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	var spec struct {
+		ObservationSource string `toml:"observationSource"`
+	}
+	if err = toml.Unmarshal([]byte(tomlString), &spec); err != nil {
+		return cli.errorOut(errors.Wrap(err, "invalid job spec"))
+	}
+	if strings.TrimSpace(spec.ObservationSource) == "" {
+		return cli.errorOut(errors.New("job spec has no observationSource"))
+	}
+	if _, err = pipeline.Parse(spec.ObservationSource); err != nil {
+		return cli.errorOut(err)
+	}
+
+	var fixtures pipeline.Fixtures
+	if path := c.String("fixtures"); path != "" {
+		b, rerr := ioutil.ReadFile(path)
+		if rerr != nil {
+			return cli.errorOut(errors.Wrapf(rerr, "error reading fixtures from '%s'", path))
+		}
+		if fixtures, err = pipeline.ParseFixtures(string(b)); err != nil {
+			return cli.errorOut(err)
+		}
+	}
+
+	runner := pipeline.NewOfflineRunner(cli.Config, fixtures.Stub(), cli.Logger)
+	run, _, err := runner.ExecuteRun(context.Background(), pipeline.Spec{DotDagSource: spec.ObservationSource}, pipeline.NewVarsFrom(fixtures.Vars), cli.Logger)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	if err = cli.Render(&PipelineTestRunPresenter{presenters.NewPipelineRunResource(run, cli.Logger)}); err != nil {
+		return cli.errorOut(err)
+	}
+	if run.HasFatalErrors() {
+		return cli.errorOut(errors.New("pipeline run has fatal errors"))
+	}
+	return nil
+}
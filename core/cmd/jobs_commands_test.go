@@ -3,6 +3,9 @@ package cmd_test
 import (
 	"bytes"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/cmd"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -83,6 +87,56 @@ func TestJobPresenter_RenderTable(t *testing.T) {
 	assert.Contains(t, output, createdAt.Format(time.RFC3339))
 }
 
+func TestPipelineRunReplayPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+		str    = func(s string) *string { return &s }
+	)
+
+	p := cmd.PipelineRunReplayPresenter{
+		JAID: cmd.NewJAID("42"),
+		PipelineRunReplayResource: presenters.PipelineRunReplayResource{
+			OutputsChanged: true,
+			Original: presenters.PipelineRunResource{
+				Outputs:     []*string{str("3")},
+				FatalErrors: []*string{nil},
+			},
+			Replayed: presenters.PipelineRunResource{
+				Outputs:     []*string{nil},
+				FatalErrors: []*string{str("uh oh")},
+			},
+			TaskRuns: []presenters.PipelineTaskRunDiffResource{
+				{
+					DotID:    "ds1",
+					Type:     "http",
+					Original: &presenters.PipelineTaskRunResource{Output: str(`{"USD":1}`)},
+					Replayed: &presenters.PipelineTaskRunResource{Error: str("timeout")},
+					Changed:  true,
+				},
+				{
+					DotID:    "answer",
+					Type:     "median",
+					Replayed: &presenters.PipelineTaskRunResource{Output: str(`"3"`)},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, [][]string{
+		{"ds1", "http", `{"USD":1}`, "error: timeout", "yes"},
+		{"answer", "median", "-", `"3"`, ""},
+		{"(outputs)", "", "3", "error: uh oh", "yes"},
+	}, p.ToRows())
+
+	require.NoError(t, p.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "error: timeout")
+	assert.Contains(t, output, "(outputs)")
+}
+
 func TestJobRenderer_GetTasks(t *testing.T) {
 	t.Parallel()
 
@@ -405,3 +459,74 @@ func requireJobsCount(t *testing.T, orm job.ORM, expected int) {
 	require.NoError(t, err)
 	require.Len(t, jobs, expected)
 }
+
+func TestClient_TestPipeline(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	specPath := filepath.Join(dir, "spec.toml")
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(`
+type = "webhook"
+schemaVersion = 1
+observationSource = """
+ds1          [type=http method=GET url="https://example.com/price"];
+ds1_parse    [type=jsonparse path="USD"];
+ds1_multiply [type=multiply times=100];
+submit       [type=ethtx to="0x5FbDB2315678afecb367f032d93F642f64180aa3" data="0x01"];
+ds1 -> ds1_parse -> ds1_multiply -> submit;
+"""
+`), 0600))
+
+	newClient := func(t *testing.T) (*cmd.Client, *cltest.RendererMock) {
+		r := &cltest.RendererMock{}
+		return &cmd.Client{
+			Renderer: r,
+			Config:   cltest.NewTestGeneralConfig(t),
+			Logger:   logger.TestLogger(t),
+		}, r
+	}
+	newContext := func(fixtures string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String("fixtures", "", "")
+		fixturesPath := filepath.Join(dir, fmt.Sprintf("fixtures-%d.toml", time.Now().UnixNano()))
+		require.NoError(t, ioutil.WriteFile(fixturesPath, []byte(fixtures), 0600))
+		require.NoError(t, set.Parse([]string{"--fixtures", fixturesPath, specPath}))
+		return cli.NewContext(nil, set, nil)
+	}
+
+	t.Run("runs the pipeline against the fixtures", func(t *testing.T) {
+		client, r := newClient(t)
+
+		require.NoError(t, client.TestPipeline(newContext(`
+[[http]]
+url = "https://example.com/price"
+response = '{"USD": 1.5}'
+`)))
+
+		require.Len(t, r.Renders, 1)
+		p, ok := r.Renders[0].(*cmd.PipelineTestRunPresenter)
+		require.True(t, ok, "Expected Renders[0] to be *cmd.PipelineTestRunPresenter, got %T", r.Renders[0])
+		require.Len(t, p.TaskRuns, 4)
+		for _, tr := range p.TaskRuns {
+			assert.Nil(t, tr.Error, tr.DotID)
+		}
+		require.Len(t, p.Outputs, 1)
+		assert.Contains(t, *p.Outputs[0], "0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	})
+
+	t.Run("errors if the run has fatal errors", func(t *testing.T) {
+		client, r := newClient(t)
+
+		err := client.TestPipeline(newContext(`
+[[http]]
+url = "https://example.com/other"
+response = '{"USD": 1.5}'
+`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "fatal errors")
+
+		require.Len(t, r.Renders, 1)
+		p := r.Renders[0].(*cmd.PipelineTestRunPresenter)
+		require.NotNil(t, p.FatalErrors[0])
+	})
+}
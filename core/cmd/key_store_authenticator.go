@@ -8,13 +8,15 @@ import (
 	clipkg "github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 )
 
 // TerminalKeyStoreAuthenticator contains fields for prompting the user and an
-// exit code.
+// exit code, and for resolving passwords given as secret references.
 type TerminalKeyStoreAuthenticator struct {
 	Prompter Prompter
+	Secrets  secrets.Resolver
 }
 
 func (auth TerminalKeyStoreAuthenticator) authenticate(c *clipkg.Context, keyStore keystore.Master) error {
@@ -22,7 +24,7 @@ func (auth TerminalKeyStoreAuthenticator) authenticate(c *clipkg.Context, keySto
 	if err != nil {
 		return errors.Wrap(err, "error determining if keystore is empty")
 	}
-	password, err := passwordFromFile(c.String("password"))
+	password, err := passwordFromFileOrSecret(auth.Secrets, c.String("password"))
 	if err != nil {
 		return errors.Wrap(err, "error reading password from file")
 	}
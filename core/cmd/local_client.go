@@ -28,11 +28,18 @@ import (
 	"golang.org/x/sync/errgroup"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
+	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/shutdown"
 	"github.com/smartcontractkit/chainlink/core/static"
@@ -134,7 +141,7 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 	var vrfpwd string
 	var fileErr error
 	if len(c.String("vrfpassword")) != 0 {
-		vrfpwd, fileErr = passwordFromFile(c.String("vrfpassword"))
+		vrfpwd, fileErr = passwordFromFileOrSecret(cli.Config.SecretsResolver(), c.String("vrfpassword"))
 		if fileErr != nil {
 			return errors.Wrapf(fileErr,
 				"error reading VRF password from vrfpassword file \"%s\"",
@@ -235,6 +242,13 @@ func (cli *Client) runNode(c *clipkg.Context) error {
 		return nil
 	})
 
+	if cli.Config.ConfigFile() != "" {
+		grp.Go(func() error {
+			reloadConfigFileOnSIGHUP(grpCtx, app, lggr)
+			return nil
+		})
+	}
+
 	lggr.Debug("Environment variables\n", config.NewConfigPrinter(cli.Config))
 
 	lggr.Infow(fmt.Sprintf("Chainlink booted in %.2fs", time.Since(static.InitTime).Seconds()), "appID", app.ID())
@@ -320,6 +334,19 @@ func passwordFromFile(pwdFile string) (string, error) {
 	return strings.TrimSpace(string(dat)), err
 }
 
+// passwordFromFileOrSecret reads the password from the given file, or resolves
+// it if given a secret reference instead.
+func passwordFromFileOrSecret(resolver secrets.Resolver, pwdFileOrRef string) (string, error) {
+	if !secrets.IsRef(pwdFileOrRef) {
+		return passwordFromFile(pwdFileOrRef)
+	}
+	if resolver == nil {
+		return "", errors.New("secret references are not supported here")
+	}
+	pwd, err := resolver.Resolve(context.Background(), pwdFileOrRef)
+	return strings.TrimSpace(pwd), err
+}
+
 // RebroadcastTransactions run locally to force manual rebroadcasting of
 // transactions in a given nonce range.
 func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
@@ -361,7 +388,7 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 			err = multierr.Append(err, serr)
 		}
 	}()
-	pwd, err := passwordFromFile(c.String("password"))
+	pwd, err := passwordFromFileOrSecret(cli.Config.SecretsResolver(), c.String("password"))
 	if err != nil {
 		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
 	}
@@ -394,6 +421,81 @@ func (cli *Client) RebroadcastTransactions(c *clipkg.Context) (err error) {
 	return cli.errorOut(err)
 }
 
+// RelayPlugin serves the relayer of a network as a plugin of the node which
+// started this process. Only the EVM relayer can run as a plugin. The node
+// runs no EVM chains of its own while it does, so the plugin loads and runs
+// them alone: it builds only the keystore and the EVM chains, not a whole
+// application.
+func (cli *Client) RelayPlugin(c *clipkg.Context) (err error) {
+	network := relaytypes.Network(c.String("network"))
+	if network != relaytypes.EVM {
+		return cli.errorOut(errors.Errorf("network %s cannot run as a relay plugin", network))
+	}
+	if !cli.Config.EVMEnabled() {
+		return cli.errorOut(errors.New("EVM must be enabled to serve the EVM relayer"))
+	}
+
+	lggr := cli.Logger.Named("RelayPlugin")
+	// the node holds the DB lock, and has migrated the DB
+	db, err := pg.OpenUnlockedDB(cli.Config, lggr)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "opening DB"))
+	}
+	defer lggr.ErrorIfClosing(db, "db")
+
+	keyStore := keystore.New(db, utils.GetScryptParams(cli.Config), lggr, cli.Config)
+	pwd, err := passwordFromFileOrSecret(cli.Config.SecretsResolver(), c.String("password"))
+	if err != nil {
+		return cli.errorOut(fmt.Errorf("error reading password: %+v", err))
+	}
+	if err = keyStore.Unlock(pwd); err != nil {
+		return cli.errorOut(errors.Wrap(err, "error authenticating keystore"))
+	}
+
+	// Upsert EVM chains/nodes from ENV and the config file, as the node
+	// does not while its EVM chains are disabled
+	if err = evm.ClobberDBFromEnv(db, cli.Config, lggr); err != nil {
+		return cli.errorOut(err)
+	}
+	if err = evm.ClobberDBFromConfigFile(db, cli.Config.LoadedConfigFile(), lggr); err != nil {
+		return cli.errorOut(err)
+	}
+
+	eventBroadcaster := pg.NewEventBroadcaster(cli.Config.DatabaseURL(), cli.Config.DatabaseListenerMinReconnectInterval(), cli.Config.DatabaseListenerMaxReconnectDuration(), lggr, cli.Config.AppID())
+	chainSet, err := evm.LoadChainSet(evm.ChainSetOpts{
+		Config:           cli.Config,
+		Logger:           lggr,
+		DB:               db,
+		ORM:              evm.NewORM(db),
+		KeyStore:         keyStore.Eth(),
+		EventBroadcaster: eventBroadcaster,
+	})
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to load EVM chainset"))
+	}
+	for _, ch := range chainSet.Chains() {
+		if err = keyStore.Eth().EnsureKeys(ch.ID()); err != nil {
+			return cli.errorOut(errors.Wrap(err, "failed to ensure keystore keys"))
+		}
+	}
+
+	if err = eventBroadcaster.Start(); err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to start event broadcaster"))
+	}
+	defer func() {
+		err = multierr.Append(err, eventBroadcaster.Close())
+	}()
+	if err = chainSet.Start(); err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to start EVM chains"))
+	}
+	defer func() {
+		err = multierr.Append(err, chainSet.Close())
+	}()
+
+	relayer := evmrelay.NewRelayer(db, chainSet, lggr.Named("EVM"))
+	return cli.errorOut(plugin.Serve(relayer, evmrelay.OCR2SpecFromPlugin, lggr))
+}
+
 type HealthCheckPresenter struct {
 	webPresenters.Check
 }
@@ -608,6 +710,56 @@ func (cli *Client) CreateMigration(c *clipkg.Context) error {
 	return nil
 }
 
+// importRunsBatchSize is the number of archived runs imported per transaction
+const importRunsBatchSize = 100
+
+// ImportPipelineRuns imports the runs of a pipeline run archive, as written by
+// the reaper to JOB_PIPELINE_ARCHIVE_DIR, back into the database.
+func (cli *Client) ImportPipelineRuns(c *clipkg.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("You must specify the path of an archive"))
+	}
+	archivePath := c.Args().First()
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to open archive"))
+	}
+	defer cli.Logger.ErrorIfClosing(f, archivePath)
+
+	db, err := newConnection(cli.Config, cli.Logger)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to initialize orm"))
+	}
+	defer cli.Logger.ErrorIfClosing(db, "db")
+	orm := pipeline.NewORM(db, cli.Logger, cli.Config)
+
+	var read, imported int64
+	batch := make([]pipeline.ArchivedRun, 0, importRunsBatchSize)
+	importBatch := func() error {
+		n, ierr := orm.ImportArchivedRuns(batch)
+		imported += n
+		batch = batch[:0]
+		return ierr
+	}
+	err = pipeline.ReadArchive(f, func(run pipeline.ArchivedRun) error {
+		read++
+		batch = append(batch, run)
+		if len(batch) == importRunsBatchSize {
+			return importBatch()
+		}
+		return nil
+	})
+	if err == nil && len(batch) > 0 {
+		err = importBatch()
+	}
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	cli.Logger.Infof("Imported %d of %d archived runs (%d already existed)", imported, read, read-imported)
+	return nil
+}
+
 func newConnection(cfg config.GeneralConfig, lggr logger.Logger) (*sqlx.DB, error) {
 	parsed := cfg.DatabaseURL()
 	if parsed.String() == "" {
@@ -2,6 +2,7 @@ package cmd_test
 
 import (
 	"database/sql"
+	"errors"
 	"flag"
 	"fmt"
 	"math/big"
@@ -22,6 +23,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	secretsMocks "github.com/smartcontractkit/chainlink/core/secrets/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -207,10 +209,16 @@ func TestClient_RunNodeWithPasswords(t *testing.T) {
 		{"correct", "../internal/fixtures/correct_password.txt", true},
 		{"incorrect", "../internal/fixtures/incorrect_password.txt", false},
 		{"wrongfile", "doesntexist.txt", false},
+		{"secret", "secret://file/password", true},
+		{"missingsecret", "secret://file/missing", false},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
+			resolver := new(secretsMocks.Resolver)
+			resolver.On("Resolve", mock.Anything, "secret://file/password").Return("p4SsW0rD1!@#_\n", nil).Maybe()
+			resolver.On("Resolve", mock.Anything, "secret://file/missing").Return("", errors.New("no such file")).Maybe()
+
 			cfg := cltest.NewTestGeneralConfig(t)
 			db := pgtest.NewSqlxDB(t)
 			keyStore := cltest.NewKeyStore(t, db, cfg)
@@ -238,6 +246,7 @@ func TestClient_RunNodeWithPasswords(t *testing.T) {
 				Config:                 cfg,
 				Logger:                 logger.TestLogger(t),
 				AppFactory:             cltest.InstanceAppFactory{App: app},
+				KeyStoreAuthenticator:  cmd.TerminalKeyStoreAuthenticator{Secrets: resolver},
 				FallbackAPIInitializer: apiPrompt,
 				Runner:                 cltest.EmptyRunner{},
 			}
@@ -349,7 +358,7 @@ func TestClient_RunNodeWithAPICredentialsFile(t *testing.T) {
 				Config:                 cfg,
 				Logger:                 logger.TestLogger(t),
 				AppFactory:             cltest.InstanceAppFactory{App: app},
-				KeyStoreAuthenticator:  cmd.TerminalKeyStoreAuthenticator{prompter},
+				KeyStoreAuthenticator:  cmd.TerminalKeyStoreAuthenticator{Prompter: prompter},
 				FallbackAPIInitializer: apiPrompt,
 				Runner:                 cltest.EmptyRunner{},
 			}
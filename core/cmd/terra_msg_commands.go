@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// TerraMsgPresenter implements TableRenderer for a TerraMsgResource.
+type TerraMsgPresenter struct {
+	presenters.TerraMsgResource
+}
+
+// ToRow presents the TerraMsgResource as a slice of strings.
+func (p *TerraMsgPresenter) ToRow() []string {
+	var txHash string
+	if p.TxHash != nil {
+		txHash = *p.TxHash
+	}
+	row := []string{
+		p.GetID(),
+		p.ContractID,
+		p.State,
+		txHash,
+		strconv.Itoa(p.BroadcastCount),
+		p.GasPrice.String,
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+	return row
+}
+
+var terraMsgHeaders = []string{"ID", "Contract", "State", "Tx Hash", "Broadcasts", "Gas Price (uluna)", "Created", "Updated"}
+
+// RenderTable implements TableRenderer
+func (p TerraMsgPresenter) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	rows = append(rows, p.ToRow())
+	renderList(terraMsgHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// TerraMsgPresenters implements TableRenderer for a slice of TerraMsgPresenter.
+type TerraMsgPresenters []TerraMsgPresenter
+
+// RenderTable implements TableRenderer
+func (ps TerraMsgPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(terraMsgHeaders, rows, rt.Writer)
+
+	return nil
+}
+
+// IndexTerraMsgs lists the msgs queued by the transaction manager of a Terra
+// chain, by default those which are unstarted or broadcasted.
+func (cli *Client) IndexTerraMsgs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the id of the chain"))
+	}
+	uri := fmt.Sprintf("/v2/chains/terra/%s/msgs", url.PathEscape(c.Args().First()))
+	if state := c.String("state"); state != "" {
+		uri += "?state=" + url.QueryEscape(state)
+	}
+	return cli.getPage(uri, c.Int("page"), &TerraMsgPresenters{})
+}
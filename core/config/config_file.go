@@ -0,0 +1,120 @@
+package config
+
+import (
+	"net/url"
+	"os"
+	"reflect"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+)
+
+// secretSettings are redacted from the effective config file
+var secretSettings = map[string]bool{
+	"EXPLORER_ACCESS_KEY": true,
+	"EXPLORER_SECRET":     true,
+	"SECRETS_VAULT_TOKEN": true,
+}
+
+// secretURLSettings have their passwords redacted from the effective config file
+var secretURLSettings = map[string]bool{
+	"DATABASE_BACKUP_URL": true,
+	"DATABASE_URL":        true,
+}
+
+// ConfigFile is the path of an optional TOML config file, which declares
+// global settings, EVM chains and their nodes. Environment variables take
+// precedence over the global settings of the file.
+func (c *generalConfig) ConfigFile() string {
+	return c.viper.GetString(envvar.Name("ConfigFile"))
+}
+
+// LoadedConfigFile returns the config file which was loaded at startup, or nil
+// if CONFIG_FILE is not set.
+func (c *generalConfig) LoadedConfigFile() *configfile.File {
+	return c.configFile
+}
+
+// EffectiveConfigFile returns the effective configuration, merged from the
+// environment, the config file and defaults, in the form of a config file.
+// Secrets are redacted, and settings resolved from secret references are given
+// as the references.
+func (c *generalConfig) EffectiveConfigFile() *configfile.File {
+	f := &configfile.File{Global: make(map[string]interface{})}
+
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	for i := 0; i < schemaT.NumField(); i++ {
+		name := schemaT.Field(i).Tag.Get("env")
+		if configfile.EnvOnly(name) {
+			continue
+		}
+		if ref, ok := c.secretRefs[name]; ok {
+			f.Global[name] = ref
+			continue
+		}
+		str := c.viper.GetString(name)
+		if str == "" {
+			continue
+		}
+		f.Global[name] = redactSetting(name, str)
+	}
+	// These may have been changed at runtime
+	f.Global[envvar.Name("LogLevel")] = c.LogLevel().String()
+	f.Global[envvar.Name("LogSQL")] = strconv.FormatBool(c.LogSQL())
+
+	if c.configFile != nil {
+		f.EVM = c.configFile.EVM
+	}
+	return f
+}
+
+// loadConfigFile loads the config file, if any, and merges its global settings
+// beneath those of the environment.
+func (c *generalConfig) loadConfigFile() error {
+	path := c.ConfigFile()
+	if path == "" {
+		return nil
+	}
+
+	f, err := configfile.Load(path)
+	if err != nil {
+		return err
+	}
+	globals := make(map[string]interface{}, len(f.Global))
+	for k, v := range f.Globals() {
+		globals[k] = v
+	}
+	if err = c.viper.MergeConfigMap(globals); err != nil {
+		return errors.Wrap(err, "failed to merge config file")
+	}
+	c.configFile = f
+	return nil
+}
+
+// configFileGlobal returns the value of a global setting from the config file,
+// unless it is overridden by the environment.
+func (c *generalConfig) configFileGlobal(k string) (string, bool) {
+	if c.configFile == nil {
+		return "", false
+	}
+	if _, ok := os.LookupEnv(k); ok {
+		return "", false
+	}
+	return c.configFile.GlobalString(k)
+}
+
+func redactSetting(name, value string) string {
+	if secretSettings[name] {
+		return "xxxxx"
+	}
+	if secretURLSettings[name] {
+		if u, err := url.Parse(value); err == nil {
+			return u.Redacted()
+		}
+		return "xxxxx"
+	}
+	return value
+}
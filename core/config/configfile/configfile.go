@@ -0,0 +1,291 @@
+// Package configfile implements the TOML node config file, which declares
+// global settings, EVM chains and their nodes in a single place.
+//
+//	[Global]
+//	LOG_LEVEL = "debug"
+//	DEFAULT_HTTP_TIMEOUT = "30s"
+//
+//	[[EVM]]
+//	ChainID = "4"
+//	Enabled = true
+//
+//	[EVM.Config]
+//	EvmGasPriceDefault = "20000000000"
+//
+//	[[EVM.Nodes]]
+//	Name = "rinkeby-primary"
+//	WSURL = "wss://rinkeby.example/ws"
+//	HTTPURL = "https://rinkeby.example"
+//
+// Global settings are keyed by their environment variable names, which take
+// precedence over the file when both are set.
+package configfile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// envOnly are the settings which are read before the config file is loaded,
+// and so can only be set by environment variable.
+var envOnly = map[string]bool{
+	"CONFIG_FILE":  true,
+	"JSON_CONSOLE": true,
+	"LOG_FILE_DIR": true,
+	"LOG_SINKS":    true,
+	"LOG_TO_DISK":  true,
+	"LOG_UNIX_TS":  true,
+	"ROOT":         true,
+}
+
+// EnvOnly reports whether a global setting can only be set by environment
+// variable.
+func EnvOnly(key string) bool {
+	return envOnly[key]
+}
+
+// File is a parsed config file
+type File struct {
+	// Global maps environment variable names to their values
+	Global map[string]interface{} `toml:"Global,omitempty"`
+	EVM    []EVMChain             `toml:"EVM,omitempty"`
+}
+
+// EVMChain declares an EVM chain. Nodes, when given, replace all of the
+// chain's existing nodes.
+type EVMChain struct {
+	ChainID string `toml:"ChainID"`
+	// Enabled defaults to true
+	Enabled *bool `toml:"Enabled,omitempty"`
+	// Config overrides fields of types.ChainCfg
+	Config map[string]interface{} `toml:"Config,omitempty"`
+	Nodes  []EVMNode              `toml:"Nodes,omitempty"`
+}
+
+// EVMNode declares a node of an EVM chain
+type EVMNode struct {
+	Name     string `toml:"Name"`
+	WSURL    string `toml:"WSURL,omitempty"`
+	HTTPURL  string `toml:"HTTPURL,omitempty"`
+	SendOnly bool   `toml:"SendOnly,omitempty"`
+}
+
+// Load reads and validates the config file at path.
+func Load(path string) (*File, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read config file")
+	}
+	f, err := Parse(b)
+	return f, errors.Wrapf(err, "invalid config file %s", path)
+}
+
+// Parse decodes and validates a config file.
+func Parse(b []byte) (*File, error) {
+	var f File
+	if err := toml.NewDecoder(bytes.NewReader(b)).Strict(true).Decode(&f); err != nil {
+		return nil, err
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Validate checks global settings against the envvar schema, and chain
+// settings against types.ChainCfg.
+func (f *File) Validate() (err error) {
+	for _, key := range f.globalKeys() {
+		err = multierr.Append(err, f.validateGlobal(key))
+	}
+
+	chainIDs := make(map[string]bool)
+	nodeNames := make(map[string]bool)
+	for i, chain := range f.EVM {
+		id, idErr := chain.ID()
+		if idErr != nil {
+			err = multierr.Append(err, errors.Wrapf(idErr, "EVM[%d].ChainID", i))
+			continue
+		}
+		if chainIDs[id.String()] {
+			err = multierr.Append(err, errors.Errorf("EVM[%d].ChainID: chain %s is declared more than once", i, id))
+		}
+		chainIDs[id.String()] = true
+
+		if _, cfgErr := chain.ChainCfg(); cfgErr != nil {
+			err = multierr.Append(err, errors.Wrapf(cfgErr, "EVM.%s.Config", id))
+		}
+
+		for j, node := range chain.Nodes {
+			name := strings.ToLower(node.Name)
+			if name != "" && nodeNames[name] {
+				err = multierr.Append(err, errors.Errorf("EVM.%s.Nodes[%d].Name: node %q is declared more than once", id, j, node.Name))
+			}
+			nodeNames[name] = true
+			if nodeErr := node.validate(); nodeErr != nil {
+				err = multierr.Append(err, errors.Wrapf(nodeErr, "EVM.%s.Nodes[%d]", id, j))
+			}
+		}
+	}
+	return err
+}
+
+func (f *File) validateGlobal(key string) error {
+	if envOnly[key] {
+		return errors.Errorf("Global.%s: can only be set by environment variable", key)
+	}
+	field, ok := envvar.FieldByEnv(key)
+	if !ok {
+		return errors.Errorf("Global.%s: unknown setting", key)
+	}
+	str, err := stringify(f.Global[key])
+	if err != nil {
+		return errors.Wrapf(err, "Global.%s", key)
+	}
+	if _, err := envvar.ParseValue(field, str); err != nil {
+		return errors.Wrapf(err, "Global.%s: invalid value %q", key, str)
+	}
+	return nil
+}
+
+// GlobalString returns the value of a global setting, formatted as it would
+// be in the environment.
+func (f *File) GlobalString(key string) (string, bool) {
+	v, ok := f.Global[key]
+	if !ok {
+		return "", false
+	}
+	str, err := stringify(v)
+	return str, err == nil
+}
+
+// Globals returns all global settings, formatted as they would be in the
+// environment.
+func (f *File) Globals() map[string]string {
+	globals := make(map[string]string, len(f.Global))
+	for key := range f.Global {
+		if str, ok := f.GlobalString(key); ok {
+			globals[key] = str
+		}
+	}
+	return globals
+}
+
+// EVMChain returns the declaration of the chain with the given ID, or nil.
+func (f *File) EVMChain(id string) *EVMChain {
+	for i := range f.EVM {
+		if cid, err := f.EVM[i].ID(); err == nil && cid.String() == id {
+			return &f.EVM[i]
+		}
+	}
+	return nil
+}
+
+// Marshal encodes the file as TOML.
+func (f *File) Marshal() ([]byte, error) {
+	return toml.Marshal(f)
+}
+
+func (f *File) globalKeys() []string {
+	keys := make([]string, 0, len(f.Global))
+	for key := range f.Global {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ID parses the chain ID.
+func (c EVMChain) ID() (*utils.Big, error) {
+	id, ok := new(big.Int).SetString(c.ChainID, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid chain ID %q", c.ChainID)
+	}
+	return utils.NewBig(id), nil
+}
+
+// IsEnabled reports whether the chain is enabled, which it is by default.
+func (c EVMChain) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
+// ChainCfg decodes the chain's config overrides.
+func (c EVMChain) ChainCfg() (cfg types.ChainCfg, err error) {
+	if len(c.Config) == 0 {
+		return cfg, nil
+	}
+	b, err := json.Marshal(c.Config)
+	if err != nil {
+		return cfg, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&cfg)
+	return cfg, err
+}
+
+func (n EVMNode) validate() error {
+	if n.Name == "" {
+		return errors.New("Name is required")
+	}
+	if n.SendOnly {
+		if n.WSURL != "" {
+			return errors.New("WSURL must not be set for a send-only node")
+		}
+		if n.HTTPURL == "" {
+			return errors.New("HTTPURL is required for a send-only node")
+		}
+	} else if n.WSURL == "" {
+		return errors.New("WSURL is required")
+	}
+	for _, u := range []string{n.WSURL, n.HTTPURL} {
+		if u == "" {
+			continue
+		}
+		if _, err := url.ParseRequestURI(u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stringify formats a TOML value as it would be set in the environment. Lists
+// are separated by spaces.
+func stringify(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case []interface{}:
+		strs := make([]string, len(t))
+		for i, elem := range t {
+			str, err := stringify(elem)
+			if err != nil {
+				return "", err
+			}
+			strs[i] = str
+		}
+		return strings.Join(strs, " "), nil
+	}
+	return "", fmt.Errorf("unsupported value of type %T", v)
+}
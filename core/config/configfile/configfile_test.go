@@ -0,0 +1,171 @@
+package configfile_test
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const validFile = `
+[Global]
+LOG_LEVEL = "debug"
+DEFAULT_HTTP_LIMIT = 100
+P2P_BOOTSTRAP_PEERS = ["/dns4/a/tcp/1337/p2p/x", "/dns4/b/tcp/1337/p2p/y"]
+
+[[EVM]]
+ChainID = "4"
+
+[EVM.Config]
+EvmGasPriceDefault = "20000000000"
+MinIncomingConfirmations = 3
+
+[[EVM.Nodes]]
+Name = "rinkeby-primary"
+WSURL = "wss://rinkeby.example/ws"
+HTTPURL = "https://rinkeby.example"
+
+[[EVM.Nodes]]
+Name = "rinkeby-sendonly"
+HTTPURL = "https://rinkeby-backup.example"
+SendOnly = true
+
+[[EVM]]
+ChainID = "42"
+Enabled = false
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	f, err := configfile.Parse([]byte(validFile))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"LOG_LEVEL":           "debug",
+		"DEFAULT_HTTP_LIMIT":  "100",
+		"P2P_BOOTSTRAP_PEERS": "/dns4/a/tcp/1337/p2p/x /dns4/b/tcp/1337/p2p/y",
+	}, f.Globals())
+
+	require.Len(t, f.EVM, 2)
+	id, err := f.EVM[0].ID()
+	require.NoError(t, err)
+	assert.Equal(t, utils.NewBigI(4), id)
+	assert.True(t, f.EVM[0].IsEnabled())
+	assert.False(t, f.EVM[1].IsEnabled())
+
+	cfg, err := f.EVM[0].ChainCfg()
+	require.NoError(t, err)
+	assert.Equal(t, utils.NewBig(big.NewInt(20000000000)), cfg.EvmGasPriceDefault)
+	assert.Equal(t, null.IntFrom(3), cfg.MinIncomingConfirmations)
+
+	assert.Equal(t, []configfile.EVMNode{
+		{Name: "rinkeby-primary", WSURL: "wss://rinkeby.example/ws", HTTPURL: "https://rinkeby.example"},
+		{Name: "rinkeby-sendonly", HTTPURL: "https://rinkeby-backup.example", SendOnly: true},
+	}, f.EVM[0].Nodes)
+
+	assert.Equal(t, &f.EVM[1], f.EVMChain("42"))
+	assert.Nil(t, f.EVMChain("1"))
+}
+
+func TestParse_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name string
+		toml string
+		err  string
+	}{
+		{"unknown section", "[Foo]\nBar = 1", "undecoded keys"},
+		{"unknown global", "[Global]\nNOT_A_SETTING = 1", "Global.NOT_A_SETTING: unknown setting"},
+		{"env only global", "[Global]\nROOT = \"/tmp\"", "Global.ROOT: can only be set by environment variable"},
+		{"invalid global", "[Global]\nCHAINLINK_PORT = \"http\"", "Global.CHAINLINK_PORT: invalid value"},
+		{"invalid chain ID", "[[EVM]]\nChainID = \"rinkeby\"", `EVM[0].ChainID: invalid chain ID "rinkeby"`},
+		{"duplicate chain", "[[EVM]]\nChainID = \"4\"\n[[EVM]]\nChainID = \"4\"", "chain 4 is declared more than once"},
+		{"unknown chain config", "[[EVM]]\nChainID = \"4\"\n[EVM.Config]\nGasPrice = 1", "EVM.4.Config"},
+		{"node without name", "[[EVM]]\nChainID = \"4\"\n[[EVM.Nodes]]\nWSURL = \"wss://a\"", "EVM.4.Nodes[0]: Name is required"},
+		{"node without ws url", "[[EVM]]\nChainID = \"4\"\n[[EVM.Nodes]]\nName = \"a\"", "EVM.4.Nodes[0]: WSURL is required"},
+		{"send-only node with ws url", "[[EVM]]\nChainID = \"4\"\n[[EVM.Nodes]]\nName = \"a\"\nWSURL = \"wss://a\"\nHTTPURL = \"https://a\"\nSendOnly = true", "WSURL must not be set for a send-only node"},
+		{"duplicate node", "[[EVM]]\nChainID = \"4\"\n[[EVM.Nodes]]\nName = \"a\"\nWSURL = \"wss://a\"\n[[EVM]]\nChainID = \"42\"\n[[EVM.Nodes]]\nName = \"A\"\nWSURL = \"wss://b\"", `node "A" is declared more than once`},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := configfile.Parse([]byte(tt.toml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "chainlink.toml")
+	require.NoError(t, os.WriteFile(path, []byte(validFile), 0600))
+
+	f, err := configfile.Load(path)
+	require.NoError(t, err)
+
+	// A marshalled file parses back to the same declarations
+	b, err := f.Marshal()
+	require.NoError(t, err)
+	g, err := configfile.Parse(b)
+	require.NoError(t, err)
+	assert.Empty(t, configfile.Diff(f, g))
+
+	_, err = configfile.Load(filepath.Join(t.TempDir(), "missing.toml"))
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	prev, err := configfile.Parse([]byte(validFile))
+	require.NoError(t, err)
+
+	next, err := configfile.Parse([]byte(`
+[Global]
+LOG_LEVEL = "info"
+LOG_SQL = true
+DEFAULT_HTTP_LIMIT = 100
+
+[[EVM]]
+ChainID = "4"
+
+[EVM.Config]
+EvmGasPriceDefault = "30000000000"
+MinIncomingConfirmations = 3
+
+[[EVM.Nodes]]
+Name = "rinkeby-primary"
+WSURL = "wss://rinkeby.example/ws"
+
+[[EVM]]
+ChainID = "42"
+Enabled = true
+
+[[EVM]]
+ChainID = "137"
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, []configfile.Change{
+		{Path: "Global.LOG_LEVEL"},
+		{Path: "Global.LOG_SQL"},
+		{Path: "Global.P2P_BOOTSTRAP_PEERS", RequiresRestart: true},
+		{Path: "EVM.4.Config"},
+		{Path: "EVM.4.Nodes", RequiresRestart: true},
+		{Path: "EVM.42.Enabled"},
+		{Path: "EVM.137"},
+	}, configfile.Diff(prev, next))
+
+	assert.Empty(t, configfile.Diff(prev, prev))
+	assert.Len(t, configfile.Diff(nil, prev), 5)
+}
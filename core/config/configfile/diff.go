@@ -0,0 +1,79 @@
+package configfile
+
+import (
+	"reflect"
+)
+
+// liveGlobals are the global settings which may be changed without a restart
+var liveGlobals = map[string]bool{
+	"LOG_LEVEL": true,
+	"LOG_SQL":   true,
+}
+
+// Change is a setting which differs between two config files
+type Change struct {
+	// Path locates the setting, e.g. Global.LOG_LEVEL or EVM.4.Config
+	Path string
+	// RequiresRestart is true for changes which are not applied to a running
+	// node
+	RequiresRestart bool
+}
+
+func (c Change) String() string {
+	if c.RequiresRestart {
+		return c.Path + " (requires restart)"
+	}
+	return c.Path
+}
+
+// Diff returns the changes from prev to next, in a stable order. Chains which
+// were removed from the file are not reported, since the file only ever adds
+// and updates chains.
+func Diff(prev, next *File) (changes []Change) {
+	if prev == nil {
+		prev = &File{}
+	}
+	if next == nil {
+		next = &File{}
+	}
+
+	keys := next.globalKeys()
+	for _, key := range prev.globalKeys() {
+		if _, ok := next.Global[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	for _, key := range keys {
+		prevStr, prevOK := prev.GlobalString(key)
+		nextStr, nextOK := next.GlobalString(key)
+		if prevOK != nextOK || prevStr != nextStr {
+			changes = append(changes, Change{Path: "Global." + key, RequiresRestart: !liveGlobals[key]})
+		}
+	}
+
+	for _, chain := range next.EVM {
+		id, err := chain.ID()
+		if err != nil {
+			continue
+		}
+		path := "EVM." + id.String()
+		prevChain := prev.EVMChain(id.String())
+		if prevChain == nil {
+			changes = append(changes, Change{Path: path})
+			continue
+		}
+		if prevChain.IsEnabled() != chain.IsEnabled() {
+			changes = append(changes, Change{Path: path + ".Enabled"})
+		}
+		prevCfg, _ := prevChain.ChainCfg()
+		nextCfg, _ := chain.ChainCfg()
+		if !reflect.DeepEqual(prevCfg, nextCfg) {
+			changes = append(changes, Change{Path: path + ".Config"})
+		}
+		if !reflect.DeepEqual(prevChain.Nodes, chain.Nodes) {
+			// Running chains do not pick up new nodes
+			changes = append(changes, Change{Path: path + ".Nodes", RequiresRestart: true})
+		}
+	}
+	return changes
+}
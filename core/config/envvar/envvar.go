@@ -17,6 +17,7 @@ var (
 	JSONConsole = New("JSONConsole", parse.Bool)
 	LogToDisk   = New("LogToDisk", parse.Bool)
 	LogUnixTS   = New("LogUnixTS", parse.Bool)
+	LogSinks    = New("LogSinks", parse.String)
 )
 
 // EnvVar is an environment variable which
@@ -77,6 +78,10 @@ func (e *EnvVar) ParseFrom(get func(string) string) (v interface{}, invalid stri
 func (e *EnvVar) ParseString() (v string, invalid string) {
 	var i interface{}
 	i, invalid = e.Parse()
+	// unset strings without a default parse as their *string ZeroValue
+	if s, ok := i.(*string); ok {
+		return *s, invalid
+	}
 	return i.(string), invalid
 }
 
@@ -1,12 +1,15 @@
 package envvar
 
 import (
+	"encoding"
 	"fmt"
 	"log"
 	"math/big"
 	"net"
 	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
@@ -25,6 +28,7 @@ type ConfigSchema struct {
 
 	// General/misc
 	ChainType                    string          `env:"CHAIN_TYPE"`
+	ConfigFile                   string          `env:"CONFIG_FILE"`
 	Dev                          bool            `env:"CHAINLINK_DEV" default:"false"`
 	ExplorerAccessKey            string          `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret               string          `env:"EXPLORER_SECRET"`
@@ -66,10 +70,22 @@ type ConfigSchema struct {
 	JSONConsole bool          `env:"JSON_CONSOLE" default:"false"`
 	LogFileDir  string        `env:"LOG_FILE_DIR"`
 	LogLevel    zapcore.Level `env:"LOG_LEVEL"`
+	LogSinks    string        `env:"LOG_SINKS"`
 	LogSQL      bool          `env:"LOG_SQL" default:"false"`
 	LogToDisk   bool          `env:"LOG_TO_DISK" default:"false"`
 	LogUnixTS   bool          `env:"LOG_UNIX_TS" default:"false"`
 
+	// Tracing
+	TracingCollectorTarget string `env:"TRACING_COLLECTOR_TARGET"`
+	TracingEnabled         bool   `env:"TRACING_ENABLED" default:"false"`
+	TracingFilePath        string `env:"TRACING_FILE_PATH"`
+
+	// Secrets
+	SecretsCacheTTL   time.Duration `env:"SECRETS_CACHE_TTL" default:"1m"`
+	SecretsFileDir    string        `env:"SECRETS_FILE_DIR"`
+	SecretsVaultToken string        `env:"SECRETS_VAULT_TOKEN"`
+	SecretsVaultURL   *url.URL      `env:"SECRETS_VAULT_URL"`
+
 	// Web Server
 	AllowOrigins                   string          `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
 	AuthenticatedRateLimit         int64           `env:"AUTHENTICATED_RATE_LIMIT" default:"1000"`
@@ -103,6 +119,11 @@ type ConfigSchema struct {
 	SolanaEnabled bool `env:"SOLANA_ENABLED" default:"false"`
 	TerraEnabled  bool `env:"TERRA_ENABLED" default:"false"`
 
+	// Terra
+	TerraGasBumpPercent       uint16 `env:"TERRA_GAS_BUMP_PERCENT" default:"20"`
+	TerraMaxBroadcastAttempts uint32 `env:"TERRA_MAX_BROADCAST_ATTEMPTS" default:"5"`
+	TerraMaxGasPriceULuna     string `env:"TERRA_MAX_GAS_PRICE_ULUNA" default:"0.15"`
+
 	// EVM/Ethereum
 	// Legacy Eth ENV vars
 	EthereumHTTPURL       string `env:"ETH_HTTP_URL"`
@@ -161,10 +182,29 @@ type ConfigSchema struct {
 	DefaultHTTPLimit                          int64           `env:"DEFAULT_HTTP_LIMIT" default:"32768"`
 	DefaultHTTPTimeout                        models.Duration `env:"DEFAULT_HTTP_TIMEOUT" default:"15s"`
 	FeatureExternalInitiators                 bool            `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
+	JobPipelineArchiveDir                     string          `env:"JOB_PIPELINE_ARCHIVE_DIR"`
 	JobPipelineMaxRunDuration                 time.Duration   `env:"JOB_PIPELINE_MAX_RUN_DURATION" default:"10m"`
+	JobPipelineMetricsMaxBridges              uint32          `env:"JOB_PIPELINE_METRICS_MAX_BRIDGES" default:"100"`
+	JobPipelineMetricsMaxJobs                 uint32          `env:"JOB_PIPELINE_METRICS_MAX_JOBS" default:"500"`
+	JobPipelineReaperErroredThreshold         time.Duration   `env:"JOB_PIPELINE_REAPER_ERRORED_THRESHOLD"`
 	JobPipelineReaperInterval                 time.Duration   `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                time.Duration   `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
 	JobPipelineResultWriteQueueDepth          uint64          `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
+	JobPipelineRetentionPolicies              string          `env:"JOB_PIPELINE_RETENTION_POLICIES"`
+
+	// Alerts
+	AlertsChannels       string        `env:"ALERTS_CHANNELS"`
+	AlertsCheckInterval  time.Duration `env:"ALERTS_CHECK_INTERVAL" default:"30s"`
+	AlertsEnabled        bool          `env:"ALERTS_ENABLED" default:"false"`
+	AlertsRepeatInterval time.Duration `env:"ALERTS_REPEAT_INTERVAL" default:"4h"`
+	AlertsRules          string        `env:"ALERTS_RULES"`
+
+	// Streams
+	StreamMaxStaleness time.Duration `env:"STREAM_MAX_STALENESS" default:"1m"`
+	StreamSources      string        `env:"STREAM_SOURCES"`
+
+	// Relay plugins
+	RelayPlugins string `env:"RELAY_PLUGINS"`
 
 	// Flux Monitor
 	FMDefaultTransactionQueueDepth uint32 `env:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"` //nodoc
@@ -305,3 +345,83 @@ func ZeroValue(name string) interface{} {
 	log.Panicf("Invariant violated, no field of name %s found for ZeroValue", name)
 	return nil
 }
+
+// FieldByEnv looks up the name of the ConfigSchema field for an environment
+// variable name.
+func FieldByEnv(env string) (field string, ok bool) {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	for i := 0; i < schemaT.NumField(); i++ {
+		item := schemaT.Field(i)
+		if item.Tag.Get("env") == env {
+			return item.Name, true
+		}
+	}
+	return "", false
+}
+
+// ParseValue parses str into the type of the named field, or panics if it
+// does not exist. It is used to validate values which do not come from the
+// environment, such as those of a config file.
+func ParseValue(name, str string) (interface{}, error) {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	item, ok := schemaT.FieldByName(name)
+	if !ok {
+		log.Panicf("Invariant violated, no field of name %s found for ParseValue", name)
+	}
+
+	t := item.Type
+	switch t {
+	case reflect.TypeOf(time.Duration(0)):
+		return time.ParseDuration(str)
+	case reflect.TypeOf(models.Duration{}):
+		d, err := time.ParseDuration(str)
+		if err != nil {
+			return nil, err
+		}
+		return models.MakeDuration(d)
+	case reflect.TypeOf(url.URL{}), reflect.TypeOf(&url.URL{}):
+		return url.Parse(str)
+	case reflect.TypeOf([]string{}):
+		return strings.Fields(str), nil
+	}
+
+	elemT := t
+	if t.Kind() == reflect.Ptr {
+		elemT = t.Elem()
+	}
+	if v, ok := reflect.New(elemT).Interface().(encoding.TextUnmarshaler); ok {
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			return nil, err
+		}
+		if t.Kind() == reflect.Ptr {
+			return v, nil
+		}
+		return reflect.ValueOf(v).Elem().Interface(), nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return str, nil
+	case reflect.Bool:
+		return strconv.ParseBool(str)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(str, 10, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(i).Convert(t).Interface(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(str, 10, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(u).Convert(t).Interface(), nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(str, t.Bits())
+		if err != nil {
+			return nil, err
+		}
+		return reflect.ValueOf(f).Convert(t).Interface(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %s for field %s", t, name)
+}
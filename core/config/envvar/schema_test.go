@@ -1,11 +1,17 @@
 package envvar
 
 import (
+	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
-	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func TestConfigSchema(t *testing.T) {
@@ -13,6 +19,11 @@ func TestConfigSchema(t *testing.T) {
 		"AdminCredentialsFile":                           "ADMIN_CREDENTIALS_FILE",
 		"AdvisoryLockCheckInterval":                      "ADVISORY_LOCK_CHECK_INTERVAL",
 		"AdvisoryLockID":                                 "ADVISORY_LOCK_ID",
+		"AlertsChannels":                                 "ALERTS_CHANNELS",
+		"AlertsCheckInterval":                            "ALERTS_CHECK_INTERVAL",
+		"AlertsEnabled":                                  "ALERTS_ENABLED",
+		"AlertsRepeatInterval":                           "ALERTS_REPEAT_INTERVAL",
+		"AlertsRules":                                    "ALERTS_RULES",
 		"AllowOrigins":                                   "ALLOW_ORIGINS",
 		"AuthenticatedRateLimit":                         "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":                   "AUTHENTICATED_RATE_LIMIT_PERIOD",
@@ -40,6 +51,7 @@ func TestConfigSchema(t *testing.T) {
 		"BridgeResponseURL":                              "BRIDGE_RESPONSE_URL",
 		"ChainType":                                      "CHAIN_TYPE",
 		"ClientNodeURL":                                  "CLIENT_NODE_URL",
+		"ConfigFile":                                     "CONFIG_FILE",
 		"DatabaseBackupDir":                              "DATABASE_BACKUP_DIR",
 		"DatabaseBackupFrequency":                        "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                             "DATABASE_BACKUP_MODE",
@@ -108,10 +120,15 @@ func TestConfigSchema(t *testing.T) {
 		"InsecureFastScrypt":                             "INSECURE_FAST_SCRYPT",
 		"InsecureSkipVerify":                             "INSECURE_SKIP_VERIFY",
 		"JSONConsole":                                    "JSON_CONSOLE",
+		"JobPipelineArchiveDir":                          "JOB_PIPELINE_ARCHIVE_DIR",
 		"JobPipelineMaxRunDuration":                      "JOB_PIPELINE_MAX_RUN_DURATION",
+		"JobPipelineMetricsMaxBridges":                   "JOB_PIPELINE_METRICS_MAX_BRIDGES",
+		"JobPipelineMetricsMaxJobs":                      "JOB_PIPELINE_METRICS_MAX_JOBS",
+		"JobPipelineReaperErroredThreshold":              "JOB_PIPELINE_REAPER_ERRORED_THRESHOLD",
 		"JobPipelineReaperInterval":                      "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                     "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":               "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
+		"JobPipelineRetentionPolicies":                   "JOB_PIPELINE_RETENTION_POLICIES",
 		"KeeperCheckUpkeepGasPriceFeatureEnabled":        "KEEPER_CHECK_UPKEEP_GAS_PRICE_FEATURE_ENABLED",
 		"KeeperDefaultTransactionQueueDepth":             "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"KeeperGasPriceBufferPercent":                    "KEEPER_GAS_PRICE_BUFFER_PERCENT",
@@ -127,6 +144,7 @@ func TestConfigSchema(t *testing.T) {
 		"LogFileDir":                                     "LOG_FILE_DIR",
 		"LogLevel":                                       "LOG_LEVEL",
 		"LogSQL":                                         "LOG_SQL",
+		"LogSinks":                                       "LOG_SINKS",
 		"LogToDisk":                                      "LOG_TO_DISK",
 		"LogUnixTS":                                      "LOG_UNIX_TS",
 		"MaximumServiceDuration":                         "MAXIMUM_SERVICE_DURATION",
@@ -143,12 +161,19 @@ func TestConfigSchema(t *testing.T) {
 		"RPID":                                           "MFA_RPID",
 		"RPOrigin":                                       "MFA_RPORIGIN",
 		"ReaperExpiration":                               "REAPER_EXPIRATION",
+		"RelayPlugins":                                   "RELAY_PLUGINS",
 		"ReplayFromBlock":                                "REPLAY_FROM_BLOCK",
 		"RootDir":                                        "ROOT",
+		"SecretsCacheTTL":                                "SECRETS_CACHE_TTL",
+		"SecretsFileDir":                                 "SECRETS_FILE_DIR",
+		"SecretsVaultToken":                              "SECRETS_VAULT_TOKEN",
+		"SecretsVaultURL":                                "SECRETS_VAULT_URL",
 		"SecureCookies":                                  "SECURE_COOKIES",
 		"SessionTimeout":                                 "SESSION_TIMEOUT",
 		"ShutdownGracePeriod":                            "SHUTDOWN_GRACE_PERIOD",
 		"SolanaEnabled":                                  "SOLANA_ENABLED",
+		"StreamMaxStaleness":                             "STREAM_MAX_STALENESS",
+		"StreamSources":                                  "STREAM_SOURCES",
 		"TLSCertPath":                                    "TLS_CERT_PATH",
 		"TLSHost":                                        "CHAINLINK_TLS_HOST",
 		"TLSKeyPath":                                     "TLS_KEY_PATH",
@@ -162,6 +187,12 @@ func TestConfigSchema(t *testing.T) {
 		"TelemetryIngressURL":                            "TELEMETRY_INGRESS_URL",
 		"TelemetryIngressUseBatchSend":                   "TELEMETRY_INGRESS_USE_BATCH_SEND",
 		"TerraEnabled":                                   "TERRA_ENABLED",
+		"TerraGasBumpPercent":                            "TERRA_GAS_BUMP_PERCENT",
+		"TerraMaxBroadcastAttempts":                      "TERRA_MAX_BROADCAST_ATTEMPTS",
+		"TerraMaxGasPriceULuna":                          "TERRA_MAX_GAS_PRICE_ULUNA",
+		"TracingCollectorTarget":                         "TRACING_COLLECTOR_TARGET",
+		"TracingEnabled":                                 "TRACING_ENABLED",
+		"TracingFilePath":                                "TRACING_FILE_PATH",
 		"TriggerFallbackDBPollInterval":                  "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
 		"UnAuthenticatedRateLimit":                       "UNAUTHENTICATED_RATE_LIMIT",
 		"UnAuthenticatedRateLimitPeriod":                 "UNAUTHENTICATED_RATE_LIMIT_PERIOD",
@@ -260,3 +291,50 @@ func TestConfigSchema(t *testing.T) {
 		assert.Equal(t, item, env)
 	}
 }
+
+func TestFieldByEnv(t *testing.T) {
+	field, ok := FieldByEnv("LOG_LEVEL")
+	assert.True(t, ok)
+	assert.Equal(t, "LogLevel", field)
+
+	_, ok = FieldByEnv("NOT_A_CONFIG_VAR")
+	assert.False(t, ok)
+}
+
+func TestEnvVar_ParseString_NoDefault(t *testing.T) {
+	t.Setenv("LOG_SINKS", "")
+	sinks, invalid := LogSinks.ParseString()
+	assert.Empty(t, invalid)
+	assert.Equal(t, "", sinks)
+}
+
+func TestParseValue(t *testing.T) {
+	for _, tt := range []struct {
+		field string
+		str   string
+		exp   interface{}
+	}{
+		{"AllowOrigins", "http://localhost:3000", "http://localhost:3000"},
+		{"Dev", "true", true},
+		{"DefaultHTTPLimit", "42", int64(42)},
+		{"Port", "6688", uint16(6688)},
+		{"EvmGasLimitMultiplier", "1.5", float32(1.5)},
+		{"OCRDatabaseTimeout", "5s", 5 * time.Second},
+		{"SessionTimeout", "15m", models.MustMakeDuration(15 * time.Minute)},
+		{"LogLevel", "debug", zapcore.DebugLevel},
+		{"DefaultChainID", "42", big.NewInt(42)},
+		{"P2PBootstrapPeers", "/ip4/a /ip4/b", []string{"/ip4/a", "/ip4/b"}},
+		{"AutoPprofMaxProfileSize", "1mb", utils.FileSize(utils.MB)},
+	} {
+		t.Run(tt.field, func(t *testing.T) {
+			v, err := ParseValue(tt.field, tt.str)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, v)
+		})
+	}
+
+	_, err := ParseValue("Port", "not a port")
+	assert.Error(t, err)
+	_, err = ParseValue("LogLevel", "loud")
+	assert.Error(t, err)
+}
@@ -20,9 +20,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
 	"github.com/smartcontractkit/chainlink/core/config/envvar"
 	"github.com/smartcontractkit/chainlink/core/config/parse"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/store/dialects"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -70,6 +72,11 @@ type GeneralOnlyConfig interface {
 	AdminCredentialsFile() string
 	AdvisoryLockCheckInterval() time.Duration
 	AdvisoryLockID() int64
+	AlertsChannels() string
+	AlertsCheckInterval() time.Duration
+	AlertsEnabled() bool
+	AlertsRepeatInterval() time.Duration
+	AlertsRules() string
 	AllowOrigins() string
 	AppID() uuid.UUID
 	AuthenticatedRateLimit() int64
@@ -90,6 +97,7 @@ type GeneralOnlyConfig interface {
 	BridgeResponseURL() *url.URL
 	CertFile() string
 	ClientNodeURL() string
+	ConfigFile() string
 	DatabaseBackupDir() string
 	DatabaseBackupFrequency() time.Duration
 	DatabaseBackupMode() DatabaseBackupMode
@@ -105,6 +113,7 @@ type GeneralOnlyConfig interface {
 	DefaultHTTPTimeout() models.Duration
 	DefaultLogLevel() zapcore.Level
 	Dev() bool
+	EffectiveConfigFile() *configfile.File
 	ShutdownGracePeriod() time.Duration
 	EthereumHTTPURL() *url.URL
 	EthereumSecondaryURLs() []url.URL
@@ -121,8 +130,13 @@ type GeneralOnlyConfig interface {
 	InsecureSkipVerify() bool
 	JSONConsole() bool
 	JobPipelineMaxRunDuration() time.Duration
+	JobPipelineMetricsMaxBridges() uint32
+	JobPipelineMetricsMaxJobs() uint32
+	JobPipelineArchiveDir() string
+	JobPipelineReaperErroredThreshold() time.Duration
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
+	JobPipelineRetentionPolicies() string
 	JobPipelineResultWriteQueueDepth() uint64
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint32
@@ -135,6 +149,7 @@ type GeneralOnlyConfig interface {
 	KeyFile() string
 	LeaseLockDuration() time.Duration
 	LeaseLockRefreshInterval() time.Duration
+	LoadedConfigFile() *configfile.File
 	LogFileDir() string
 	LogLevel() zapcore.Level
 	LogSQL() bool
@@ -147,11 +162,19 @@ type GeneralOnlyConfig interface {
 	RPID() string
 	RPOrigin() string
 	ReaperExpiration() models.Duration
+	RelayPlugins() string
 	RootDir() string
+	SecretsCacheTTL() time.Duration
+	SecretsFileDir() string
+	SecretsResolver() secrets.Resolver
+	SecretsVaultToken() string
+	SecretsVaultURL() *url.URL
 	SecureCookies() bool
 	SessionOptions() sessions.Options
 	SessionSecret() ([]byte, error)
 	SessionTimeout() models.Duration
+	StreamMaxStaleness() time.Duration
+	StreamSources() string
 	TLSCertPath() string
 	TLSDir() string
 	TLSHost() string
@@ -165,6 +188,12 @@ type GeneralOnlyConfig interface {
 	TelemetryIngressMaxBatchSize() uint
 	TelemetryIngressSendInterval() time.Duration
 	TelemetryIngressUseBatchSend() bool
+	TerraGasBumpPercent() uint16
+	TerraMaxBroadcastAttempts() uint32
+	TerraMaxGasPriceULuna() string
+	TracingCollectorTarget() string
+	TracingEnabled() bool
+	TracingFilePath() string
 	TriggerFallbackDBPollInterval() time.Duration
 	UnAuthenticatedRateLimit() int64
 	UnAuthenticatedRateLimitPeriod() models.Duration
@@ -247,6 +276,9 @@ type generalConfig struct {
 	logMutex         sync.RWMutex
 	genAppID         sync.Once
 	appID            uuid.UUID
+	configFile       *configfile.File
+	secretsResolver  secrets.Resolver
+	secretRefs       map[string]string
 }
 
 // NewGeneralConfig returns the config with the environment variables set to their
@@ -287,14 +319,27 @@ func newGeneralConfigWithViper(v *viper.Viper, lggr logger.Logger) (config *gene
 		lggr.Warnf("Unable to load config file: %v\n", err)
 	}
 
+	if err = config.loadConfigFile(); err != nil {
+		lggr.Fatalf("Unable to load config file: %v", err)
+	}
+
+	config.secretsResolver = secrets.NewResolver(config)
+	if err = config.resolveSecrets(); err != nil {
+		lggr.Fatalf("Unable to resolve secrets: %v", err)
+	}
+
 	ll, invalid := envvar.LogLevel.ParseLogLevel()
 	if invalid != "" {
 		lggr.Error(invalid)
 	}
+	if str, ok := config.configFileGlobal(envvar.Name("LogLevel")); ok {
+		// Already validated by loadConfigFile
+		_ = ll.Set(str)
+	}
 	config.defaultLogLevel = ll
 
 	config.logLevel = config.defaultLogLevel
-	config.logSQL = viper.GetBool(envvar.Name("LogSQL"))
+	config.logSQL = v.GetBool(envvar.Name("LogSQL"))
 
 	return
 }
@@ -751,6 +796,18 @@ func (c *generalConfig) JobPipelineMaxRunDuration() time.Duration {
 	return c.getWithFallback("JobPipelineMaxRunDuration", parse.Duration).(time.Duration)
 }
 
+// JobPipelineMetricsMaxJobs is the number of jobs whose pipeline metrics are
+// labelled individually. Further jobs are counted together under "other".
+func (c *generalConfig) JobPipelineMetricsMaxJobs() uint32 {
+	return c.getWithFallback("JobPipelineMetricsMaxJobs", parse.Uint32).(uint32)
+}
+
+// JobPipelineMetricsMaxBridges is the number of bridges whose metrics are
+// labelled individually. Further bridges are counted together under "other".
+func (c *generalConfig) JobPipelineMetricsMaxBridges() uint32 {
+	return c.getWithFallback("JobPipelineMetricsMaxBridges", parse.Uint32).(uint32)
+}
+
 func (c *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return c.getWithFallback("JobPipelineResultWriteQueueDepth", parse.Uint64).(uint64)
 }
@@ -763,6 +820,69 @@ func (c *generalConfig) JobPipelineReaperThreshold() time.Duration {
 	return c.getWithFallback("JobPipelineReaperThreshold", parse.Duration).(time.Duration)
 }
 
+// JobPipelineReaperErroredThreshold is how long errored runs are kept for,
+// defaulting to JOB_PIPELINE_REAPER_THRESHOLD if zero
+func (c *generalConfig) JobPipelineReaperErroredThreshold() time.Duration {
+	return c.getWithFallback("JobPipelineReaperErroredThreshold", parse.Duration).(time.Duration)
+}
+
+// JobPipelineRetentionPolicies is a JSON object of per job type retention
+// policies, overriding the reaper thresholds
+func (c *generalConfig) JobPipelineRetentionPolicies() string {
+	return c.viper.GetString(envvar.Name("JobPipelineRetentionPolicies"))
+}
+
+// AlertsEnabled enables the embedded alert engine
+func (c *generalConfig) AlertsEnabled() bool {
+	return c.getWithFallback("AlertsEnabled", parse.Bool).(bool)
+}
+
+// AlertsCheckInterval is how often the alert rules are evaluated
+func (c *generalConfig) AlertsCheckInterval() time.Duration {
+	return c.getWithFallback("AlertsCheckInterval", parse.Duration).(time.Duration)
+}
+
+// AlertsRepeatInterval is how often notifications of an alert which is still
+// firing are repeated
+func (c *generalConfig) AlertsRepeatInterval() time.Duration {
+	return c.getWithFallback("AlertsRepeatInterval", parse.Duration).(time.Duration)
+}
+
+// AlertsRules is a JSON object of overrides of the built-in alert rules,
+// keyed by rule name
+func (c *generalConfig) AlertsRules() string {
+	return c.viper.GetString(envvar.Name("AlertsRules"))
+}
+
+// AlertsChannels is a JSON array of the channels alerts are notified to
+func (c *generalConfig) AlertsChannels() string {
+	return c.viper.GetString(envvar.Name("AlertsChannels"))
+}
+
+// StreamSources is a JSON object of the WebSocket and gRPC streams the node
+// subscribes to, keyed by source name
+func (c *generalConfig) StreamSources() string {
+	return c.viper.GetString(envvar.Name("StreamSources"))
+}
+
+// StreamMaxStaleness is how long a stream may go without a message before it
+// is considered unhealthy, unless overridden for the source
+func (c *generalConfig) StreamMaxStaleness() time.Duration {
+	return c.getWithFallback("StreamMaxStaleness", parse.Duration).(time.Duration)
+}
+
+// RelayPlugins is a JSON object of the relayer plugin processes the node runs,
+// keyed by the network they relay to
+func (c *generalConfig) RelayPlugins() string {
+	return c.viper.GetString(envvar.Name("RelayPlugins"))
+}
+
+// JobPipelineArchiveDir is the directory to which runs are exported before
+// being reaped. Runs are not archived if it is empty.
+func (c *generalConfig) JobPipelineArchiveDir() string {
+	return c.viper.GetString(envvar.Name("JobPipelineArchiveDir"))
+}
+
 // KeeperRegistryCheckGasOverhead is the amount of extra gas to provide checkUpkeep() calls
 // to account for the gas consumed by the keeper registry
 func (c *generalConfig) KeeperRegistryCheckGasOverhead() uint64 {
@@ -887,6 +1007,39 @@ func (c *generalConfig) TelemetryIngressLogging() bool {
 	return c.getWithFallback("TelemetryIngressLogging", parse.Bool).(bool)
 }
 
+// TerraGasBumpPercent is the percentage by which the gas price of Terra msgs
+// is bumped each time they are resubmitted after timing out
+func (c *generalConfig) TerraGasBumpPercent() uint16 {
+	return c.getWithFallback("TerraGasBumpPercent", parse.Uint16).(uint16)
+}
+
+// TerraMaxBroadcastAttempts is the number of times a Terra msg is broadcast
+// before it is marked as errored
+func (c *generalConfig) TerraMaxBroadcastAttempts() uint32 {
+	return c.viper.GetUint32(envvar.Name("TerraMaxBroadcastAttempts"))
+}
+
+// TerraMaxGasPriceULuna is the decimal uluna gas price above which Terra msgs
+// are never bumped
+func (c *generalConfig) TerraMaxGasPriceULuna() string {
+	return c.viper.GetString(envvar.Name("TerraMaxGasPriceULuna"))
+}
+
+// TracingEnabled turns on OpenTelemetry tracing of pipeline runs, transactions and RPC calls
+func (c *generalConfig) TracingEnabled() bool {
+	return c.getWithFallback("TracingEnabled", parse.Bool).(bool)
+}
+
+// TracingCollectorTarget is the host:port of an OTLP gRPC collector to export spans to
+func (c *generalConfig) TracingCollectorTarget() string {
+	return c.viper.GetString(envvar.Name("TracingCollectorTarget"))
+}
+
+// TracingFilePath is the path of a local file to which spans are appended as JSON, for use without a collector
+func (c *generalConfig) TracingFilePath() string {
+	return c.viper.GetString(envvar.Name("TracingFilePath"))
+}
+
 func (c *generalConfig) ORMMaxOpenConns() int {
 	return int(c.getWithFallback("ORMMaxOpenConns", parse.Uint16).(uint16))
 }
@@ -1110,6 +1263,9 @@ func parseDatabaseBackupMode(s string) (interface{}, error) {
 
 func (c *generalConfig) lookupEnv(k string, parse func(string) (interface{}, error)) (interface{}, bool) {
 	s, ok := os.LookupEnv(k)
+	if !ok {
+		s, ok = c.configFileGlobal(k)
+	}
 	if !ok {
 		return nil, false
 	}
@@ -11,6 +11,8 @@ import (
 
 	config "github.com/smartcontractkit/chainlink/core/config"
 
+	configfile "github.com/smartcontractkit/chainlink/core/config/configfile"
+
 	dialects "github.com/smartcontractkit/chainlink/core/store/dialects"
 
 	ethkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
@@ -25,6 +27,8 @@ import (
 
 	p2pkey "github.com/smartcontractkit/chainlink/core/services/keystore/keys/p2pkey"
 
+	secrets "github.com/smartcontractkit/chainlink/core/secrets"
+
 	sessions "github.com/gin-gonic/contrib/sessions"
 
 	time "time"
@@ -85,6 +89,76 @@ func (_m *GeneralConfig) AdvisoryLockID() int64 {
 	return r0
 }
 
+// AlertsChannels provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsChannels() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AlertsCheckInterval provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsCheckInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AlertsRepeatInterval provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsRepeatInterval() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// AlertsRules provides a mock function with given fields:
+func (_m *GeneralConfig) AlertsRules() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AllowOrigins provides a mock function with given fields:
 func (_m *GeneralConfig) AllowOrigins() string {
 	ret := _m.Called()
@@ -383,6 +457,20 @@ func (_m *GeneralConfig) ClientNodeURL() string {
 	return r0
 }
 
+// ConfigFile provides a mock function with given fields:
+func (_m *GeneralConfig) ConfigFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupDir provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupDir() string {
 	ret := _m.Called()
@@ -625,6 +713,22 @@ func (_m *GeneralConfig) EVMRPCEnabled() bool {
 	return r0
 }
 
+// EffectiveConfigFile provides a mock function with given fields:
+func (_m *GeneralConfig) EffectiveConfigFile() *configfile.File {
+	ret := _m.Called()
+
+	var r0 *configfile.File
+	if rf, ok := ret.Get(0).(func() *configfile.File); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*configfile.File)
+		}
+	}
+
+	return r0
+}
+
 // EthereumHTTPURL provides a mock function with given fields:
 func (_m *GeneralConfig) EthereumHTTPURL() *url.URL {
 	ret := _m.Called()
@@ -1858,6 +1962,20 @@ func (_m *GeneralConfig) JSONConsole() bool {
 	return r0
 }
 
+// JobPipelineArchiveDir provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineArchiveDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -1872,6 +1990,48 @@ func (_m *GeneralConfig) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineMetricsMaxBridges provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineMetricsMaxBridges() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineMetricsMaxJobs provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineMetricsMaxJobs() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineReaperErroredThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineReaperErroredThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *GeneralConfig) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -1914,6 +2074,20 @@ func (_m *GeneralConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
+// JobPipelineRetentionPolicies provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineRetentionPolicies() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // KeeperCheckUpkeepGasPriceFeatureEnabled provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperCheckUpkeepGasPriceFeatureEnabled() bool {
 	ret := _m.Called()
@@ -2082,6 +2256,22 @@ func (_m *GeneralConfig) LeaseLockRefreshInterval() time.Duration {
 	return r0
 }
 
+// LoadedConfigFile provides a mock function with given fields:
+func (_m *GeneralConfig) LoadedConfigFile() *configfile.File {
+	ret := _m.Called()
+
+	var r0 *configfile.File
+	if rf, ok := ret.Get(0).(func() *configfile.File); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*configfile.File)
+		}
+	}
+
+	return r0
+}
+
 // LogFileDir provides a mock function with given fields:
 func (_m *GeneralConfig) LogFileDir() string {
 	ret := _m.Called()
@@ -2964,6 +3154,20 @@ func (_m *GeneralConfig) ReaperExpiration() models.Duration {
 	return r0
 }
 
+// RelayPlugins provides a mock function with given fields:
+func (_m *GeneralConfig) RelayPlugins() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // RootDir provides a mock function with given fields:
 func (_m *GeneralConfig) RootDir() string {
 	ret := _m.Called()
@@ -2978,6 +3182,80 @@ func (_m *GeneralConfig) RootDir() string {
 	return r0
 }
 
+// SecretsCacheTTL provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// SecretsFileDir provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsFileDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsResolver provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsResolver() secrets.Resolver {
+	ret := _m.Called()
+
+	var r0 secrets.Resolver
+	if rf, ok := ret.Get(0).(func() secrets.Resolver); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(secrets.Resolver)
+		}
+	}
+
+	return r0
+}
+
+// SecretsVaultToken provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsVaultToken() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsVaultURL provides a mock function with given fields:
+func (_m *GeneralConfig) SecretsVaultURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
 // SecureCookies provides a mock function with given fields:
 func (_m *GeneralConfig) SecureCookies() bool {
 	ret := _m.Called()
@@ -3090,6 +3368,34 @@ func (_m *GeneralConfig) SolanaEnabled() bool {
 	return r0
 }
 
+// StreamMaxStaleness provides a mock function with given fields:
+func (_m *GeneralConfig) StreamMaxStaleness() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// StreamSources provides a mock function with given fields:
+func (_m *GeneralConfig) StreamSources() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TLSCertPath provides a mock function with given fields:
 func (_m *GeneralConfig) TLSCertPath() string {
 	ret := _m.Called()
@@ -3288,6 +3594,90 @@ func (_m *GeneralConfig) TerraEnabled() bool {
 	return r0
 }
 
+// TerraGasBumpPercent provides a mock function with given fields:
+func (_m *GeneralConfig) TerraGasBumpPercent() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// TerraMaxBroadcastAttempts provides a mock function with given fields:
+func (_m *GeneralConfig) TerraMaxBroadcastAttempts() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// TerraMaxGasPriceULuna provides a mock function with given fields:
+func (_m *GeneralConfig) TerraMaxGasPriceULuna() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *GeneralConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingFilePath provides a mock function with given fields:
+func (_m *GeneralConfig) TracingFilePath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+	"github.com/smartcontractkit/chainlink/core/config/parse"
+	"github.com/smartcontractkit/chainlink/core/secrets"
+)
+
+const resolveSecretsTimeout = 30 * time.Second
+
+// SecretsCacheTTL is how long resolved secrets are cached for before being
+// looked up again, which is how rotated secrets are picked up. Zero disables
+// caching.
+func (c *generalConfig) SecretsCacheTTL() time.Duration {
+	return c.getWithFallback("SecretsCacheTTL", parse.Duration).(time.Duration)
+}
+
+// SecretsFileDir is the directory beneath which secret://file references are
+// read.
+func (c *generalConfig) SecretsFileDir() string {
+	return c.viper.GetString(envvar.Name("SecretsFileDir"))
+}
+
+// SecretsResolver resolves secret:// references.
+func (c *generalConfig) SecretsResolver() secrets.Resolver {
+	return c.secretsResolver
+}
+
+// SecretsVaultToken is the token used to authenticate with the Vault server.
+func (c *generalConfig) SecretsVaultToken() string {
+	return c.viper.GetString(envvar.Name("SecretsVaultToken"))
+}
+
+// SecretsVaultURL is the address of a Vault compatible server, from whose KV
+// version 2 secrets engines secret://vault references are read.
+func (c *generalConfig) SecretsVaultURL() *url.URL {
+	rval := c.getWithFallback("SecretsVaultURL", parse.URL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: SecretsVaultURL returned as type %T", rval))
+	}
+}
+
+// resolveSecrets replaces settings which are secret references with the
+// secrets they reference. Settings are only resolved once, so rotating them
+// requires a restart.
+func (c *generalConfig) resolveSecrets() error {
+	c.secretRefs = make(map[string]string)
+
+	schemaT := reflect.TypeOf(envvar.ConfigSchema{})
+	for i := 0; i < schemaT.NumField(); i++ {
+		name := schemaT.Field(i).Tag.Get("env")
+		// The resolver itself cannot be configured with secrets
+		if strings.HasPrefix(name, "SECRETS_") {
+			continue
+		}
+		ref := c.viper.GetString(name)
+		if !secrets.IsRef(ref) {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), resolveSecretsTimeout)
+		value, err := c.secretsResolver.Resolve(ctx, ref)
+		cancel()
+		if err != nil {
+			return errors.Wrapf(err, "failed to resolve %s", name)
+		}
+		c.viper.Set(name, value)
+		c.secretRefs[name] = ref
+	}
+	return nil
+}
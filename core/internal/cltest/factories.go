@@ -60,8 +60,10 @@ func NewPeerID() p2ppeer.ID {
 }
 
 type BridgeOpts struct {
-	Name string
-	URL  string
+	Name          string
+	URL           string
+	OutgoingToken     string
+	SendOutgoingToken bool
 }
 
 // NewBridgeType create new bridge type given info slice
@@ -82,6 +84,8 @@ func NewBridgeType(t testing.TB, opts BridgeOpts) (*bridges.BridgeTypeAuthentica
 	} else {
 		btr.URL = WebURL(t, fmt.Sprintf("https://bridge.example.com/api?%s", rnd))
 	}
+	btr.OutgoingToken = opts.OutgoingToken
+	btr.SendOutgoingToken = opts.SendOutgoingToken
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	require.NoError(t, err)
@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	alerts "github.com/smartcontractkit/chainlink/core/services/alerts"
+
 	big "math/big"
 
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
@@ -12,6 +14,8 @@ import (
 
 	config "github.com/smartcontractkit/chainlink/core/config"
 
+	configfile "github.com/smartcontractkit/chainlink/core/config/configfile"
+
 	context "context"
 
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
@@ -64,6 +68,22 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// AlertEngine provides a mock function with given fields:
+func (_m *Application) AlertEngine() alerts.Engine {
+	ret := _m.Called()
+
+	var r0 alerts.Engine
+	if rf, ok := ret.Get(0).(func() alerts.Engine); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(alerts.Engine)
+		}
+	}
+
+	return r0
+}
+
 // BPTXMORM provides a mock function with given fields:
 func (_m *Application) BPTXMORM() bulletprooftxmanager.ORM {
 	ret := _m.Called()
@@ -346,6 +366,45 @@ func (_m *Application) PipelineORM() pipeline.ORM {
 	return r0
 }
 
+// PipelineRunner provides a mock function with given fields:
+func (_m *Application) PipelineRunner() pipeline.Runner {
+	ret := _m.Called()
+
+	var r0 pipeline.Runner
+	if rf, ok := ret.Get(0).(func() pipeline.Runner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.Runner)
+		}
+	}
+
+	return r0
+}
+
+// ReloadConfigFile provides a mock function with given fields:
+func (_m *Application) ReloadConfigFile() ([]configfile.Change, error) {
+	ret := _m.Called()
+
+	var r0 []configfile.Change
+	if rf, ok := ret.Get(0).(func() []configfile.Change); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]configfile.Change)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	ret := _m.Called(chainID, number)
@@ -360,6 +419,27 @@ func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64) error {
 	return r0
 }
 
+// ReplayJobRunV2 provides a mock function with given fields: ctx, jobID, runID, currentSpec
+func (_m *Application) ReplayJobRunV2(ctx context.Context, jobID int32, runID int64, currentSpec bool) (pipeline.Replay, error) {
+	ret := _m.Called(ctx, jobID, runID, currentSpec)
+
+	var r0 pipeline.Replay
+	if rf, ok := ret.Get(0).(func(context.Context, int32, int64, bool) pipeline.Replay); ok {
+		r0 = rf(ctx, jobID, runID, currentSpec)
+	} else {
+		r0 = ret.Get(0).(pipeline.Replay)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32, int64, bool) error); ok {
+		r1 = rf(ctx, jobID, runID, currentSpec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ResumeJobV2 provides a mock function with given fields: ctx, taskID, result
 func (_m *Application) ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error {
 	ret := _m.Called(ctx, taskID, result)
@@ -202,6 +202,16 @@ func NewLogger() Logger {
 		parseErrs = append(parseErrs, invalid)
 	}
 
+	sinks, invalid := envvar.LogSinks.ParseString()
+	if invalid != "" {
+		parseErrs = append(parseErrs, invalid)
+	}
+	var err error
+	c.Sinks, err = ParseSinkConfigs(sinks)
+	if err != nil {
+		parseErrs = append(parseErrs, fmt.Sprintf("Invalid value provided for LogSinks - no log sinks will be used: %v", err))
+	}
+
 	l := c.New()
 	for _, msg := range parseErrs {
 		l.Error(msg)
@@ -215,6 +225,7 @@ type Config struct {
 	JsonConsole bool
 	ToDisk      bool // if false, the Logger will only log to stdout.
 	UnixTS      bool
+	Sinks       []SinkConfig // additional destinations, see SinkConfig
 }
 
 // New returns a new Logger with pretty printing to stdout, prometheus counters, and sentry forwarding.
@@ -222,10 +233,22 @@ type Config struct {
 func (c *Config) New() Logger {
 	cfg := newProductionConfig(c.Dir, c.JsonConsole, c.ToDisk, c.UnixTS)
 	cfg.Level.SetLevel(c.LogLevel)
-	l, err := newZapLogger(cfg)
+	var opts []zap.Option
+	var sinkErr error
+	if len(c.Sinks) > 0 {
+		var opt zap.Option
+		opt, sinkErr = newSinkCores(c.Sinks, cfg.EncoderConfig)
+		if sinkErr == nil {
+			opts = append(opts, opt)
+		}
+	}
+	l, err := newZapLogger(cfg, opts...)
 	if err != nil {
 		log.Fatal(err)
 	}
+	if sinkErr != nil {
+		l.Errorw("Failed to open log sinks, logs will not be shipped", "err", sinkErr)
+	}
 	l = newSentryLogger(l)
 	return newPrometheusLogger(l)
 }
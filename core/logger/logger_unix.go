@@ -3,7 +3,10 @@
 
 package logger
 
-import "path/filepath"
+import (
+	"log/syslog"
+	"path/filepath"
+)
 
 func registerOSSinks() error {
 	return nil
@@ -14,3 +17,19 @@ func registerOSSinks() error {
 func logFileURI(configRootDir string) string {
 	return filepath.ToSlash(filepath.Join(configRootDir, "log.jsonl"))
 }
+
+// syslogWriter ships each encoded entry as a single syslog message. An empty
+// network and address connect to the local syslog daemon.
+type syslogWriter struct {
+	*syslog.Writer
+}
+
+func newSyslogWriter(network, address, tag string) (sinkWriter, error) {
+	w, err := syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_DAEMON, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogWriter{w}, nil
+}
+
+func (w *syslogWriter) Sync() error { return nil }
@@ -4,6 +4,7 @@
 package logger
 
 import (
+	"errors"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -28,3 +29,7 @@ func newWinFileSink(u *url.URL) (zap.Sink, error) {
 	// Remove leading slash left by url.Parse()
 	return os.OpenFile(u.Path[1:], os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
 }
+
+func newSyslogWriter(network, address, tag string) (sinkWriter, error) {
+	return nil, errors.New("syslog sinks are not supported on windows")
+}
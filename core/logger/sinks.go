@@ -0,0 +1,551 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Sink types supported by SinkConfig.Type
+const (
+	SinkTypeSyslog = "syslog"
+	SinkTypeHTTP   = "http"
+	SinkTypeFile   = "file"
+)
+
+const (
+	defaultHTTPSinkBatchSize     = 100
+	defaultHTTPSinkFlushInterval = 5 * time.Second
+	defaultHTTPSinkTimeout       = 10 * time.Second
+	defaultSyslogTag             = "chainlink"
+
+	// httpSinkMaxBatches bounds how many batches an http sink buffers while
+	// its endpoint is slow or down, beyond which entries are dropped
+	httpSinkMaxBatches = 10
+	// rotatingFilePruneInterval is how often backups older than maxAge are
+	// pruned, in case the file is not rotated for a while
+	rotatingFilePruneInterval = time.Hour
+)
+
+// SinkConfig configures an additional destination that log entries are
+// shipped to, alongside the console and LOG_TO_DISK file.
+//
+// Each sink has its own minimum level, and may sample entries from the
+// services returned by GetLogServices, e.g. {"HeadTracker": 0.1} keeps one in
+// ten HeadTracker entries.
+type SinkConfig struct {
+	Type     string             `json:"type"`
+	Level    zapcore.Level      `json:"level"`
+	Sampling map[string]float64 `json:"sampling,omitempty"`
+
+	// syslog
+	Network string `json:"network,omitempty"`
+	Address string `json:"address,omitempty"`
+	Tag     string `json:"tag,omitempty"`
+
+	// http
+	URL           string            `json:"url,omitempty"`
+	Labels        map[string]string `json:"labels,omitempty"`
+	BatchSize     int               `json:"batchSize,omitempty"`
+	FlushInterval Duration          `json:"flushInterval,omitempty"`
+
+	// file
+	Path       string   `json:"path,omitempty"`
+	MaxSizeMB  int      `json:"maxSizeMB,omitempty"`
+	MaxAge     Duration `json:"maxAge,omitempty"`
+	MaxBackups int      `json:"maxBackups,omitempty"`
+}
+
+// Duration is a time.Duration which unmarshals from a JSON string such as "5s".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// ParseSinkConfigs parses a JSON array of SinkConfig, as provided via LOG_SINKS.
+func ParseSinkConfigs(s string) ([]SinkConfig, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var sinks []SinkConfig
+	if err := json.Unmarshal([]byte(s), &sinks); err != nil {
+		return nil, errors.Wrap(err, "invalid log sinks")
+	}
+	for i, sink := range sinks {
+		if err := sink.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid log sink %d", i)
+		}
+	}
+	return sinks, nil
+}
+
+func (s SinkConfig) validate() error {
+	switch s.Type {
+	case SinkTypeSyslog:
+	case SinkTypeHTTP:
+		if s.URL == "" {
+			return errors.New("url is required for http sinks")
+		}
+	case SinkTypeFile:
+		if s.Path == "" {
+			return errors.New("path is required for file sinks")
+		}
+		if s.MaxSizeMB < 0 || s.MaxBackups < 0 || s.MaxAge < 0 {
+			return errors.New("maxSizeMB, maxAge and maxBackups must not be negative")
+		}
+	default:
+		return errors.Errorf("unknown sink type %q", s.Type)
+	}
+	services := GetLogServices()
+	for svc, rate := range s.Sampling {
+		if !contains(services, svc) {
+			return errors.Errorf("cannot sample unknown service %q, expected one of %v", svc, services)
+		}
+		if rate < 0 || rate > 1 {
+			return errors.Errorf("sampling rate for %s must be between 0 and 1, got %v", svc, rate)
+		}
+	}
+	return nil
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// newSinkCores opens every configured sink and returns a zap option teeing
+// entries to them. Sinks live for the lifetime of the process, and are
+// flushed by Logger.Sync.
+func newSinkCores(sinks []SinkConfig, encCfg zapcore.EncoderConfig) (zap.Option, error) {
+	var cores []zapcore.Core
+	var closers sinkClosers
+	for _, s := range sinks {
+		w, err := s.open()
+		if err != nil {
+			return nil, multierr.Combine(errors.Wrapf(err, "failed to open %s log sink", s.Type), closers.Close())
+		}
+		closers = append(closers, w)
+		var core zapcore.Core = zapcore.NewCore(zapcore.NewJSONEncoder(encCfg), w, zap.NewAtomicLevelAt(s.Level))
+		if len(s.Sampling) > 0 {
+			core = newServiceSamplerCore(core, s.Sampling)
+		}
+		cores = append(cores, core)
+	}
+	opt := zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(append([]zapcore.Core{c}, cores...)...)
+	})
+	return opt, nil
+}
+
+type sinkWriter interface {
+	zapcore.WriteSyncer
+	io.Closer
+}
+
+type sinkClosers []sinkWriter
+
+func (cs sinkClosers) Close() (err error) {
+	for _, c := range cs {
+		err = multierr.Combine(err, c.Close())
+	}
+	return
+}
+
+func (s SinkConfig) open() (sinkWriter, error) {
+	switch s.Type {
+	case SinkTypeSyslog:
+		tag := s.Tag
+		if tag == "" {
+			tag = defaultSyslogTag
+		}
+		return newSyslogWriter(s.Network, s.Address, tag)
+	case SinkTypeHTTP:
+		return newHTTPSink(s.URL, s.Labels, s.BatchSize, time.Duration(s.FlushInterval)), nil
+	case SinkTypeFile:
+		return newRotatingFile(s.Path, int64(s.MaxSizeMB)*1024*1024, time.Duration(s.MaxAge), s.MaxBackups)
+	}
+	return nil, errors.Errorf("unknown sink type %q", s.Type)
+}
+
+// serviceSamplerCore drops a fraction of the entries logged by the services
+// named in rates. Entries from other loggers are always kept.
+type serviceSamplerCore struct {
+	zapcore.Core
+	samplers map[string]*sampler
+}
+
+func newServiceSamplerCore(core zapcore.Core, rates map[string]float64) zapcore.Core {
+	samplers := make(map[string]*sampler, len(rates))
+	for svc, rate := range rates {
+		samplers[svc] = &sampler{rate: rate}
+	}
+	return &serviceSamplerCore{Core: core, samplers: samplers}
+}
+
+func (c *serviceSamplerCore) With(fields []zapcore.Field) zapcore.Core {
+	return &serviceSamplerCore{Core: c.Core.With(fields), samplers: c.samplers}
+}
+
+func (c *serviceSamplerCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if !c.Enabled(ent.Level) {
+		return ce
+	}
+	if s := c.samplerFor(ent.LoggerName); s != nil && !s.sample() {
+		return ce
+	}
+	return ce.AddCore(ent, c)
+}
+
+// samplerFor returns the sampler of the first service found among the
+// dot-separated segments of name, e.g. "1.2.0@abc.EVM.1.HeadTracker".
+func (c *serviceSamplerCore) samplerFor(name string) *sampler {
+	for _, seg := range strings.Split(name, ".") {
+		if s, ok := c.samplers[seg]; ok {
+			return s
+		}
+	}
+	return nil
+}
+
+// sampler deterministically keeps rate of the entries it sees, spreading
+// them evenly rather than randomly.
+type sampler struct {
+	mu   sync.Mutex
+	rate float64
+	acc  float64
+}
+
+func (s *sampler) sample() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.acc += s.rate
+	if s.acc >= 1 {
+		s.acc--
+		return true
+	}
+	return false
+}
+
+var httpSinkDroppedCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "log_http_sink_dropped_count",
+	Help: "Number of log entries dropped by http log sinks because their buffer was full",
+})
+
+// httpSink batches encoded entries and pushes them to a Loki compatible
+// push API endpoint (/loki/api/v1/push). Batches are pushed in the
+// background, so that logging never waits on the endpoint; while it is slow
+// or down, at most httpSinkMaxBatches batches are buffered and further
+// entries are dropped.
+type httpSink struct {
+	url    string
+	labels map[string]string
+	client *http.Client
+
+	mu         sync.Mutex
+	batch      [][2]string
+	batchSize  int
+	maxEntries int
+	dropped    int64
+
+	chFlush chan struct{}
+	chStop  chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newHTTPSink(url string, labels map[string]string, batchSize int, flushInterval time.Duration) *httpSink {
+	if batchSize <= 0 {
+		batchSize = defaultHTTPSinkBatchSize
+	}
+	if flushInterval <= 0 {
+		flushInterval = defaultHTTPSinkFlushInterval
+	}
+	if len(labels) == 0 {
+		labels = map[string]string{"app": "chainlink"}
+	}
+	s := &httpSink{
+		url:        url,
+		labels:     labels,
+		client:     &http.Client{Timeout: defaultHTTPSinkTimeout},
+		batchSize:  batchSize,
+		maxEntries: batchSize * httpSinkMaxBatches,
+		chFlush:    make(chan struct{}, 1),
+		chStop:     make(chan struct{}),
+	}
+	s.wg.Add(1)
+	go s.run(flushInterval)
+	return s
+}
+
+func (s *httpSink) run(flushInterval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.chStop:
+			return
+		case <-ticker.C:
+		case <-s.chFlush:
+		}
+		if err := s.Sync(); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to push logs to %s: %v\n", s.url, err)
+		}
+	}
+}
+
+// Write buffers a single encoded entry, and signals the background flusher
+// once a batch is full. Entries are dropped while the buffer is full.
+func (s *httpSink) Write(p []byte) (int, error) {
+	line := strings.TrimSuffix(string(p), "\n")
+	s.mu.Lock()
+	if len(s.batch) >= s.maxEntries {
+		s.dropped++
+		s.mu.Unlock()
+		httpSinkDroppedCounter.Inc()
+		return len(p), nil
+	}
+	s.batch = append(s.batch, [2]string{strconv.FormatInt(time.Now().UnixNano(), 10), line})
+	full := len(s.batch) >= s.batchSize
+	s.mu.Unlock()
+	if full {
+		select {
+		case s.chFlush <- struct{}{}:
+		default:
+		}
+	}
+	return len(p), nil
+}
+
+// Sync pushes any buffered entries, in batches of at most batchSize.
+func (s *httpSink) Sync() error {
+	s.mu.Lock()
+	entries := s.batch
+	s.batch = nil
+	dropped := s.dropped
+	s.dropped = 0
+	s.mu.Unlock()
+	if dropped > 0 {
+		fmt.Fprintf(os.Stderr, "dropped %d log entries bound for %s: buffer full\n", dropped, s.url)
+	}
+	for len(entries) > 0 {
+		n := s.batchSize
+		if n > len(entries) {
+			n = len(entries)
+		}
+		if err := s.push(entries[:n]); err != nil {
+			return err
+		}
+		entries = entries[n:]
+	}
+	return nil
+}
+
+func (s *httpSink) push(batch [][2]string) error {
+	body, err := json.Marshal(lokiPushRequest{Streams: []lokiStream{{Stream: s.labels, Values: batch}}})
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	close(s.chStop)
+	s.wg.Wait()
+	return s.Sync()
+}
+
+type lokiPushRequest struct {
+	Streams []lokiStream `json:"streams"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// rotatingFile is a file which is rotated once it would exceed maxSize.
+// Rotated files are renamed with a timestamp suffix, and pruned once older
+// than maxAge or once there are more than maxBackups of them. A zero limit
+// disables the corresponding check. Backups are pruned when the file is
+// opened, on rotation, and every rotatingFilePruneInterval if maxAge is set.
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	maxAge     time.Duration
+	maxBackups int
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+func newRotatingFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*rotatingFile, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f := &rotatingFile{path: path, maxSize: maxSize, maxAge: maxAge, maxBackups: maxBackups, chStop: make(chan struct{})}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	if err := f.prune(); err != nil {
+		return nil, multierr.Combine(err, f.file.Close())
+	}
+	if maxAge > 0 {
+		interval := rotatingFilePruneInterval
+		if maxAge < interval {
+			interval = maxAge
+		}
+		f.wg.Add(1)
+		go f.runPrune(interval)
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) runPrune(interval time.Duration) {
+	defer f.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-f.chStop:
+			return
+		case <-ticker.C:
+			f.mu.Lock()
+			err := f.prune()
+			f.mu.Unlock()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "failed to prune log backups of %s: %v\n", f.path, err)
+			}
+		}
+	}
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return multierr.Combine(err, file.Close())
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	rotated := fmt.Sprintf("%s.%s", f.path, time.Now().UTC().Format("20060102T150405.000000000"))
+	if err := os.Rename(f.path, rotated); err != nil {
+		return err
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	return f.prune()
+}
+
+// backups returns the rotated files, newest first.
+func (f *rotatingFile) backups() ([]string, error) {
+	matches, err := filepath.Glob(f.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(matches)))
+	return matches, nil
+}
+
+func (f *rotatingFile) prune() error {
+	backups, err := f.backups()
+	if err != nil {
+		return err
+	}
+	var merr error
+	for i, b := range backups {
+		remove := f.maxBackups > 0 && i >= f.maxBackups
+		if !remove && f.maxAge > 0 {
+			if info, err := os.Stat(b); err == nil && time.Since(info.ModTime()) > f.maxAge {
+				remove = true
+			}
+		}
+		if remove {
+			merr = multierr.Combine(merr, os.Remove(b))
+		}
+	}
+	return merr
+}
+
+func (f *rotatingFile) Sync() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Sync()
+}
+
+func (f *rotatingFile) Close() error {
+	close(f.chStop)
+	f.wg.Wait()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.file.Close()
+}
@@ -0,0 +1,253 @@
+package logger
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestParseSinkConfigs(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		sinks, err := ParseSinkConfigs("")
+		require.NoError(t, err)
+		assert.Empty(t, sinks)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		sinks, err := ParseSinkConfigs(`[
+			{"type": "syslog", "level": "warn"},
+			{"type": "http", "level": "info", "url": "http://localhost:3100/loki/api/v1/push", "flushInterval": "1s", "sampling": {"HeadTracker": 0.1}},
+			{"type": "file", "level": "debug", "path": "/tmp/chainlink.jsonl", "maxSizeMB": 10, "maxAge": "24h", "maxBackups": 3}
+		]`)
+		require.NoError(t, err)
+		require.Len(t, sinks, 3)
+
+		assert.Equal(t, SinkTypeSyslog, sinks[0].Type)
+		assert.Equal(t, zapcore.WarnLevel, sinks[0].Level)
+
+		assert.Equal(t, SinkTypeHTTP, sinks[1].Type)
+		assert.Equal(t, Duration(time.Second), sinks[1].FlushInterval)
+		assert.Equal(t, map[string]float64{HeadTracker: 0.1}, sinks[1].Sampling)
+
+		assert.Equal(t, SinkTypeFile, sinks[2].Type)
+		assert.Equal(t, zapcore.DebugLevel, sinks[2].Level)
+		assert.Equal(t, Duration(24*time.Hour), sinks[2].MaxAge)
+		assert.Equal(t, 3, sinks[2].MaxBackups)
+	})
+
+	for _, tt := range []struct {
+		name, json string
+	}{
+		{"not json", `syslog`},
+		{"unknown type", `[{"type": "kafka"}]`},
+		{"http without url", `[{"type": "http"}]`},
+		{"file without path", `[{"type": "file"}]`},
+		{"negative size", `[{"type": "file", "path": "a", "maxSizeMB": -1}]`},
+		{"unknown service", `[{"type": "syslog", "sampling": {"Foo": 0.5}}]`},
+		{"rate out of range", `[{"type": "syslog", "sampling": {"Keeper": 2}}]`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSinkConfigs(tt.json)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestServiceSamplerCore(t *testing.T) {
+	obs, logs := observer.New(zapcore.DebugLevel)
+	zl := zap.New(newServiceSamplerCore(obs, map[string]float64{HeadTracker: 0.25, Keeper: 0}))
+
+	ht := zl.Named("1.0@abc").Named("EVM").Named(HeadTracker).With(zap.Int("n", 1))
+	keeper := zl.Named(Keeper)
+	other := zl.Named(FluxMonitor)
+	for i := 0; i < 100; i++ {
+		ht.Info("head")
+		keeper.Info("keeper")
+		other.Info("other")
+	}
+
+	assert.Equal(t, 25, logs.FilterMessage("head").Len())
+	assert.Equal(t, 0, logs.FilterMessage("keeper").Len())
+	assert.Equal(t, 100, logs.FilterMessage("other").Len())
+}
+
+func TestHTTPSink(t *testing.T) {
+	var mu sync.Mutex
+	var pushed []lokiPushRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req lokiPushRequest
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			mu.Lock()
+			pushed = append(pushed, req)
+			mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	s := newHTTPSink(srv.URL, map[string]string{"app": "test"}, 2, time.Hour)
+	_, err := s.Write([]byte(`{"msg":"a"}` + "\n"))
+	require.NoError(t, err)
+	mu.Lock()
+	assert.Empty(t, pushed, "batch should not be pushed before it is full")
+	mu.Unlock()
+
+	_, err = s.Write([]byte(`{"msg":"b"}` + "\n"))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(pushed) == 1
+	}, 5*time.Second, 10*time.Millisecond, "full batch should be pushed in the background")
+	_, err = s.Write([]byte(`{"msg":"c"}` + "\n"))
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, pushed, 2)
+	require.Len(t, pushed[0].Streams, 1)
+	assert.Equal(t, map[string]string{"app": "test"}, pushed[0].Streams[0].Stream)
+	require.Len(t, pushed[0].Streams[0].Values, 2)
+	assert.Equal(t, `{"msg":"a"}`, pushed[0].Streams[0].Values[0][1])
+	assert.Equal(t, `{"msg":"b"}`, pushed[0].Streams[0].Values[1][1])
+	require.Len(t, pushed[1].Streams[0].Values, 1)
+	assert.Equal(t, `{"msg":"c"}`, pushed[1].Streams[0].Values[0][1])
+}
+
+func TestHTTPSink_Overflow(t *testing.T) {
+	chUnblock := make(chan struct{})
+	var mu sync.Mutex
+	var values int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-chUnblock
+		var req lokiPushRequest
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&req)) {
+			mu.Lock()
+			values += len(req.Streams[0].Values)
+			mu.Unlock()
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	s := newHTTPSink(srv.URL, nil, 1, time.Hour)
+	// the first entry is pushed, and blocks the flusher on the endpoint
+	_, err := s.Write([]byte(`{"msg":"first"}` + "\n"))
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.batch) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// writes neither block on the endpoint, nor buffer without bound
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 2*httpSinkMaxBatches; i++ {
+			_, err := s.Write([]byte(`{"msg":"more"}` + "\n"))
+			assert.NoError(t, err)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Write blocked on the endpoint")
+	}
+	s.mu.Lock()
+	assert.Len(t, s.batch, httpSinkMaxBatches)
+	assert.Equal(t, int64(httpSinkMaxBatches), s.dropped)
+	s.mu.Unlock()
+
+	close(chUnblock)
+	require.NoError(t, s.Close())
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1+httpSinkMaxBatches, values)
+}
+
+func TestRotatingFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "sub", "chainlink.jsonl")
+	f, err := newRotatingFile(path, 10, 0, 2)
+	require.NoError(t, err)
+
+	line := []byte("123456789\n")
+	for i := 0; i < 5; i++ {
+		_, err = f.Write(line)
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.Sync())
+	require.NoError(t, f.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, string(line), string(b))
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Len(t, backups, 2, "older backups should have been pruned")
+	for _, b := range backups {
+		assert.True(t, strings.HasPrefix(filepath.Base(b), "chainlink.jsonl."))
+	}
+}
+
+func TestConfig_New_Sinks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sink.jsonl")
+	c := Config{
+		LogLevel: zapcore.InfoLevel,
+		Sinks: []SinkConfig{{
+			Type:     SinkTypeFile,
+			Level:    zapcore.DebugLevel,
+			Path:     path,
+			Sampling: map[string]float64{Keeper: 0},
+		}},
+	}
+	lggr := c.New()
+	lggr.Debug("shipped debug")
+	lggr.Named(Keeper).Info("sampled out")
+
+	root, err := lggr.NewRootLogger(zapcore.ErrorLevel)
+	require.NoError(t, err)
+	root.Info("shipped from root")
+	_ = lggr.Sync()
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "shipped debug")
+	assert.Contains(t, string(b), "shipped from root")
+	assert.NotContains(t, string(b), "sampled out")
+}
+
+func TestRotatingFile_PruneOnOpen(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "chainlink.jsonl")
+	old := path + ".20220101T000000.000000000"
+	recent := path + ".20220102T000000.000000000"
+	for _, b := range []string{old, recent} {
+		require.NoError(t, os.WriteFile(b, []byte("backup\n"), 0600))
+	}
+	twoDaysAgo := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(old, twoDaysAgo, twoDaysAgo))
+
+	f, err := newRotatingFile(path, 0, 24*time.Hour, 0)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, f.Close()) })
+
+	backups, err := filepath.Glob(path + ".*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{recent}, backups)
+}
@@ -15,17 +15,18 @@ var _ Logger = &zapLogger{}
 type zapLogger struct {
 	*zap.SugaredLogger
 	config     zap.Config
+	opts       []zap.Option
 	name       string
 	fields     []interface{}
 	callerSkip int
 }
 
-func newZapLogger(cfg zap.Config) (Logger, error) {
-	zl, err := cfg.Build()
+func newZapLogger(cfg zap.Config, opts ...zap.Option) (Logger, error) {
+	zl, err := cfg.Build(opts...)
 	if err != nil {
 		return nil, err
 	}
-	return &zapLogger{config: cfg, SugaredLogger: zl.Sugar()}, nil
+	return &zapLogger{config: cfg, opts: opts, SugaredLogger: zl.Sugar()}, nil
 }
 
 func (l *zapLogger) SetLogLevel(lvl zapcore.Level) {
@@ -65,7 +66,7 @@ func (l *zapLogger) Named(name string) Logger {
 func (l *zapLogger) NewRootLogger(lvl zapcore.Level) (Logger, error) {
 	newLogger := *l
 	newLogger.config.Level = zap.NewAtomicLevelAt(lvl)
-	zl, err := newLogger.config.Build()
+	zl, err := newLogger.config.Build(l.opts...)
 	if err != nil {
 		return nil, err
 	}
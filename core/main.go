@@ -33,6 +33,8 @@ func Run(client *cmd.Client, args ...string) {
 func NewProductionClient() *cmd.Client {
 	lggr := logger.NewLogger()
 	cfg := config.NewGeneralConfig(lggr)
+	// The config file may set a log level too
+	lggr.SetLogLevel(cfg.LogLevel())
 
 	prompter := cmd.NewTerminalPrompter()
 	cookieAuth := cmd.NewSessionCookieAuthenticator(cfg, cmd.DiskCookieStore{Config: cfg}, lggr)
@@ -50,7 +52,7 @@ func NewProductionClient() *cmd.Client {
 		Config:                         cfg,
 		Logger:                         lggr,
 		AppFactory:                     cmd.ChainlinkAppFactory{},
-		KeyStoreAuthenticator:          cmd.TerminalKeyStoreAuthenticator{Prompter: prompter},
+		KeyStoreAuthenticator:          cmd.TerminalKeyStoreAuthenticator{Prompter: prompter, Secrets: cfg.SecretsResolver()},
 		FallbackAPIInitializer:         cmd.NewPromptingAPIInitializer(prompter),
 		Runner:                         cmd.ChainlinkRunner{},
 		HTTP:                           cmd.NewAuthenticatedHTTPClient(cfg, cookieAuth, sr),
@@ -0,0 +1,127 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EnvProvider reads secrets from environment variables.
+type EnvProvider struct{}
+
+// Get returns the value of the environment variable named by path.
+func (EnvProvider) Get(_ context.Context, path string) (string, error) {
+	value, ok := os.LookupEnv(path)
+	if !ok {
+		return "", errors.Errorf("environment variable %s is not set", path)
+	}
+	return value, nil
+}
+
+// FileProvider reads secrets from a tree of files, one secret per file, such as
+// mounted Kubernetes or Docker secrets.
+type FileProvider struct {
+	dir string
+}
+
+// NewFileProvider returns a FileProvider reading files beneath dir.
+func NewFileProvider(dir string) *FileProvider {
+	return &FileProvider{dir: dir}
+}
+
+// Get returns the content of the file at path, relative to the directory of
+// the provider, with surrounding whitespace trimmed.
+func (p *FileProvider) Get(_ context.Context, path string) (string, error) {
+	// Cleaning the path as if it were absolute stops it escaping the directory
+	name := filepath.Join(p.dir, filepath.Clean(string(filepath.Separator)+filepath.FromSlash(path)))
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+const vaultTimeout = 10 * time.Second
+
+// VaultProvider reads secrets from a Vault compatible KV version 2 secrets
+// engine over HTTP.
+type VaultProvider struct {
+	url    *url.URL
+	token  string
+	client *http.Client
+}
+
+// NewVaultProvider returns a VaultProvider for the server at u, which
+// authenticates with token.
+func NewVaultProvider(u *url.URL, token string) *VaultProvider {
+	return &VaultProvider{url: u, token: token, client: &http.Client{Timeout: vaultTimeout}}
+}
+
+// Get reads the secret at a path of the form <mount>/<path>#<key>. The key may
+// be omitted if the secret has a single key.
+func (p *VaultProvider) Get(ctx context.Context, ref string) (string, error) {
+	secretPath, key := ref, ""
+	if i := strings.LastIndex(ref, "#"); i >= 0 {
+		secretPath, key = ref[:i], ref[i+1:]
+	}
+	parts := strings.SplitN(strings.Trim(secretPath, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", errors.Errorf("invalid vault secret %q, expected <mount>/<path>#<key>", ref)
+	}
+
+	u := *p.url
+	u.Path = path.Join(u.Path, "v1", parts[0], "data", parts[1])
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", p.token)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", errors.Wrap(err, "vault request failed")
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", errors.Errorf("vault secret %s not found", secretPath)
+	case resp.StatusCode != http.StatusOK:
+		return "", errors.Errorf("vault request failed with status %s", resp.Status)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", errors.Wrap(err, "failed to decode vault response")
+	}
+	data := body.Data.Data
+
+	if key == "" {
+		if len(data) != 1 {
+			return "", errors.Errorf("vault secret %s has %d keys, the key must be given as %s#<key>", secretPath, len(data), secretPath)
+		}
+		for k := range data {
+			key = k
+		}
+	}
+	value, ok := data[key]
+	if !ok {
+		return "", errors.Errorf("vault secret %s has no key %s", secretPath, key)
+	}
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(value), nil
+}
@@ -0,0 +1,177 @@
+// Package secrets resolves secret:// references to secrets which are kept
+// outside of the node's environment and database, so that they can be stored
+// and rotated by a dedicated secrets manager.
+//
+// A reference names its provider followed by a provider specific path:
+//
+//	secret://env/KEYSTORE_PASSWORD
+//	secret://file/db/url
+//	secret://vault/secret/chainlink/bridges#coingecko
+package secrets
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Scheme prefixes every secret reference
+	Scheme = "secret://"
+	// BridgesDir is the subtree of the file and vault providers to which the
+	// outgoing tokens of bridges are confined
+	BridgesDir = "bridges"
+)
+
+// IsRef returns true if s is a secret reference rather than a plain value.
+func IsRef(s string) bool {
+	return strings.HasPrefix(s, Scheme)
+}
+
+// Provider looks up secrets by path.
+type Provider interface {
+	Get(ctx context.Context, path string) (string, error)
+}
+
+//go:generate mockery --name Resolver --output ./mocks/ --case=underscore
+
+// Resolver resolves secret references.
+type Resolver interface {
+	// Resolve returns the secret referenced by s, or s itself if it is not a
+	// secret reference.
+	Resolve(ctx context.Context, s string) (string, error)
+}
+
+// Config configures the providers of a Resolver.
+type Config interface {
+	SecretsCacheTTL() time.Duration
+	SecretsFileDir() string
+	SecretsVaultToken() string
+	SecretsVaultURL() *url.URL
+}
+
+type cachedSecret struct {
+	value   string
+	expires time.Time
+}
+
+type resolver struct {
+	cfg Config
+
+	mu    sync.Mutex
+	cache map[string]cachedSecret
+}
+
+// NewResolver returns a Resolver which resolves references using the env,
+// file and vault providers. Resolved secrets are cached for SECRETS_CACHE_TTL,
+// after which they are looked up again so that rotated secrets are picked up.
+func NewResolver(cfg Config) Resolver {
+	return &resolver{cfg: cfg, cache: make(map[string]cachedSecret)}
+}
+
+func (r *resolver) Resolve(ctx context.Context, s string) (string, error) {
+	if !IsRef(s) {
+		return s, nil
+	}
+
+	now := time.Now()
+	r.mu.Lock()
+	cached, ok := r.cache[s]
+	r.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.value, nil
+	}
+
+	name, path, err := ParseRef(s)
+	if err != nil {
+		return "", err
+	}
+	p, err := r.provider(name)
+	if err != nil {
+		return "", err
+	}
+	value, err := p.Get(ctx, path)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to resolve %s", s)
+	}
+
+	if ttl := r.cfg.SecretsCacheTTL(); ttl > 0 {
+		r.mu.Lock()
+		r.cache[s] = cachedSecret{value: value, expires: now.Add(ttl)}
+		r.mu.Unlock()
+	}
+	return value, nil
+}
+
+func (r *resolver) provider(name string) (Provider, error) {
+	switch name {
+	case "env":
+		return EnvProvider{}, nil
+	case "file":
+		dir := r.cfg.SecretsFileDir()
+		if dir == "" {
+			return nil, errors.New("SECRETS_FILE_DIR must be set to use secret://file references")
+		}
+		return NewFileProvider(dir), nil
+	case "vault":
+		u := r.cfg.SecretsVaultURL()
+		if u == nil {
+			return nil, errors.New("SECRETS_VAULT_URL must be set to use secret://vault references")
+		}
+		return NewVaultProvider(u, r.cfg.SecretsVaultToken()), nil
+	default:
+		return nil, errors.Errorf("unknown secrets provider %q", name)
+	}
+}
+
+// ParseRef splits a secret reference into the name of its provider and the
+// path of the secret.
+func ParseRef(s string) (provider, path string, err error) {
+	if !IsRef(s) {
+		return "", "", errors.Errorf("%q is not a secret reference", s)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(s, Scheme), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid secret reference %q, expected %s<provider>/<path>", s, Scheme)
+	}
+	return parts[0], parts[1], nil
+}
+
+// CheckBridgeRef returns an error unless s may be the outgoing token of a
+// bridge. Bridges are sent their token, so that anyone able to create one
+// could otherwise have the node send them any of its secrets: references must
+// be to file or vault secrets beneath BridgesDir, e.g.
+//
+//	secret://file/bridges/coingecko
+//	secret://vault/secret/bridges/coingecko#token
+func CheckBridgeRef(s string) error {
+	provider, secretPath, err := ParseRef(s)
+	if err != nil {
+		return err
+	}
+	switch provider {
+	case "file":
+	case "vault":
+		// Within the mount, without the key
+		if i := strings.LastIndex(secretPath, "#"); i >= 0 {
+			secretPath = secretPath[:i]
+		}
+		parts := strings.SplitN(strings.Trim(secretPath, "/"), "/", 2)
+		if len(parts) != 2 {
+			return errors.Errorf("invalid vault secret reference %q, expected %svault/<mount>/<path>#<key>", s, Scheme)
+		}
+		secretPath = parts[1]
+	default:
+		return errors.Errorf("bridge tokens must be secret://file or secret://vault references, got %q", s)
+	}
+	// Cleaning the path as if it were absolute resolves any ..
+	if !strings.HasPrefix(path.Clean("/"+secretPath), "/"+BridgesDir+"/") {
+		return errors.Errorf("bridge tokens must reference secrets beneath %s/, got %q", BridgesDir, s)
+	}
+	return nil
+}
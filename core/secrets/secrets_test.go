@@ -0,0 +1,240 @@
+package secrets_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/secrets"
+)
+
+type testConfig struct {
+	cacheTTL   time.Duration
+	fileDir    string
+	vaultToken string
+	vaultURL   *url.URL
+}
+
+func (c testConfig) SecretsCacheTTL() time.Duration { return c.cacheTTL }
+func (c testConfig) SecretsFileDir() string         { return c.fileDir }
+func (c testConfig) SecretsVaultToken() string      { return c.vaultToken }
+func (c testConfig) SecretsVaultURL() *url.URL      { return c.vaultURL }
+
+// newVaultStub serves a KV version 2 secrets engine mounted at secret/
+func newVaultStub(t *testing.T, token string, secrets map[string]map[string]interface{}) *url.URL {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		data, ok := secrets[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{"data": data, "metadata": map[string]interface{}{"version": 1}},
+		}))
+	}))
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func TestIsRef(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, secrets.IsRef("secret://env/FOO"))
+	assert.False(t, secrets.IsRef("postgresql://localhost"))
+	assert.False(t, secrets.IsRef(""))
+}
+
+func TestParseRef(t *testing.T) {
+	t.Parallel()
+
+	provider, path, err := secrets.ParseRef("secret://vault/secret/chainlink#db")
+	require.NoError(t, err)
+	assert.Equal(t, "vault", provider)
+	assert.Equal(t, "secret/chainlink#db", path)
+
+	for _, s := range []string{"env/FOO", "secret://", "secret://env", "secret://env/", "secret:///FOO"} {
+		_, _, err = secrets.ParseRef(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestCheckBridgeRef(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"secret://file/bridges/coingecko",
+		"secret://file//bridges/a/b",
+		"secret://vault/secret/bridges/coingecko#token",
+		"secret://vault/kv/bridges/coingecko",
+	} {
+		assert.NoError(t, secrets.CheckBridgeRef(s), s)
+	}
+	for _, s := range []string{
+		"secret://env/DATABASE_URL",
+		"secret://env/bridges/FOO",
+		"secret://file/password",
+		"secret://file/bridges",
+		"secret://file/bridges/../password",
+		"secret://vault/bridges/coingecko#token",
+		"secret://vault/secret/chainlink#token",
+		"secret://vault/secret/bridges/../chainlink#token",
+		"secret://vault",
+	} {
+		assert.Error(t, secrets.CheckBridgeRef(s), s)
+	}
+}
+
+func TestEnvProvider(t *testing.T) {
+	t.Setenv("CL_TEST_SECRET", "hunter2")
+
+	value, err := secrets.EnvProvider{}.Get(context.Background(), "CL_TEST_SECRET")
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", value)
+
+	_, err = secrets.EnvProvider{}.Get(context.Background(), "CL_TEST_SECRET_MISSING")
+	assert.Error(t, err)
+}
+
+func TestFileProvider(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "secrets")
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "db"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db", "url"), []byte("postgresql://localhost/chainlink\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "outside"), []byte("nope"), 0600))
+
+	p := secrets.NewFileProvider(dir)
+
+	value, err := p.Get(context.Background(), "db/url")
+	require.NoError(t, err)
+	assert.Equal(t, "postgresql://localhost/chainlink", value)
+
+	_, err = p.Get(context.Background(), "db/missing")
+	assert.Error(t, err)
+
+	// Paths cannot escape the directory
+	_, err = p.Get(context.Background(), "../outside")
+	assert.Error(t, err)
+}
+
+func TestVaultProvider(t *testing.T) {
+	t.Parallel()
+
+	u := newVaultStub(t, "s.token", map[string]map[string]interface{}{
+		"/v1/secret/data/chainlink/bridges": {"coingecko": "abc", "cryptocompare": "def"},
+		"/v1/secret/data/chainlink/db":      {"url": "postgresql://localhost/chainlink"},
+	})
+	p := secrets.NewVaultProvider(u, "s.token")
+	ctx := context.Background()
+
+	value, err := p.Get(ctx, "secret/chainlink/bridges#coingecko")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", value)
+
+	// The key may be omitted for secrets with a single key
+	value, err = p.Get(ctx, "secret/chainlink/db")
+	require.NoError(t, err)
+	assert.Equal(t, "postgresql://localhost/chainlink", value)
+
+	_, err = p.Get(ctx, "secret/chainlink/bridges")
+	assert.EqualError(t, err, "vault secret secret/chainlink/bridges has 2 keys, the key must be given as secret/chainlink/bridges#<key>")
+
+	_, err = p.Get(ctx, "secret/chainlink/bridges#missing")
+	assert.EqualError(t, err, "vault secret secret/chainlink/bridges has no key missing")
+
+	_, err = p.Get(ctx, "secret/chainlink/missing#key")
+	assert.EqualError(t, err, "vault secret secret/chainlink/missing not found")
+
+	_, err = p.Get(ctx, "chainlink#key")
+	assert.Error(t, err)
+
+	_, err = secrets.NewVaultProvider(u, "wrong").Get(ctx, "secret/chainlink/db")
+	assert.EqualError(t, err, "vault request failed with status 403 Forbidden")
+}
+
+func TestResolver(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "password")
+	require.NoError(t, os.WriteFile(name, []byte("first"), 0600))
+	t.Setenv("CL_TEST_SECRET", "hunter2")
+	vaultURL := newVaultStub(t, "s.token", map[string]map[string]interface{}{
+		"/v1/secret/data/chainlink": {"token": "abc"},
+	})
+	ctx := context.Background()
+
+	t.Run("plain values are returned as is", func(t *testing.T) {
+		r := secrets.NewResolver(testConfig{})
+		value, err := r.Resolve(ctx, "postgresql://localhost/chainlink")
+		require.NoError(t, err)
+		assert.Equal(t, "postgresql://localhost/chainlink", value)
+	})
+
+	t.Run("resolves each provider", func(t *testing.T) {
+		r := secrets.NewResolver(testConfig{fileDir: dir, vaultURL: vaultURL, vaultToken: "s.token"})
+
+		value, err := r.Resolve(ctx, "secret://env/CL_TEST_SECRET")
+		require.NoError(t, err)
+		assert.Equal(t, "hunter2", value)
+
+		value, err = r.Resolve(ctx, "secret://file/password")
+		require.NoError(t, err)
+		assert.Equal(t, "first", value)
+
+		value, err = r.Resolve(ctx, "secret://vault/secret/chainlink#token")
+		require.NoError(t, err)
+		assert.Equal(t, "abc", value)
+	})
+
+	t.Run("errors on unconfigured or unknown providers", func(t *testing.T) {
+		r := secrets.NewResolver(testConfig{})
+
+		_, err := r.Resolve(ctx, "secret://file/password")
+		assert.EqualError(t, err, "SECRETS_FILE_DIR must be set to use secret://file references")
+
+		_, err = r.Resolve(ctx, "secret://vault/secret/chainlink#token")
+		assert.EqualError(t, err, "SECRETS_VAULT_URL must be set to use secret://vault references")
+
+		_, err = r.Resolve(ctx, "secret://aws/chainlink")
+		assert.EqualError(t, err, `unknown secrets provider "aws"`)
+	})
+
+	t.Run("caches secrets until they expire", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(name, []byte("first"), 0600))
+		cached := secrets.NewResolver(testConfig{fileDir: dir, cacheTTL: time.Hour})
+		uncached := secrets.NewResolver(testConfig{fileDir: dir})
+
+		for _, r := range []secrets.Resolver{cached, uncached} {
+			value, err := r.Resolve(ctx, "secret://file/password")
+			require.NoError(t, err)
+			assert.Equal(t, "first", value)
+		}
+
+		// Rotate the secret
+		require.NoError(t, os.WriteFile(name, []byte("second"), 0600))
+
+		value, err := cached.Resolve(ctx, "secret://file/password")
+		require.NoError(t, err)
+		assert.Equal(t, "first", value)
+
+		value, err = uncached.Resolve(ctx, "secret://file/password")
+		require.NoError(t, err)
+		assert.Equal(t, "second", value)
+	})
+}
@@ -0,0 +1,215 @@
+package alerts
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// Config is the node configuration of the alert engine
+type Config interface {
+	AlertsChannels() string
+	AlertsCheckInterval() time.Duration
+	AlertsEnabled() bool
+	AlertsRepeatInterval() time.Duration
+	AlertsRules() string
+}
+
+// Severity is how urgently an alert needs attention
+type Severity string
+
+const (
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Built-in rules
+const (
+	RuleKeyBalanceLow    = "key_balance_low"
+	RuleNoHeads          = "no_heads"
+	RuleUnconfirmedTxAge = "unconfirmed_tx_age"
+	RuleJobErrorRate     = "job_error_rate"
+	RuleBridgeDown       = "bridge_down"
+	RuleEVMNodeDead      = "evm_node_dead"
+)
+
+// RuleConfig configures a built-in rule. The meaning of Threshold depends on
+// the rule:
+//
+//   - key_balance_low: the ETH balance below which a sending key alerts
+//   - no_heads: how long a chain may go without a new head
+//   - unconfirmed_tx_age: how long a transaction may stay unconfirmed
+//   - job_error_rate: the fraction of runs of a job, over Window, which may
+//     error, once it has run at least MinRuns times
+//
+// bridge_down and evm_node_dead have no threshold: they alert once a bridge
+// is unreachable or an EVM node is dead for longer than For.
+type RuleConfig struct {
+	Disabled  bool            `json:"disabled"`
+	Severity  Severity        `json:"severity"`
+	Threshold string          `json:"threshold"`
+	For       models.Duration `json:"for"`
+	Window    models.Duration `json:"window"`
+	MinRuns   uint32          `json:"minRuns"`
+}
+
+// DefaultRules returns the default configuration of the built-in rules
+func DefaultRules() map[string]RuleConfig {
+	return map[string]RuleConfig{
+		RuleKeyBalanceLow:    {Severity: SeverityWarning, Threshold: "0.1"},
+		RuleNoHeads:          {Severity: SeverityCritical, Threshold: "3m"},
+		RuleUnconfirmedTxAge: {Severity: SeverityWarning, Threshold: "10m"},
+		RuleJobErrorRate:     {Severity: SeverityWarning, Threshold: "0.5", Window: models.MustMakeDuration(time.Hour), MinRuns: 5},
+		RuleBridgeDown:       {Severity: SeverityCritical, For: models.MustMakeDuration(2 * time.Minute)},
+		RuleEVMNodeDead:      {Severity: SeverityCritical, For: models.MustMakeDuration(time.Minute)},
+	}
+}
+
+// ParseRules returns the configuration of the built-in rules, with the
+// overrides of ALERTS_RULES applied, e.g.
+//
+//	{"key_balance_low": {"threshold": "0.5"}, "job_error_rate": {"disabled": true}}
+func ParseRules(cfg Config) (map[string]RuleConfig, error) {
+	rules := DefaultRules()
+	s := strings.TrimSpace(cfg.AlertsRules())
+	if s == "" {
+		return rules, nil
+	}
+	var overrides map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(s), &overrides); err != nil {
+		return nil, errors.Wrap(err, "invalid ALERTS_RULES")
+	}
+
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var err error
+	for _, name := range names {
+		rule, ok := rules[name]
+		if !ok {
+			err = multierr.Append(err, errors.Errorf("unknown rule %q", name))
+			continue
+		}
+		// Fields which are not overridden keep their default
+		if uerr := json.Unmarshal(overrides[name], &rule); uerr != nil {
+			err = multierr.Append(err, errors.Wrapf(uerr, "rule %s", name))
+			continue
+		}
+		if rule.Severity != SeverityWarning && rule.Severity != SeverityCritical {
+			err = multierr.Append(err, errors.Errorf("rule %s: severity must be %q or %q, got %q", name, SeverityWarning, SeverityCritical, rule.Severity))
+		}
+		rules[name] = rule
+	}
+	return rules, errors.Wrap(err, "invalid ALERTS_RULES")
+}
+
+// Channel types
+const (
+	ChannelWebhook = "webhook"
+	ChannelSlack   = "slack"
+	ChannelEmail   = "email"
+)
+
+// ChannelConfig configures a channel alerts are notified to, as given by
+// ALERTS_CHANNELS:
+//
+//	[
+//	  {"type": "slack", "url": "https://hooks.slack.com/services/..."},
+//	  {"type": "webhook", "url": "https://example.com/alerts", "headers": {"Authorization": "Bearer ..."}},
+//	  {"type": "email", "smtpHost": "smtp.example.com", "smtpPort": 587, "username": "...", "password": "...",
+//	   "from": "node@example.com", "to": ["oncall@example.com"], "rules": ["key_balance_low"]}
+//	]
+type ChannelConfig struct {
+	// Type is "webhook", "slack" or "email"
+	Type string `json:"type"`
+	// URL is the URL of a webhook, or of a Slack compatible incoming webhook
+	URL string `json:"url"`
+	// Headers are sent with each webhook request
+	Headers map[string]string `json:"headers"`
+
+	// SMTPHost and SMTPPort are the address of the SMTP server emails are
+	// sent through, authenticating with Username and Password if set.
+	SMTPHost string   `json:"smtpHost"`
+	SMTPPort uint16   `json:"smtpPort"`
+	Username string   `json:"username"`
+	Password string   `json:"password"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+
+	// Rules restricts the channel to the alerts of these rules. All alerts
+	// are notified to the channel if it is empty.
+	Rules []string `json:"rules"`
+}
+
+// ParseChannels parses the channels configured by ALERTS_CHANNELS
+func ParseChannels(cfg Config) ([]ChannelConfig, error) {
+	s := strings.TrimSpace(cfg.AlertsChannels())
+	if s == "" {
+		return nil, nil
+	}
+	var channels []ChannelConfig
+	if err := json.Unmarshal([]byte(s), &channels); err != nil {
+		return nil, errors.Wrap(err, "invalid ALERTS_CHANNELS")
+	}
+	rules := DefaultRules()
+	var err error
+	for i, channel := range channels {
+		if verr := channel.validate(rules); verr != nil {
+			err = multierr.Append(err, errors.Wrapf(verr, "channel %d", i))
+		}
+	}
+	return channels, errors.Wrap(err, "invalid ALERTS_CHANNELS")
+}
+
+func (c ChannelConfig) validate(rules map[string]RuleConfig) (err error) {
+	switch c.Type {
+	case ChannelWebhook, ChannelSlack:
+		u, perr := url.Parse(c.URL)
+		if perr != nil {
+			err = multierr.Append(err, errors.Wrap(perr, "invalid url"))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			err = multierr.Append(err, errors.Errorf("url must be http:// or https://, got %q", c.URL))
+		}
+	case ChannelEmail:
+		if c.SMTPHost == "" {
+			err = multierr.Append(err, errors.New("smtpHost is required"))
+		}
+		if c.From == "" {
+			err = multierr.Append(err, errors.New("from is required"))
+		}
+		if len(c.To) == 0 {
+			err = multierr.Append(err, errors.New("to is required"))
+		}
+	default:
+		err = multierr.Append(err, errors.Errorf("type must be %q, %q or %q, got %q", ChannelWebhook, ChannelSlack, ChannelEmail, c.Type))
+	}
+	for _, rule := range c.Rules {
+		if _, ok := rules[rule]; !ok {
+			err = multierr.Append(err, errors.Errorf("unknown rule %q", rule))
+		}
+	}
+	return err
+}
+
+// accepts returns whether alerts of rule are notified to the channel
+func (c ChannelConfig) accepts(rule string) bool {
+	if len(c.Rules) == 0 {
+		return true
+	}
+	for _, r := range c.Rules {
+		if r == rule {
+			return true
+		}
+	}
+	return false
+}
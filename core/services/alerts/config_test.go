@@ -0,0 +1,105 @@
+package alerts_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+)
+
+type config struct {
+	channels string
+	rules    string
+}
+
+func (c config) AlertsChannels() string              { return c.channels }
+func (c config) AlertsCheckInterval() time.Duration  { return 30 * time.Second }
+func (c config) AlertsEnabled() bool                 { return true }
+func (c config) AlertsRepeatInterval() time.Duration { return 4 * time.Hour }
+func (c config) AlertsRules() string                 { return c.rules }
+
+func TestParseRules(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		rules, err := alerts.ParseRules(config{})
+		require.NoError(t, err)
+		assert.Equal(t, alerts.DefaultRules(), rules)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		rules, err := alerts.ParseRules(config{rules: `{
+			"key_balance_low": {"threshold": "0.5", "severity": "critical"},
+			"job_error_rate": {"disabled": true},
+			"bridge_down": {"for": "5m"}
+		}`})
+		require.NoError(t, err)
+
+		balance := rules[alerts.RuleKeyBalanceLow]
+		assert.Equal(t, "0.5", balance.Threshold)
+		assert.Equal(t, alerts.SeverityCritical, balance.Severity)
+		assert.True(t, rules[alerts.RuleJobErrorRate].Disabled)
+		// Fields which are not overridden keep their default
+		assert.Equal(t, "0.5", rules[alerts.RuleJobErrorRate].Threshold)
+		assert.Equal(t, uint32(5), rules[alerts.RuleJobErrorRate].MinRuns)
+		assert.Equal(t, 5*time.Minute, rules[alerts.RuleBridgeDown].For.Duration())
+		assert.Equal(t, alerts.SeverityCritical, rules[alerts.RuleBridgeDown].Severity)
+		assert.Equal(t, alerts.DefaultRules()[alerts.RuleNoHeads], rules[alerts.RuleNoHeads])
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := alerts.ParseRules(config{rules: `{"key_balance": {}, "no_heads": {"severity": "info"}}`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid ALERTS_RULES")
+		assert.Contains(t, err.Error(), `unknown rule "key_balance"`)
+		assert.Contains(t, err.Error(), `rule no_heads: severity must be "warning" or "critical", got "info"`)
+
+		_, err = alerts.ParseRules(config{rules: `[]`})
+		require.Error(t, err)
+	})
+}
+
+func TestParseChannels(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		channels, err := alerts.ParseChannels(config{})
+		require.NoError(t, err)
+		assert.Empty(t, channels)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		channels, err := alerts.ParseChannels(config{channels: `[
+			{"type": "slack", "url": "https://hooks.slack.com/services/T000/B000/XXXX"},
+			{"type": "webhook", "url": "https://example.com/alerts", "headers": {"Authorization": "Bearer token"}},
+			{"type": "email", "smtpHost": "smtp.example.com", "smtpPort": 465, "from": "node@example.com", "to": ["oncall@example.com"], "rules": ["key_balance_low"]}
+		]`})
+		require.NoError(t, err)
+		require.Len(t, channels, 3)
+
+		assert.Equal(t, alerts.ChannelSlack, channels[0].Type)
+		assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, channels[1].Headers)
+		email := channels[2]
+		assert.Equal(t, alerts.ChannelEmail, email.Type)
+		assert.Equal(t, uint16(465), email.SMTPPort)
+		assert.Equal(t, []string{"oncall@example.com"}, email.To)
+		assert.Equal(t, []string{alerts.RuleKeyBalanceLow}, email.Rules)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := alerts.ParseChannels(config{channels: `[
+			{"type": "pagerduty"},
+			{"type": "webhook", "url": "ftp://example.com"},
+			{"type": "email", "rules": ["foo"]}
+		]`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid ALERTS_CHANNELS")
+		assert.Contains(t, err.Error(), `channel 0: type must be "webhook", "slack" or "email", got "pagerduty"`)
+		assert.Contains(t, err.Error(), `channel 1: url must be http:// or https://, got "ftp://example.com"`)
+		assert.Contains(t, err.Error(), "channel 2: smtpHost is required")
+		assert.Contains(t, err.Error(), `unknown rule "foo"`)
+	})
+}
@@ -0,0 +1,472 @@
+// Package alerts evaluates built-in rules about the health of the node, such
+// as sending keys running low on funds or chains no longer receiving heads,
+// and notifies operators of the alerts they raise through webhooks, Slack and
+// email.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promAlertsFiring = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "alerts_firing",
+		Help: "The number of alerts currently firing, by rule",
+	}, []string{"rule"})
+	promNotificationErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "alert_notification_errors_total",
+		Help: "The number of alert notifications which could not be sent, by channel type",
+	}, []string{"channel"})
+)
+
+// ErrSilenceNotFound is returned when expiring a silence which does not exist
+var ErrSilenceNotFound = errors.New("silence not found")
+
+// AlertState is the state of an alert
+type AlertState string
+
+const (
+	// AlertStatePending alerts breach their rule, but have not done so for
+	// long enough to fire yet
+	AlertStatePending AlertState = "pending"
+	// AlertStateFiring alerts are notified to the configured channels
+	AlertStateFiring AlertState = "firing"
+)
+
+// Alert is a condition raised by a rule
+type Alert struct {
+	// ID identifies the alert by its rule and labels
+	ID       string
+	Rule     string
+	Severity Severity
+	Labels   map[string]string
+	Summary  string
+	State    AlertState
+	// StartsAt is when the condition was first seen
+	StartsAt time.Time
+	// LastNotifiedAt is when the alert was last notified, zero if never
+	LastNotifiedAt time.Time
+	// Silenced is true while a silence matches the alert
+	Silenced bool
+}
+
+// Silence suppresses the notifications of matching alerts until EndsAt. It
+// matches the alerts of Rule, or of all rules if Rule is empty, which have
+// all of Labels.
+type Silence struct {
+	ID        string
+	Rule      string
+	Labels    map[string]string
+	Comment   string
+	CreatedAt time.Time
+	EndsAt    time.Time
+}
+
+// Matches returns whether the silence applies to alert
+func (s Silence) Matches(alert Alert) bool {
+	if s.Rule != "" && s.Rule != alert.Rule {
+		return false
+	}
+	for k, v := range s.Labels {
+		if alert.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Engine evaluates the built-in rules every ALERTS_CHECK_INTERVAL and notifies
+// their alerts to the channels configured by ALERTS_CHANNELS. Firing alerts
+// are notified again every ALERTS_REPEAT_INTERVAL while they last, and once
+// more when they resolve.
+type Engine interface {
+	services.Service
+	// Alerts returns the pending and firing alerts
+	Alerts() []Alert
+	// Silences returns the silences which have not ended yet
+	Silences() []Silence
+	// AddSilence creates a silence, returning it with its ID set
+	AddSilence(silence Silence) (Silence, error)
+	// ExpireSilence ends a silence immediately
+	ExpireSilence(id string) error
+}
+
+type channel struct {
+	config   ChannelConfig
+	notifier Notifier
+}
+
+type engine struct {
+	utils.StartStopOnce
+	enabled        bool
+	checkInterval  time.Duration
+	repeatInterval time.Duration
+	rules          []Rule
+	ruleConfigs    map[string]RuleConfig
+	channels       []channel
+	lggr           logger.Logger
+
+	mu       sync.RWMutex
+	alerts   map[string]*Alert
+	silences map[string]Silence
+
+	chStop chan struct{}
+	wg     sync.WaitGroup
+}
+
+var _ Engine = (*engine)(nil)
+
+// NewEngine returns an Engine of the built-in rules, as configured by
+// ALERTS_RULES. Rules are only evaluated if ALERTS_ENABLED is set.
+func NewEngine(cfg Config, db *sqlx.DB, chainSet evm.ChainSet, ethKs keystore.Eth, bridgeORM bridges.ORM, lggr logger.Logger) (Engine, error) {
+	ruleConfigs, err := ParseRules(cfg)
+	if err != nil {
+		return nil, err
+	}
+	channelConfigs, err := ParseChannels(cfg)
+	if err != nil {
+		return nil, err
+	}
+	e := newEngine(cfg, ruleConfigs, lggr)
+	if !e.enabled {
+		return e, nil
+	}
+
+	for _, name := range sortedRuleNames(ruleConfigs) {
+		rc := ruleConfigs[name]
+		if rc.Disabled {
+			continue
+		}
+		var rule Rule
+		switch name {
+		case RuleKeyBalanceLow:
+			rule, err = newKeyBalanceLowRule(chainSet, ethKs, rc)
+		case RuleNoHeads:
+			rule, err = newNoHeadsRule(chainSet, rc)
+		case RuleUnconfirmedTxAge:
+			rule, err = newUnconfirmedTxAgeRule(db, rc)
+		case RuleJobErrorRate:
+			rule, err = newJobErrorRateRule(db, rc)
+		case RuleBridgeDown:
+			rule = newBridgeDownRule(bridgeORM)
+		case RuleEVMNodeDead:
+			rule = newEVMNodeDeadRule()
+		}
+		if err != nil {
+			_ = e.closeRules()
+			return nil, errors.Wrapf(err, "ALERTS_RULES: rule %s", name)
+		}
+		e.rules = append(e.rules, rule)
+	}
+	for _, cc := range channelConfigs {
+		e.channels = append(e.channels, channel{cc, newNotifier(cc)})
+	}
+	return e, nil
+}
+
+func newEngine(cfg Config, ruleConfigs map[string]RuleConfig, lggr logger.Logger) *engine {
+	return &engine{
+		enabled:        cfg.AlertsEnabled(),
+		checkInterval:  cfg.AlertsCheckInterval(),
+		repeatInterval: cfg.AlertsRepeatInterval(),
+		ruleConfigs:    ruleConfigs,
+		lggr:           lggr.Named("AlertEngine"),
+		alerts:         make(map[string]*Alert),
+		silences:       make(map[string]Silence),
+		chStop:         make(chan struct{}),
+	}
+}
+
+func sortedRuleNames(rules map[string]RuleConfig) []string {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Start evaluates the rules in the background, if alerts are enabled
+func (e *engine) Start() error {
+	return e.StartOnce("AlertEngine", func() error {
+		if !e.enabled {
+			return nil
+		}
+		if len(e.channels) == 0 {
+			e.lggr.Warn("Alerts are enabled, but no channels are configured by ALERTS_CHANNELS: alerts will only be available through the API")
+		}
+		e.wg.Add(1)
+		go e.run()
+		return nil
+	})
+}
+
+// Close stops evaluating the rules
+func (e *engine) Close() error {
+	return e.StopOnce("AlertEngine", func() error {
+		close(e.chStop)
+		e.wg.Wait()
+		return e.closeRules()
+	})
+}
+
+func (e *engine) closeRules() (err error) {
+	for _, rule := range e.rules {
+		if closer, ok := rule.(interface{ Close() error }); ok {
+			if cerr := closer.Close(); cerr != nil {
+				err = errors.Wrapf(cerr, "failed to close rule %s", rule.Name())
+			}
+		}
+	}
+	return err
+}
+
+func (e *engine) run() {
+	defer e.wg.Done()
+	ctx, cancel := utils.ContextFromChan(e.chStop)
+	defer cancel()
+
+	ticker := time.NewTicker(e.checkInterval)
+	defer ticker.Stop()
+	for {
+		e.check(ctx, time.Now())
+		select {
+		case <-e.chStop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check evaluates all rules and sends the notifications which are due
+func (e *engine) check(ctx context.Context, now time.Time) {
+	results := make(map[string][]Condition, len(e.rules))
+	for _, rule := range e.rules {
+		evalCtx, cancel := context.WithTimeout(ctx, e.checkInterval)
+		conditions, err := rule.Evaluate(evalCtx, now)
+		cancel()
+		if err != nil {
+			// The alerts of the rule are kept as they are until it can be
+			// evaluated again
+			e.lggr.Errorw("Failed to evaluate alert rule", "rule", rule.Name(), "err", err)
+			continue
+		}
+		results[rule.Name()] = conditions
+	}
+
+	notifications := e.reconcile(results, now)
+	for _, n := range notifications {
+		e.notify(ctx, n)
+	}
+}
+
+// reconcile updates the alerts of the evaluated rules with their conditions
+// and returns the notifications which are due.
+func (e *engine) reconcile(results map[string][]Condition, now time.Time) (notifications []Notification) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for id, silence := range e.silences {
+		if !now.Before(silence.EndsAt) {
+			delete(e.silences, id)
+		}
+	}
+
+	seen := make(map[string]struct{})
+	for ruleName, conditions := range results {
+		rc := e.ruleConfigs[ruleName]
+		for _, c := range conditions {
+			id := fingerprint(ruleName, c.Labels)
+			seen[id] = struct{}{}
+			alert, ok := e.alerts[id]
+			if !ok {
+				alert = &Alert{
+					ID:       id,
+					Rule:     ruleName,
+					Severity: rc.Severity,
+					Labels:   c.Labels,
+					State:    AlertStatePending,
+					StartsAt: now,
+				}
+				e.alerts[id] = alert
+			}
+			alert.Summary = c.Summary
+			if alert.State == AlertStatePending && now.Sub(alert.StartsAt) >= rc.For.Duration() {
+				alert.State = AlertStateFiring
+			}
+		}
+	}
+
+	firing := make(map[string]int)
+	for id, alert := range e.alerts {
+		if _, evaluated := results[alert.Rule]; !evaluated {
+			if alert.State == AlertStateFiring {
+				firing[alert.Rule]++
+			}
+			continue
+		}
+		if _, ok := seen[id]; !ok {
+			delete(e.alerts, id)
+			if !alert.LastNotifiedAt.IsZero() {
+				notifications = append(notifications, Notification{Alert: *alert, Resolved: true, At: now})
+			}
+			continue
+		}
+		if alert.State != AlertStateFiring {
+			continue
+		}
+		firing[alert.Rule]++
+		alert.Silenced = e.silenced(*alert)
+		// Silenced alerts are notified once their silence ends, if they still
+		// fire by then
+		if alert.Silenced {
+			continue
+		}
+		if alert.LastNotifiedAt.IsZero() || now.Sub(alert.LastNotifiedAt) >= e.repeatInterval {
+			alert.LastNotifiedAt = now
+			notifications = append(notifications, Notification{Alert: *alert, At: now})
+		}
+	}
+
+	for ruleName := range e.ruleConfigs {
+		promAlertsFiring.WithLabelValues(ruleName).Set(float64(firing[ruleName]))
+	}
+
+	sort.Slice(notifications, func(i, j int) bool {
+		return notifications[i].Alert.ID < notifications[j].Alert.ID
+	})
+	return notifications
+}
+
+func (e *engine) silenced(alert Alert) bool {
+	for _, silence := range e.silences {
+		if silence.Matches(alert) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *engine) notify(ctx context.Context, n Notification) {
+	for _, ch := range e.channels {
+		if !ch.config.accepts(n.Alert.Rule) {
+			continue
+		}
+		notifyCtx, cancel := context.WithTimeout(ctx, notifyTimeout)
+		err := ch.notifier.Notify(notifyCtx, n)
+		cancel()
+		if err != nil {
+			promNotificationErrors.WithLabelValues(ch.config.Type).Inc()
+			e.lggr.Errorw("Failed to notify alert", "channel", ch.config.Type, "rule", n.Alert.Rule, "alertID", n.Alert.ID, "err", err)
+		}
+	}
+}
+
+// Alerts returns the pending and firing alerts, most severe and oldest first
+func (e *engine) Alerts() []Alert {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	alerts := make([]Alert, 0, len(e.alerts))
+	for _, alert := range e.alerts {
+		alerts = append(alerts, *alert)
+	}
+	sort.Slice(alerts, func(i, j int) bool {
+		a, b := alerts[i], alerts[j]
+		if a.Severity != b.Severity {
+			return a.Severity == SeverityCritical
+		}
+		if !a.StartsAt.Equal(b.StartsAt) {
+			return a.StartsAt.Before(b.StartsAt)
+		}
+		return a.ID < b.ID
+	})
+	return alerts
+}
+
+// Silences returns the silences which have not ended yet, by end time
+func (e *engine) Silences() []Silence {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	now := time.Now()
+	silences := make([]Silence, 0, len(e.silences))
+	for _, silence := range e.silences {
+		if now.Before(silence.EndsAt) {
+			silences = append(silences, silence)
+		}
+	}
+	sort.Slice(silences, func(i, j int) bool {
+		return silences[i].EndsAt.Before(silences[j].EndsAt)
+	})
+	return silences
+}
+
+// AddSilence creates a silence, which takes effect on the next check
+func (e *engine) AddSilence(silence Silence) (Silence, error) {
+	now := time.Now()
+	if !silence.EndsAt.After(now) {
+		return Silence{}, errors.New("silence must end in the future")
+	}
+	if silence.Rule != "" {
+		if _, ok := e.ruleConfigs[silence.Rule]; !ok {
+			return Silence{}, errors.Errorf("unknown rule %q", silence.Rule)
+		}
+	}
+	silence.ID = uuid.NewV4().String()
+	silence.CreatedAt = now
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.silences[silence.ID] = silence
+	for _, alert := range e.alerts {
+		if alert.State == AlertStateFiring && silence.Matches(*alert) {
+			alert.Silenced = true
+		}
+	}
+	return silence, nil
+}
+
+// ExpireSilence ends a silence. Alerts it matched are notified on the next
+// check if they are still firing and have not been notified within
+// ALERTS_REPEAT_INTERVAL.
+func (e *engine) ExpireSilence(id string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.silences[id]; !ok {
+		return ErrSilenceNotFound
+	}
+	delete(e.silences, id)
+	return nil
+}
+
+// fingerprint identifies an alert by its rule and labels
+func fingerprint(rule string, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(rule))
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(h, "\x00%s=%s", k, labels[k])
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
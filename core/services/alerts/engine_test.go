@@ -0,0 +1,231 @@
+package alerts
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+type testConfig struct{}
+
+func (testConfig) AlertsChannels() string              { return "" }
+func (testConfig) AlertsCheckInterval() time.Duration  { return time.Minute }
+func (testConfig) AlertsEnabled() bool                 { return true }
+func (testConfig) AlertsRepeatInterval() time.Duration { return time.Hour }
+func (testConfig) AlertsRules() string                 { return "" }
+
+type fakeRule struct {
+	name       string
+	conditions []Condition
+	err        error
+}
+
+func (r *fakeRule) Name() string { return r.name }
+
+func (r *fakeRule) Evaluate(ctx context.Context, now time.Time) ([]Condition, error) {
+	return r.conditions, r.err
+}
+
+type fakeNotifier struct {
+	mu            sync.Mutex
+	notifications []Notification
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, notification Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func (n *fakeNotifier) take() []Notification {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	notifications := n.notifications
+	n.notifications = nil
+	return notifications
+}
+
+func newTestEngine(t *testing.T, rules ...Rule) (*engine, *fakeNotifier) {
+	ruleConfigs := DefaultRules()
+	ruleConfigs[RuleBridgeDown] = RuleConfig{Severity: SeverityCritical, For: models.MustMakeDuration(2 * time.Minute)}
+	e := newEngine(testConfig{}, ruleConfigs, logger.TestLogger(t))
+	e.rules = rules
+	notifier := new(fakeNotifier)
+	e.channels = []channel{{ChannelConfig{Type: ChannelWebhook}, notifier}}
+	return e, notifier
+}
+
+func TestEngine_FiresAndResolves(t *testing.T) {
+	t.Parallel()
+
+	rule := &fakeRule{name: RuleKeyBalanceLow, conditions: []Condition{
+		{Labels: map[string]string{"address": "0x1"}, Summary: "low balance"},
+	}}
+	e, notifier := newTestEngine(t, rule)
+	ctx := context.Background()
+	now := time.Now()
+
+	// Rules without a For fire as soon as their condition is seen
+	e.check(ctx, now)
+	alerts := e.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, AlertStateFiring, alerts[0].State)
+	assert.Equal(t, SeverityWarning, alerts[0].Severity)
+	assert.Equal(t, fingerprint(RuleKeyBalanceLow, map[string]string{"address": "0x1"}), alerts[0].ID)
+	notifications := notifier.take()
+	require.Len(t, notifications, 1)
+	assert.False(t, notifications[0].Resolved)
+	assert.Equal(t, "low balance", notifications[0].Alert.Summary)
+
+	// Not notified again before the repeat interval
+	e.check(ctx, now.Add(30*time.Minute))
+	assert.Empty(t, notifier.take())
+
+	e.check(ctx, now.Add(time.Hour))
+	require.Len(t, notifier.take(), 1)
+
+	// A failing rule keeps its alerts
+	rule.err = errors.New("failed")
+	e.check(ctx, now.Add(time.Hour+time.Minute))
+	assert.Len(t, e.Alerts(), 1)
+	assert.Empty(t, notifier.take())
+
+	rule.err = nil
+	rule.conditions = nil
+	e.check(ctx, now.Add(time.Hour+2*time.Minute))
+	assert.Empty(t, e.Alerts())
+	notifications = notifier.take()
+	require.Len(t, notifications, 1)
+	assert.True(t, notifications[0].Resolved)
+	assert.Equal(t, "resolved", notifications[0].Status())
+}
+
+func TestEngine_Pending(t *testing.T) {
+	t.Parallel()
+
+	rule := &fakeRule{name: RuleBridgeDown, conditions: []Condition{
+		{Labels: map[string]string{"bridge": "foo"}, Summary: "bridge down"},
+	}}
+	e, notifier := newTestEngine(t, rule)
+	ctx := context.Background()
+	now := time.Now()
+
+	e.check(ctx, now)
+	alerts := e.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, AlertStatePending, alerts[0].State)
+	assert.Empty(t, notifier.take())
+
+	e.check(ctx, now.Add(2*time.Minute))
+	alerts = e.Alerts()
+	require.Len(t, alerts, 1)
+	assert.Equal(t, AlertStateFiring, alerts[0].State)
+	assert.Equal(t, now, alerts[0].StartsAt)
+	require.Len(t, notifier.take(), 1)
+
+	// foo resolves, while bar is still pending
+	rule.conditions = []Condition{{Labels: map[string]string{"bridge": "bar"}}}
+	e.check(ctx, now.Add(3*time.Minute))
+	notifications := notifier.take()
+	require.Len(t, notifications, 1)
+	assert.True(t, notifications[0].Resolved)
+	assert.Equal(t, "foo", notifications[0].Alert.Labels["bridge"])
+
+	// Pending alerts which resolve are never notified
+	rule.conditions = nil
+	e.check(ctx, now.Add(4*time.Minute))
+	assert.Empty(t, notifier.take())
+}
+
+func TestEngine_Silences(t *testing.T) {
+	t.Parallel()
+
+	rule := &fakeRule{name: RuleEVMNodeDead, conditions: []Condition{
+		{Labels: map[string]string{"node": "primary"}},
+		{Labels: map[string]string{"node": "secondary"}},
+	}}
+	e, notifier := newTestEngine(t, rule)
+	e.ruleConfigs[RuleEVMNodeDead] = RuleConfig{Severity: SeverityCritical}
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := e.AddSilence(Silence{EndsAt: now.Add(-time.Minute)})
+	require.EqualError(t, err, "silence must end in the future")
+	_, err = e.AddSilence(Silence{Rule: "foo", EndsAt: now.Add(time.Hour)})
+	require.EqualError(t, err, `unknown rule "foo"`)
+
+	silence, err := e.AddSilence(Silence{
+		Rule:    RuleEVMNodeDead,
+		Labels:  map[string]string{"node": "primary"},
+		Comment: "maintenance",
+		EndsAt:  now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, silence.ID)
+	assert.Equal(t, []Silence{silence}, e.Silences())
+
+	e.check(ctx, now)
+	notifications := notifier.take()
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "secondary", notifications[0].Alert.Labels["node"])
+	for _, alert := range e.Alerts() {
+		assert.Equal(t, alert.Labels["node"] == "primary", alert.Silenced)
+	}
+
+	// Silenced alerts are notified once their silence is expired
+	require.NoError(t, e.ExpireSilence(silence.ID))
+	assert.Equal(t, ErrSilenceNotFound, e.ExpireSilence(silence.ID))
+	e.check(ctx, now.Add(time.Minute))
+	notifications = notifier.take()
+	require.Len(t, notifications, 1)
+	assert.Equal(t, "primary", notifications[0].Alert.Labels["node"])
+
+	// and once it ends
+	_, err = e.AddSilence(Silence{EndsAt: now.Add(90 * time.Minute)})
+	require.NoError(t, err)
+	e.check(ctx, now.Add(time.Hour+time.Minute))
+	assert.Empty(t, notifier.take())
+	e.check(ctx, now.Add(2*time.Hour))
+	assert.Len(t, notifier.take(), 2)
+}
+
+func TestEngine_ChannelRules(t *testing.T) {
+	t.Parallel()
+
+	e, all := newTestEngine(t, &fakeRule{name: RuleNoHeads, conditions: []Condition{{Labels: map[string]string{"evmChainID": "1"}}}})
+	balanceOnly := new(fakeNotifier)
+	e.channels = append(e.channels, channel{ChannelConfig{Type: ChannelEmail, Rules: []string{RuleKeyBalanceLow}}, balanceOnly})
+
+	e.check(context.Background(), time.Now())
+	assert.Len(t, all.take(), 1)
+	assert.Empty(t, balanceOnly.take())
+}
+
+func TestEngine_Disabled(t *testing.T) {
+	t.Parallel()
+
+	e, _ := newTestEngine(t)
+	e.enabled = false
+	require.NoError(t, e.Start())
+	require.NoError(t, e.Close())
+	assert.Empty(t, e.Alerts())
+}
+
+func Test_fingerprint(t *testing.T) {
+	t.Parallel()
+
+	a := fingerprint(RuleKeyBalanceLow, map[string]string{"evmChainID": "1", "address": "0x1"})
+	assert.Equal(t, a, fingerprint(RuleKeyBalanceLow, map[string]string{"address": "0x1", "evmChainID": "1"}))
+	assert.NotEqual(t, a, fingerprint(RuleKeyBalanceLow, map[string]string{"evmChainID": "1", "address": "0x2"}))
+	assert.NotEqual(t, a, fingerprint(RuleNoHeads, map[string]string{"evmChainID": "1", "address": "0x1"}))
+	assert.Len(t, a, 16)
+}
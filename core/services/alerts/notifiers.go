@@ -0,0 +1,193 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/smtp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// notifyTimeout bounds how long sending a notification may take
+	notifyTimeout = 10 * time.Second
+	// defaultSMTPPort is the SMTP submission port
+	defaultSMTPPort = 587
+)
+
+// Notification is sent to channels when an alert fires, again every
+// ALERTS_REPEAT_INTERVAL while it keeps firing, and when it resolves.
+type Notification struct {
+	Alert    Alert
+	Resolved bool
+	At       time.Time
+}
+
+// Status is "firing" or "resolved"
+func (n Notification) Status() string {
+	if n.Resolved {
+		return "resolved"
+	}
+	return string(AlertStateFiring)
+}
+
+// Title is a one line description of the notification
+func (n Notification) Title() string {
+	return fmt.Sprintf("[%s] %s %s", strings.ToUpper(n.Status()), n.Alert.Severity, n.Alert.Rule)
+}
+
+// Text describes the notification to operators
+func (n Notification) Text() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s\n", n.Title(), n.Alert.Summary)
+	keys := make([]string, 0, len(n.Alert.Labels))
+	for k := range n.Alert.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s: %s\n", k, n.Alert.Labels[k])
+	}
+	fmt.Fprintf(&b, "Started at: %s\n", n.Alert.StartsAt.UTC().Format(time.RFC3339))
+	if n.Resolved {
+		fmt.Fprintf(&b, "Resolved at: %s\n", n.At.UTC().Format(time.RFC3339))
+	}
+	return b.String()
+}
+
+// Notifier sends notifications to a channel
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+func newNotifier(cfg ChannelConfig) Notifier {
+	client := &http.Client{Timeout: notifyTimeout}
+	switch cfg.Type {
+	case ChannelSlack:
+		return &slackNotifier{cfg.URL, client}
+	case ChannelEmail:
+		return &emailNotifier{cfg, smtp.SendMail}
+	default:
+		return &webhookNotifier{cfg.URL, cfg.Headers, client}
+	}
+}
+
+// webhookPayload is the body POSTed to webhooks
+type webhookPayload struct {
+	Status     string            `json:"status"`
+	ID         string            `json:"id"`
+	Rule       string            `json:"rule"`
+	Severity   Severity          `json:"severity"`
+	Labels     map[string]string `json:"labels"`
+	Summary    string            `json:"summary"`
+	StartsAt   time.Time         `json:"startsAt"`
+	ResolvedAt *time.Time        `json:"resolvedAt,omitempty"`
+}
+
+// webhookNotifier POSTs notifications to a URL as JSON
+type webhookNotifier struct {
+	url     string
+	headers map[string]string
+	client  *http.Client
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n Notification) error {
+	payload := webhookPayload{
+		Status:   n.Status(),
+		ID:       n.Alert.ID,
+		Rule:     n.Alert.Rule,
+		Severity: n.Alert.Severity,
+		Labels:   n.Alert.Labels,
+		Summary:  n.Alert.Summary,
+		StartsAt: n.Alert.StartsAt,
+	}
+	if n.Resolved {
+		at := n.At
+		payload.ResolvedAt = &at
+	}
+	return postJSON(ctx, w.client, w.url, w.headers, payload)
+}
+
+// slackNotifier POSTs notifications to a Slack compatible incoming webhook
+type slackNotifier struct {
+	url    string
+	client *http.Client
+}
+
+func (s *slackNotifier) Notify(ctx context.Context, n Notification) error {
+	return postJSON(ctx, s.client, s.url, nil, map[string]string{"text": n.Text()})
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal notification")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed to create request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to send notification")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("notification rejected with status %d: %s", resp.StatusCode, msg)
+	}
+	return nil
+}
+
+// emailNotifier sends notifications as emails through an SMTP server
+type emailNotifier struct {
+	cfg      ChannelConfig
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func (e *emailNotifier) Notify(ctx context.Context, n Notification) error {
+	port := e.cfg.SMTPPort
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	addr := net.JoinHostPort(e.cfg.SMTPHost, strconv.Itoa(int(port)))
+	var auth smtp.Auth
+	if e.cfg.Username != "" {
+		auth = smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.SMTPHost)
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", e.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(e.cfg.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", n.Title())
+	fmt.Fprintf(&msg, "Date: %s\r\n", n.At.Format(time.RFC1123Z))
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(n.Text(), "\n", "\r\n"))
+
+	// net/smtp does not take a context, so the email is sent in the
+	// background and abandoned if ctx is done first
+	chErr := make(chan error, 1)
+	go func() {
+		chErr <- e.sendMail(addr, auth, e.cfg.From, e.cfg.To, msg.Bytes())
+	}()
+	select {
+	case err := <-chErr:
+		return errors.Wrap(err, "failed to send email")
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed to send email")
+	}
+}
@@ -0,0 +1,132 @@
+package alerts
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNotification(resolved bool) Notification {
+	startsAt := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	return Notification{
+		Alert: Alert{
+			ID:       "0123456789abcdef",
+			Rule:     RuleKeyBalanceLow,
+			Severity: SeverityWarning,
+			Labels:   map[string]string{"evmChainID": "1", "address": "0x1"},
+			Summary:  "Key 0x1 on chain 1 has a balance of 0.05 ETH, below the threshold of 0.1 ETH",
+			State:    AlertStateFiring,
+			StartsAt: startsAt,
+		},
+		Resolved: resolved,
+		At:       startsAt.Add(time.Hour),
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	chBody := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		chBody <- body
+	}))
+	t.Cleanup(server.Close)
+
+	n := newNotifier(ChannelConfig{Type: ChannelWebhook, URL: server.URL, Headers: map[string]string{"Authorization": "Bearer token"}})
+	require.NoError(t, n.Notify(context.Background(), newTestNotification(true)))
+
+	var payload webhookPayload
+	require.NoError(t, json.Unmarshal(<-chBody, &payload))
+	assert.Equal(t, "resolved", payload.Status)
+	assert.Equal(t, "0123456789abcdef", payload.ID)
+	assert.Equal(t, RuleKeyBalanceLow, payload.Rule)
+	assert.Equal(t, SeverityWarning, payload.Severity)
+	assert.Equal(t, map[string]string{"evmChainID": "1", "address": "0x1"}, payload.Labels)
+	require.NotNil(t, payload.ResolvedAt)
+	assert.True(t, payload.ResolvedAt.Equal(time.Date(2022, 3, 1, 13, 0, 0, 0, time.UTC)))
+}
+
+func TestWebhookNotifier_Rejected(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+
+	n := newNotifier(ChannelConfig{Type: ChannelWebhook, URL: server.URL})
+	err := n.Notify(context.Background(), newTestNotification(false))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "notification rejected with status 401: invalid token")
+}
+
+func TestSlackNotifier(t *testing.T) {
+	t.Parallel()
+
+	chBody := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		assert.NoError(t, err)
+		chBody <- body
+	}))
+	t.Cleanup(server.Close)
+
+	n := newNotifier(ChannelConfig{Type: ChannelSlack, URL: server.URL})
+	require.NoError(t, n.Notify(context.Background(), newTestNotification(false)))
+
+	var payload map[string]string
+	require.NoError(t, json.Unmarshal(<-chBody, &payload))
+	assert.Equal(t, `[FIRING] warning key_balance_low: Key 0x1 on chain 1 has a balance of 0.05 ETH, below the threshold of 0.1 ETH
+address: 0x1
+evmChainID: 1
+Started at: 2022-03-01T12:00:00Z
+`, payload["text"])
+}
+
+func TestEmailNotifier(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr string
+		auth smtp.Auth
+		from string
+		to   []string
+		msg  string
+	)
+	n := &emailNotifier{
+		cfg: ChannelConfig{
+			Type:     ChannelEmail,
+			SMTPHost: "smtp.example.com",
+			Username: "node",
+			Password: "password",
+			From:     "node@example.com",
+			To:       []string{"oncall@example.com", "ops@example.com"},
+		},
+		sendMail: func(a string, au smtp.Auth, f string, rcpt []string, m []byte) error {
+			addr, auth, from, to, msg = a, au, f, rcpt, string(m)
+			return nil
+		},
+	}
+	require.NoError(t, n.Notify(context.Background(), newTestNotification(true)))
+
+	assert.Equal(t, "smtp.example.com:587", addr)
+	assert.NotNil(t, auth)
+	assert.Equal(t, "node@example.com", from)
+	assert.Equal(t, []string{"oncall@example.com", "ops@example.com"}, to)
+	assert.Contains(t, msg, "To: oncall@example.com, ops@example.com\r\n")
+	assert.Contains(t, msg, "Subject: [RESOLVED] warning key_balance_low\r\n")
+	assert.Contains(t, msg, "Resolved at: 2022-03-01T13:00:00Z\r\n")
+}
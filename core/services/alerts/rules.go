@@ -0,0 +1,361 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Condition is a breach of a rule, such as one key having a low balance
+type Condition struct {
+	// Labels identify the condition among those of the rule
+	Labels map[string]string
+	// Summary describes the condition to operators
+	Summary string
+}
+
+// Rule evaluates whether some aspect of the node needs attention
+type Rule interface {
+	Name() string
+	// Evaluate returns the conditions currently breaching the rule
+	Evaluate(ctx context.Context, now time.Time) ([]Condition, error)
+}
+
+// bridgeProbeTimeout bounds how long a bridge may take to respond to a probe
+const bridgeProbeTimeout = 10 * time.Second
+
+// keyBalanceLowRule alerts on sending keys whose ETH balance is below the
+// threshold, as last seen by the balance monitor of their chain.
+type keyBalanceLowRule struct {
+	chainSet  evm.ChainSet
+	keyStore  keystore.Eth
+	threshold assets.Eth
+}
+
+func newKeyBalanceLowRule(chainSet evm.ChainSet, keyStore keystore.Eth, cfg RuleConfig) (*keyBalanceLowRule, error) {
+	threshold, err := assets.NewEthValueS(cfg.Threshold)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid threshold %q", cfg.Threshold)
+	}
+	return &keyBalanceLowRule{chainSet, keyStore, threshold}, nil
+}
+
+func (r *keyBalanceLowRule) Name() string { return RuleKeyBalanceLow }
+
+func (r *keyBalanceLowRule) Evaluate(ctx context.Context, now time.Time) (conditions []Condition, err error) {
+	for _, chain := range r.chainSet.Chains() {
+		bm := chain.BalanceMonitor()
+		if bm == nil {
+			continue
+		}
+		states, err := r.keyStore.GetStatesForChain(chain.ID())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get keys of chain %s", chain.ID())
+		}
+		for _, state := range states {
+			if state.IsFunding {
+				continue
+			}
+			balance := bm.GetEthBalance(state.Address.Address())
+			if balance == nil || balance.Cmp(&r.threshold) >= 0 {
+				continue
+			}
+			conditions = append(conditions, Condition{
+				Labels: map[string]string{"evmChainID": chain.ID().String(), "address": state.Address.Hex()},
+				Summary: fmt.Sprintf("Key %s on chain %s has a balance of %s ETH, below the threshold of %s ETH",
+					state.Address.Hex(), chain.ID(), balance.String(), r.threshold.String()),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// noHeadsRule alerts on chains which have not received a new head for longer
+// than the threshold.
+type noHeadsRule struct {
+	chainSet  evm.ChainSet
+	threshold time.Duration
+
+	mu           sync.Mutex
+	lastHeads    map[string]time.Time
+	unsubscribes map[string]func()
+}
+
+func newNoHeadsRule(chainSet evm.ChainSet, cfg RuleConfig) (*noHeadsRule, error) {
+	threshold, err := time.ParseDuration(cfg.Threshold)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid threshold %q", cfg.Threshold)
+	}
+	return &noHeadsRule{
+		chainSet:     chainSet,
+		threshold:    threshold,
+		lastHeads:    make(map[string]time.Time),
+		unsubscribes: make(map[string]func()),
+	}, nil
+}
+
+func (r *noHeadsRule) Name() string { return RuleNoHeads }
+
+func (r *noHeadsRule) Evaluate(ctx context.Context, now time.Time) (conditions []Condition, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, chain := range r.chainSet.Chains() {
+		id := chain.ID().String()
+		// Chains are subscribed to as they are seen, so that chains added
+		// at runtime are covered too
+		if _, ok := r.unsubscribes[id]; !ok {
+			r.lastHeads[id] = now
+			_, r.unsubscribes[id] = chain.HeadBroadcaster().Subscribe(&headRecorder{r, id})
+			continue
+		}
+		if since := now.Sub(r.lastHeads[id]); since > r.threshold {
+			conditions = append(conditions, Condition{
+				Labels:  map[string]string{"evmChainID": id},
+				Summary: fmt.Sprintf("Chain %s has not received a new head for %s", id, since.Round(time.Second)),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+func (r *noHeadsRule) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for id, unsubscribe := range r.unsubscribes {
+		unsubscribe()
+		delete(r.unsubscribes, id)
+	}
+	return nil
+}
+
+// headRecorder records when the latest head of a chain was received
+type headRecorder struct {
+	rule       *noHeadsRule
+	evmChainID string
+}
+
+func (h *headRecorder) OnNewLongestChain(_ context.Context, _ *evmtypes.Head) {
+	h.rule.mu.Lock()
+	defer h.rule.mu.Unlock()
+	h.rule.lastHeads[h.evmChainID] = time.Now()
+}
+
+// unconfirmedTxAgeRule alerts on chains with a transaction which was
+// broadcast but has not been confirmed for longer than the threshold.
+type unconfirmedTxAgeRule struct {
+	db        *sqlx.DB
+	threshold time.Duration
+}
+
+func newUnconfirmedTxAgeRule(db *sqlx.DB, cfg RuleConfig) (*unconfirmedTxAgeRule, error) {
+	threshold, err := time.ParseDuration(cfg.Threshold)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid threshold %q", cfg.Threshold)
+	}
+	return &unconfirmedTxAgeRule{db, threshold}, nil
+}
+
+func (r *unconfirmedTxAgeRule) Name() string { return RuleUnconfirmedTxAge }
+
+func (r *unconfirmedTxAgeRule) Evaluate(ctx context.Context, now time.Time) (conditions []Condition, err error) {
+	var rows []struct {
+		EVMChainID  utils.Big
+		BroadcastAt null.Time
+	}
+	err = r.db.SelectContext(ctx, &rows, `SELECT evm_chain_id, min(broadcast_at) AS broadcast_at FROM eth_txes WHERE state = 'unconfirmed' GROUP BY evm_chain_id`)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query unconfirmed eth_txes")
+	}
+	for _, row := range rows {
+		if !row.BroadcastAt.Valid {
+			continue
+		}
+		if age := now.Sub(row.BroadcastAt.Time); age > r.threshold {
+			conditions = append(conditions, Condition{
+				Labels:  map[string]string{"evmChainID": row.EVMChainID.String()},
+				Summary: fmt.Sprintf("A transaction on chain %s has been unconfirmed for %s", row.EVMChainID.String(), age.Round(time.Second)),
+			})
+		}
+	}
+	return conditions, nil
+}
+
+// jobErrorRateRule alerts on jobs for which more than the threshold fraction
+// of the runs which finished within the window errored.
+type jobErrorRateRule struct {
+	db        *sqlx.DB
+	threshold float64
+	window    time.Duration
+	minRuns   uint32
+}
+
+func newJobErrorRateRule(db *sqlx.DB, cfg RuleConfig) (*jobErrorRateRule, error) {
+	threshold, err := strconv.ParseFloat(cfg.Threshold, 64)
+	if err != nil || threshold < 0 || threshold > 1 {
+		return nil, errors.Errorf("invalid threshold %q: must be a fraction between 0 and 1", cfg.Threshold)
+	}
+	if cfg.Window.Duration() <= 0 {
+		return nil, errors.New("window must be positive")
+	}
+	return &jobErrorRateRule{db, threshold, cfg.Window.Duration(), cfg.MinRuns}, nil
+}
+
+func (r *jobErrorRateRule) Name() string { return RuleJobErrorRate }
+
+func (r *jobErrorRateRule) Evaluate(ctx context.Context, now time.Time) (conditions []Condition, err error) {
+	var rows []struct {
+		ID      int32
+		Name    null.String
+		Errored uint32
+		Total   uint32
+	}
+	err = r.db.SelectContext(ctx, &rows, `
+SELECT jobs.id, jobs.name, count(*) FILTER (WHERE pipeline_runs.state = $1) AS errored, count(*) AS total
+FROM pipeline_runs
+JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE pipeline_runs.finished_at > $2
+GROUP BY jobs.id, jobs.name`, pipeline.RunStatusErrored, now.Add(-r.window))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query pipeline_runs")
+	}
+	for _, row := range rows {
+		if row.Total == 0 || row.Total < r.minRuns {
+			continue
+		}
+		rate := float64(row.Errored) / float64(row.Total)
+		if rate <= r.threshold {
+			continue
+		}
+		conditions = append(conditions, Condition{
+			Labels: map[string]string{"jobID": strconv.Itoa(int(row.ID)), "jobName": row.Name.ValueOrZero()},
+			Summary: fmt.Sprintf("%d of the %d runs of job %d (%s) in the last %s errored",
+				row.Errored, row.Total, row.ID, row.Name.ValueOrZero(), r.window),
+		})
+	}
+	return conditions, nil
+}
+
+// bridgeDownRule alerts on bridges which cannot be reached. Any HTTP response
+// counts as the bridge being up, since bridges are only expected to handle
+// the requests of bridge tasks.
+type bridgeDownRule struct {
+	orm    bridges.ORM
+	client *http.Client
+}
+
+func newBridgeDownRule(orm bridges.ORM) *bridgeDownRule {
+	return &bridgeDownRule{orm, &http.Client{Timeout: bridgeProbeTimeout}}
+}
+
+func (r *bridgeDownRule) Name() string { return RuleBridgeDown }
+
+func (r *bridgeDownRule) Evaluate(ctx context.Context, now time.Time) ([]Condition, error) {
+	var bts []bridges.BridgeType
+	for offset := 0; ; {
+		page, count, err := r.orm.BridgeTypes(offset, 100)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list bridges")
+		}
+		bts = append(bts, page...)
+		offset += len(page)
+		if len(page) == 0 || offset >= count {
+			break
+		}
+	}
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		conditions []Condition
+	)
+	for _, bt := range bts {
+		bt := bt
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := r.probe(ctx, bt); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				conditions = append(conditions, Condition{
+					Labels:  map[string]string{"bridge": bt.Name.String()},
+					Summary: fmt.Sprintf("Bridge %s cannot be reached: %v", bt.Name, err),
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	return conditions, nil
+}
+
+func (r *bridgeDownRule) probe(ctx context.Context, bt bridges.BridgeType) error {
+	u := url.URL(bt.URL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// evmNodeDeadRule alerts on EVM nodes which are dead, as reported by their
+// state transitions.
+type evmNodeDeadRule struct {
+	mu         sync.RWMutex
+	dead       map[string]time.Time
+	unregister func()
+}
+
+func newEVMNodeDeadRule() *evmNodeDeadRule {
+	r := &evmNodeDeadRule{dead: make(map[string]time.Time)}
+	r.unregister = evmclient.OnNodeStateChange(r.onStateChange)
+	return r
+}
+
+func (r *evmNodeDeadRule) onStateChange(change evmclient.NodeStateChange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if change.To == evmclient.NodeStateDead {
+		r.dead[change.Name] = change.At
+	} else {
+		delete(r.dead, change.Name)
+	}
+}
+
+func (r *evmNodeDeadRule) Name() string { return RuleEVMNodeDead }
+
+func (r *evmNodeDeadRule) Evaluate(ctx context.Context, now time.Time) (conditions []Condition, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for name, since := range r.dead {
+		conditions = append(conditions, Condition{
+			Labels:  map[string]string{"node": name},
+			Summary: fmt.Sprintf("EVM node %s has been dead for %s", name, now.Sub(since).Round(time.Second)),
+		})
+	}
+	return conditions, nil
+}
+
+func (r *evmNodeDeadRule) Close() error {
+	r.unregister()
+	return nil
+}
@@ -0,0 +1,223 @@
+package blockhashstore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// DefaultBackwardsBatchSize is the maximum number of hashes a backwards run
+// enqueues for storing per feeder run.
+const DefaultBackwardsBatchSize = 100
+
+// DefaultBackwardsStoreTimeout is how long a backwards run waits for the last
+// hash it enqueued to be stored before assuming that its transaction was
+// dropped or reverted.
+const DefaultBackwardsStoreTimeout = 30 * time.Minute
+
+var (
+	promBackwardsBlocksEnqueued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blockhash_store_backwards_blocks_enqueued",
+		Help: "The number of blockhashes enqueued for storing by backwards runs",
+	}, []string{"job_id"})
+	promBackwardsBlocksRemaining = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "blockhash_store_backwards_blocks_remaining",
+		Help: "The number of blockhashes the running backwards runs of a job have not enqueued yet",
+	}, []string{"job_id"})
+	promBackwardsRunsFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "blockhash_store_backwards_runs_finished",
+		Help: "The number of backwards runs which completed or errored",
+	}, []string{"job_id", "state"})
+)
+
+// BackwardsRunState is the state of a BackwardsRun.
+type BackwardsRunState string
+
+const (
+	BackwardsRunRunning   BackwardsRunState = "running"
+	BackwardsRunCompleted BackwardsRunState = "completed"
+	BackwardsRunErrored   BackwardsRunState = "errored"
+)
+
+// BackwardsRun stores the hashes of the blocks from StartBlock-1 down to
+// TargetBlock, each verified against the header of the block after it. This
+// makes it possible to store the hashes of blocks older than the 256 most
+// recent ones, starting from the stored hash of StartBlock.
+type BackwardsRun struct {
+	ID          int64
+	JobID       int32
+	StartBlock  int64
+	TargetBlock int64
+	// NextBlock is the highest block whose hash has not been enqueued for
+	// storing yet, or TargetBlock-1 once all have been.
+	NextBlock int64
+	State     BackwardsRunState
+	Error     null.String
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Remaining returns the number of hashes the run has not enqueued yet.
+func (r BackwardsRun) Remaining() int64 {
+	if r.NextBlock < r.TargetBlock {
+		return 0
+	}
+	return r.NextBlock - r.TargetBlock + 1
+}
+
+// NewBackwardsFeeder creates a new BackwardsFeeder instance.
+func NewBackwardsFeeder(
+	logger logger.Logger,
+	orm ORM,
+	bhs BHS,
+	jobID int32,
+	batchSize int,
+	storeTimeout time.Duration,
+	header func(ctx context.Context, blockNum uint64) ([]byte, error),
+) *BackwardsFeeder {
+	return &BackwardsFeeder{
+		lggr:         logger,
+		orm:          orm,
+		bhs:          bhs,
+		jobID:        jobID,
+		batchSize:    batchSize,
+		storeTimeout: storeTimeout,
+		header:       header,
+	}
+}
+
+// BackwardsFeeder advances the backwards runs of a BlockhashStore feeder job.
+// Runs are persisted, so that they resume where they stopped when the node
+// restarts.
+type BackwardsFeeder struct {
+	lggr         logger.Logger
+	orm          ORM
+	bhs          BHS
+	jobID        int32
+	batchSize    int
+	storeTimeout time.Duration
+
+	// header returns the RLP encoded header of a block.
+	header func(ctx context.Context, blockNum uint64) ([]byte, error)
+}
+
+// Run advances the running backwards runs of the job.
+func (f *BackwardsFeeder) Run(ctx context.Context) error {
+	runs, err := f.orm.FindRunningBackwardsRuns(f.jobID, pg.WithParentCtx(ctx))
+	if err != nil {
+		return errors.Wrap(err, "fetching running backwards runs")
+	}
+
+	var (
+		errs      error
+		remaining int64
+	)
+	for i := range runs {
+		run := &runs[i]
+		if err = f.advance(ctx, run); err != nil {
+			f.lggr.Errorw("Failed to advance backwards run", "runID", run.ID, "error", err)
+			errs = multierr.Append(errs, errors.Wrapf(err, "advancing backwards run %d", run.ID))
+		}
+		if run.State == BackwardsRunRunning {
+			remaining += run.Remaining()
+		}
+	}
+	promBackwardsBlocksRemaining.WithLabelValues(fmt.Sprint(f.jobID)).Set(float64(remaining))
+	return errs
+}
+
+// advance enqueues the next batch of hashes of run once the last hash it
+// enqueued has been stored.
+func (f *BackwardsFeeder) advance(ctx context.Context, run *BackwardsRun) error {
+	// The block after NextBlock is either the start block or the last block
+	// whose hash was enqueued, which the next header is verified against.
+	stored, err := f.bhs.IsStored(ctx, uint64(run.NextBlock+1))
+	if err != nil {
+		return errors.Wrapf(err, "checking if block %d is stored", run.NextBlock+1)
+	}
+	if !stored {
+		if run.NextBlock+1 == run.StartBlock {
+			return f.finish(ctx, run, BackwardsRunErrored,
+				errors.Errorf("hash of start block %d is not stored", run.StartBlock))
+		}
+		// UpdatedAt is the time the last batch was enqueued, as the run is not
+		// updated while waiting.
+		if time.Since(run.UpdatedAt) < f.storeTimeout {
+			f.lggr.Debugw("Waiting for the last enqueued hash to be stored",
+				"runID", run.ID, "block", run.NextBlock+1)
+			return nil
+		}
+		lowest, err := f.lowestStored(ctx, run)
+		if err != nil {
+			return err
+		}
+		if lowest == 0 {
+			return f.finish(ctx, run, BackwardsRunErrored,
+				errors.Errorf("hash of block %d was not stored within %s and no hash of the run is stored", run.NextBlock+1, f.storeTimeout))
+		}
+		// The transaction storing it was dropped or reverted, so resume below
+		// the last hash which was stored.
+		f.lggr.Warnw("Timed out waiting for the last enqueued hash to be stored, enqueuing again",
+			"runID", run.ID, "block", run.NextBlock+1, "lowestStored", lowest, "timeout", f.storeTimeout)
+		run.NextBlock = lowest - 1
+	}
+	if run.NextBlock < run.TargetBlock {
+		return f.finish(ctx, run, BackwardsRunCompleted, nil)
+	}
+
+	var errs error
+	for i := 0; i < f.batchSize && run.NextBlock >= run.TargetBlock; i++ {
+		header, err := f.header(ctx, uint64(run.NextBlock+1))
+		if err != nil {
+			errs = errors.Wrapf(err, "fetching header of block %d", run.NextBlock+1)
+			break
+		}
+		if err = f.bhs.StoreVerifyHeader(ctx, uint64(run.NextBlock), header); err != nil {
+			errs = errors.Wrapf(err, "storing block %d", run.NextBlock)
+			break
+		}
+		promBackwardsBlocksEnqueued.WithLabelValues(fmt.Sprint(f.jobID)).Inc()
+		run.NextBlock--
+	}
+	f.lggr.Debugw("Enqueued backwards run hashes", "runID", run.ID, "nextBlock", run.NextBlock,
+		"remaining", run.Remaining())
+	return multierr.Append(errs, f.orm.UpdateBackwardsRun(run, pg.WithParentCtx(ctx)))
+}
+
+// lowestStored returns the lowest block above the run's NextBlock+1 up to its
+// start block whose hash is stored, or 0 if there is none. The hashes of a
+// run are stored from the top down, so every block above it is stored too.
+func (f *BackwardsFeeder) lowestStored(ctx context.Context, run *BackwardsRun) (int64, error) {
+	for block := run.NextBlock + 2; block <= run.StartBlock; block++ {
+		stored, err := f.bhs.IsStored(ctx, uint64(block))
+		if err != nil {
+			return 0, errors.Wrapf(err, "checking if block %d is stored", block)
+		}
+		if stored {
+			return block, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *BackwardsFeeder) finish(ctx context.Context, run *BackwardsRun, state BackwardsRunState, runErr error) error {
+	run.State = state
+	if runErr != nil {
+		run.Error = null.StringFrom(runErr.Error())
+		f.lggr.Errorw("Backwards run errored", "runID", run.ID, "error", runErr)
+	} else {
+		f.lggr.Infow("Backwards run completed", "runID", run.ID,
+			"startBlock", run.StartBlock, "targetBlock", run.TargetBlock)
+	}
+	promBackwardsRunsFinished.WithLabelValues(fmt.Sprint(f.jobID), string(state)).Inc()
+	return f.orm.UpdateBackwardsRun(run, pg.WithParentCtx(ctx))
+}
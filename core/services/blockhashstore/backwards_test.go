@@ -0,0 +1,142 @@
+package blockhashstore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+var _ ORM = &testORM{}
+
+func TestBackwardsFeeder(t *testing.T) {
+	header := func(_ context.Context, blockNum uint64) ([]byte, error) {
+		return []byte(fmt.Sprint(blockNum)), nil
+	}
+
+	t.Run("walks down to the target block in batches", func(t *testing.T) {
+		orm := &testORM{}
+		require.NoError(t, orm.CreateBackwardsRun(&BackwardsRun{JobID: 1, StartBlock: 100, TargetBlock: 97}))
+		bhs := &testBHS{stored: []uint64{100}}
+		feeder := NewBackwardsFeeder(logger.TestLogger(t), orm, bhs, 1, 2, time.Minute, header)
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, []uint64{100, 99, 98}, bhs.stored)
+		assert.Equal(t, [][]byte{[]byte("100"), []byte("99")}, bhs.headers)
+		assert.Equal(t, int64(97), orm.runs[0].NextBlock)
+		assert.Equal(t, int64(1), orm.runs[0].Remaining())
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, []uint64{100, 99, 98, 97}, bhs.stored)
+		assert.Equal(t, int64(96), orm.runs[0].NextBlock)
+		assert.Equal(t, BackwardsRunRunning, orm.runs[0].State)
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, BackwardsRunCompleted, orm.runs[0].State)
+		assert.Equal(t, []uint64{100, 99, 98, 97}, bhs.stored)
+	})
+
+	t.Run("errors if the start block is not stored", func(t *testing.T) {
+		orm := &testORM{}
+		require.NoError(t, orm.CreateBackwardsRun(&BackwardsRun{JobID: 1, StartBlock: 100, TargetBlock: 97}))
+		bhs := &testBHS{}
+		feeder := NewBackwardsFeeder(logger.TestLogger(t), orm, bhs, 1, 2, time.Minute, header)
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, BackwardsRunErrored, orm.runs[0].State)
+		assert.Equal(t, "hash of start block 100 is not stored", orm.runs[0].Error.String)
+		assert.Empty(t, bhs.stored)
+	})
+
+	t.Run("waits for the last enqueued hash to be stored", func(t *testing.T) {
+		orm := &testORM{runs: []BackwardsRun{{ID: 1, JobID: 1, StartBlock: 100, TargetBlock: 90, NextBlock: 97, State: BackwardsRunRunning, UpdatedAt: time.Now()}}}
+		bhs := &testBHS{stored: []uint64{100, 99}}
+		feeder := NewBackwardsFeeder(logger.TestLogger(t), orm, bhs, 1, 2, time.Minute, header)
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, int64(97), orm.runs[0].NextBlock)
+		assert.Equal(t, BackwardsRunRunning, orm.runs[0].State)
+		assert.Equal(t, []uint64{100, 99}, bhs.stored)
+	})
+
+	t.Run("enqueues again below the last stored hash after the store timeout", func(t *testing.T) {
+		orm := &testORM{runs: []BackwardsRun{{ID: 1, JobID: 1, StartBlock: 100, TargetBlock: 90, NextBlock: 96, State: BackwardsRunRunning, UpdatedAt: time.Now().Add(-time.Hour)}}}
+		bhs := &testBHS{stored: []uint64{100, 99}}
+		feeder := NewBackwardsFeeder(logger.TestLogger(t), orm, bhs, 1, 2, time.Minute, header)
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, []uint64{100, 99, 98, 97}, bhs.stored)
+		assert.Equal(t, [][]byte{[]byte("99"), []byte("98")}, bhs.headers)
+		assert.Equal(t, int64(96), orm.runs[0].NextBlock)
+		assert.Equal(t, BackwardsRunRunning, orm.runs[0].State)
+	})
+
+	t.Run("persists partial progress", func(t *testing.T) {
+		orm := &testORM{}
+		require.NoError(t, orm.CreateBackwardsRun(&BackwardsRun{JobID: 1, StartBlock: 100, TargetBlock: 90}))
+		bhs := &testBHS{stored: []uint64{100}, errorsStore: []uint64{98}}
+		feeder := NewBackwardsFeeder(logger.TestLogger(t), orm, bhs, 1, 5, time.Minute, header)
+
+		require.Error(t, feeder.Run(context.Background()))
+		assert.Equal(t, int64(98), orm.runs[0].NextBlock)
+		assert.Equal(t, BackwardsRunRunning, orm.runs[0].State)
+	})
+
+	t.Run("ignores runs which are not running", func(t *testing.T) {
+		orm := &testORM{runs: []BackwardsRun{{ID: 1, JobID: 1, StartBlock: 100, TargetBlock: 90, NextBlock: 99, State: BackwardsRunErrored}}}
+		bhs := &testBHS{stored: []uint64{100}}
+		feeder := NewBackwardsFeeder(logger.TestLogger(t), orm, bhs, 1, 5, time.Minute, header)
+
+		require.NoError(t, feeder.Run(context.Background()))
+		assert.Equal(t, []uint64{100}, bhs.stored)
+	})
+}
+
+type testORM struct {
+	runs []BackwardsRun
+}
+
+func (o *testORM) CreateBackwardsRun(run *BackwardsRun, _ ...pg.QOpt) error {
+	run.ID = int64(len(o.runs) + 1)
+	run.NextBlock = run.StartBlock - 1
+	run.State = BackwardsRunRunning
+	run.CreatedAt, run.UpdatedAt = time.Now(), time.Now()
+	o.runs = append(o.runs, *run)
+	return nil
+}
+
+func (o *testORM) FindBackwardsRuns(jobID int32, _ ...pg.QOpt) (runs []BackwardsRun, err error) {
+	for _, run := range o.runs {
+		if run.JobID == jobID {
+			runs = append(runs, run)
+		}
+	}
+	return runs, nil
+}
+
+func (o *testORM) FindRunningBackwardsRuns(jobID int32, _ ...pg.QOpt) (runs []BackwardsRun, err error) {
+	for _, run := range o.runs {
+		if run.JobID == jobID && run.State == BackwardsRunRunning {
+			runs = append(runs, run)
+		}
+	}
+	return runs, nil
+}
+
+func (o *testORM) UpdateBackwardsRun(run *BackwardsRun, _ ...pg.QOpt) error {
+	for i := range o.runs {
+		if o.runs[i].ID == run.ID {
+			run.UpdatedAt = time.Now()
+			o.runs[i] = *run
+			return nil
+		}
+	}
+	return errors.Errorf("no run %d", run.ID)
+}
@@ -3,8 +3,10 @@ package blockhashstore
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -24,6 +26,7 @@ type Delegate struct {
 	logger logger.Logger
 	chains evm.ChainSet
 	ks     keystore.Eth
+	orm    ORM
 }
 
 // NewDelegate creates a new Delegate.
@@ -31,11 +34,13 @@ func NewDelegate(
 	logger logger.Logger,
 	chains evm.ChainSet,
 	ks keystore.Eth,
+	orm ORM,
 ) *Delegate {
 	return &Delegate{
 		logger: logger,
 		chains: chains,
 		ks:     ks,
+		orm:    orm,
 	}
 }
 
@@ -50,6 +55,11 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 		return nil, errors.Errorf(
 			"blockhashstore.Delegate expects a BlockhashStoreSpec to be present, got %+v", jb)
 	}
+	if jb.PipelineSpec != nil {
+		jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+		jb.PipelineSpec.ExternalJobID = jb.ExternalJobID
+		jb.PipelineSpec.JobID = jb.ID
+	}
 
 	chain, err := d.chains.Get(jb.BlockhashStoreSpec.EVMChainID.ToInt())
 	if err != nil {
@@ -118,13 +128,29 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 			return uint64(head.Number), nil
 		})
 
+	backwardsFeeder := NewBackwardsFeeder(
+		log.Named("Backwards"),
+		d.orm,
+		bpBHS,
+		jb.ID,
+		DefaultBackwardsBatchSize,
+		DefaultBackwardsStoreTimeout,
+		func(ctx context.Context, blockNum uint64) ([]byte, error) {
+			header, err := chain.Client().HeaderByNumber(ctx, new(big.Int).SetUint64(blockNum))
+			if err != nil {
+				return nil, errors.Wrap(err, "getting header")
+			}
+			return rlp.EncodeToBytes(header)
+		})
+
 	return []job.Service{&service{
-		feeder:     feeder,
-		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
-		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
-		logger:     log,
-		stop:       make(chan struct{}),
-		done:       make(chan struct{}),
+		feeder:          feeder,
+		backwardsFeeder: backwardsFeeder,
+		pollPeriod:      jb.BlockhashStoreSpec.PollPeriod,
+		runTimeout:      jb.BlockhashStoreSpec.RunTimeout,
+		logger:          log,
+		stop:            make(chan struct{}),
+		done:            make(chan struct{}),
 	}}, nil
 }
 
@@ -134,16 +160,18 @@ func (d *Delegate) AfterJobCreated(spec job.Job) {}
 // BeforeJobDeleted satisfies the job.Delegate interface.
 func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
 
-// service is a job.Service that runs the BHS feeder every pollPeriod.
+// service is a job.Service that runs the BHS feeder and its backwards runs
+// every pollPeriod.
 type service struct {
 	utils.StartStopOnce
-	feeder     *Feeder
-	stop, done chan struct{}
-	pollPeriod time.Duration
-	runTimeout time.Duration
-	logger     logger.Logger
-	parentCtx  context.Context
-	cancel     context.CancelFunc
+	feeder          *Feeder
+	backwardsFeeder *BackwardsFeeder
+	stop, done      chan struct{}
+	pollPeriod      time.Duration
+	runTimeout      time.Duration
+	logger          logger.Logger
+	parentCtx       context.Context
+	cancel          context.CancelFunc
 }
 
 // Start the BHS feeder service, satisfying the job.Service interface.
@@ -158,6 +186,7 @@ func (s *service) Start() error {
 				select {
 				case <-ticker.C:
 					s.runFeeder()
+					s.runBackwardsFeeder()
 				case <-s.stop:
 					ticker.Stop()
 					return
@@ -191,3 +220,12 @@ func (s *service) runFeeder() {
 			"error", err)
 	}
 }
+
+func (s *service) runBackwardsFeeder() {
+	ctx, cancel := context.WithTimeout(s.parentCtx, s.runTimeout)
+	defer cancel()
+	if err := s.backwardsFeeder.Run(ctx); err != nil {
+		s.logger.Errorw("BHS backwards feeder run was at least partially unsuccessful",
+			"error", err)
+	}
+}
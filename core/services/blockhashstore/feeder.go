@@ -34,6 +34,10 @@ type BHS interface {
 	// Store the hash associated with blockNum.
 	Store(ctx context.Context, blockNum uint64) error
 
+	// StoreVerifyHeader stores the hash associated with blockNum, given the RLP encoded header
+	// of blockNum+1, whose hash must already be stored.
+	StoreVerifyHeader(ctx context.Context, blockNum uint64, header []byte) error
+
 	// IsStored checks whether the hash associated with blockNum is already stored.
 	IsStored(ctx context.Context, blockNum uint64) (bool, error)
 }
@@ -287,6 +287,9 @@ type testBHS struct {
 
 	// errorsIsStored defines which block numbers should return errors on IsStored.
 	errorsIsStored []uint64
+
+	// headers are the headers passed to StoreVerifyHeader.
+	headers [][]byte
 }
 
 func (t *testBHS) Store(_ context.Context, blockNum uint64) error {
@@ -300,6 +303,18 @@ func (t *testBHS) Store(_ context.Context, blockNum uint64) error {
 	return nil
 }
 
+func (t *testBHS) StoreVerifyHeader(_ context.Context, blockNum uint64, header []byte) error {
+	for _, e := range t.errorsStore {
+		if e == blockNum {
+			return errors.New("error storing")
+		}
+	}
+
+	t.stored = append(t.stored, blockNum)
+	t.headers = append(t.headers, header)
+	return nil
+}
+
 func (t *testBHS) IsStored(_ context.Context, blockNum uint64) (bool, error) {
 	for _, e := range t.errorsIsStored {
 		if e == blockNum {
@@ -0,0 +1,70 @@
+package blockhashstore
+
+import (
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+)
+
+// ORM persists the backwards runs of BlockhashStore feeder jobs.
+type ORM interface {
+	CreateBackwardsRun(run *BackwardsRun, qopts ...pg.QOpt) error
+	FindBackwardsRuns(jobID int32, qopts ...pg.QOpt) ([]BackwardsRun, error)
+	FindRunningBackwardsRuns(jobID int32, qopts ...pg.QOpt) ([]BackwardsRun, error)
+	UpdateBackwardsRun(run *BackwardsRun, qopts ...pg.QOpt) error
+}
+
+var _ ORM = &orm{}
+
+type orm struct {
+	q pg.Q
+}
+
+// NewORM creates a new ORM.
+func NewORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ORM {
+	return &orm{
+		q: pg.NewQ(db, lggr, cfg),
+	}
+}
+
+const backwardsRunColumns = `id, job_id, start_block, target_block, next_block, state, error, created_at, updated_at`
+
+// CreateBackwardsRun creates a running backwards run, which has not stored
+// any hash yet.
+func (o *orm) CreateBackwardsRun(run *BackwardsRun, qopts ...pg.QOpt) error {
+	run.NextBlock = run.StartBlock - 1
+	run.State = BackwardsRunRunning
+	stmt := `
+INSERT INTO blockhash_store_backwards_runs (job_id, start_block, target_block, next_block, state, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, NOW(), NOW())
+RETURNING ` + backwardsRunColumns
+	err := o.q.WithOpts(qopts...).Get(run, stmt, run.JobID, run.StartBlock, run.TargetBlock, run.NextBlock, run.State)
+	return errors.Wrap(err, "CreateBackwardsRun failed")
+}
+
+// FindBackwardsRuns returns the backwards runs of a job, newest first.
+func (o *orm) FindBackwardsRuns(jobID int32, qopts ...pg.QOpt) (runs []BackwardsRun, err error) {
+	stmt := `SELECT ` + backwardsRunColumns + ` FROM blockhash_store_backwards_runs WHERE job_id = $1 ORDER BY id DESC`
+	err = o.q.WithOpts(qopts...).Select(&runs, stmt, jobID)
+	return runs, errors.Wrap(err, "FindBackwardsRuns failed")
+}
+
+// FindRunningBackwardsRuns returns the running backwards runs of a job,
+// oldest first.
+func (o *orm) FindRunningBackwardsRuns(jobID int32, qopts ...pg.QOpt) (runs []BackwardsRun, err error) {
+	stmt := `SELECT ` + backwardsRunColumns + ` FROM blockhash_store_backwards_runs WHERE job_id = $1 AND state = $2 ORDER BY id ASC`
+	err = o.q.WithOpts(qopts...).Select(&runs, stmt, jobID, BackwardsRunRunning)
+	return runs, errors.Wrap(err, "FindRunningBackwardsRuns failed")
+}
+
+// UpdateBackwardsRun persists the progress and state of run.
+func (o *orm) UpdateBackwardsRun(run *BackwardsRun, qopts ...pg.QOpt) error {
+	stmt := `
+UPDATE blockhash_store_backwards_runs SET next_block = $1, state = $2, error = $3, updated_at = NOW()
+WHERE id = $4
+RETURNING updated_at`
+	err := o.q.WithOpts(qopts...).Get(&run.UpdatedAt, stmt, run.NextBlock, run.State, run.Error, run.ID)
+	return errors.Wrap(err, "UpdateBackwardsRun failed")
+}
@@ -27,8 +27,10 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/terra"
 	terratypes "github.com/smartcontractkit/chainlink/core/chains/terra/types"
 	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -46,9 +48,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/promreporter"
 	"github.com/smartcontractkit/chainlink/core/services/relay"
 	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
 	relaytypes "github.com/smartcontractkit/chainlink/core/services/relay/types"
+	"github.com/smartcontractkit/chainlink/core/services/streams"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -67,6 +72,7 @@ type Application interface {
 	GetSqlxDB() *sqlx.DB
 	GetConfig() config.GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
+	ReloadConfigFile() ([]configfile.Change, error)
 	GetKeyStore() keystore.Master
 	GetEventBroadcaster() pg.EventBroadcaster
 	WakeSessionReaper()
@@ -81,7 +87,9 @@ type Application interface {
 	EVMORM() evmtypes.ORM
 	TerraORM() terratypes.ORM
 	PipelineORM() pipeline.ORM
+	PipelineRunner() pipeline.Runner
 	BridgeORM() bridges.ORM
+	AlertEngine() alerts.Engine
 	SessionORM() sessions.ORM
 	BPTXMORM() bulletprooftxmanager.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
@@ -90,6 +98,7 @@ type Application interface {
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
+	ReplayJobRunV2(ctx context.Context, jobID int32, runID int64, currentSpec bool) (pipeline.Replay, error)
 	SetServiceLogLevel(ctx context.Context, service string, level zapcore.Level) error
 
 	// Feeds
@@ -113,6 +122,7 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	alertEngine              alerts.Engine
 	sessionORM               sessions.ORM
 	bptxmORM                 bulletprooftxmanager.ORM
 	FeedsService             feeds.Service
@@ -131,6 +141,10 @@ type ChainlinkApplication struct {
 
 	started     bool
 	startStopMu sync.Mutex
+
+	// configFile was last loaded by ReloadConfigFile
+	configFile   *configfile.File
+	configFileMu sync.Mutex
 }
 
 type ApplicationOpts struct {
@@ -179,6 +193,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 
 	healthChecker := services.NewChecker()
 
+	// Tracing is started first so that it is in place before any other
+	// service begins creating spans, and closed last so that they are flushed
+	subservices = append(subservices, tracing.NewProvider(cfg, globalLogger))
+
 	telemetryIngressClient := synchronization.TelemetryIngressClient(&synchronization.NoopTelemetryIngressClient{})
 	telemetryIngressBatchClient := synchronization.TelemetryIngressBatchClient(&synchronization.NoopTelemetryIngressBatchClient{})
 	explorerClient := synchronization.ExplorerClient(&synchronization.NoopExplorerClient{})
@@ -223,12 +241,19 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	subservices = append(subservices, eventBroadcaster, chains.EVM)
 	promReporter := promreporter.NewPromReporter(db.DB, globalLogger)
 	subservices = append(subservices, promReporter)
+	subservices = append(subservices, webhook.NewExternalInitiatorNotifier(externalInitiatorManager, globalLogger))
+
+	streamManager, err := streams.NewManager(cfg, globalLogger)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewApplication: failed to initialize stream manager")
+	}
+	subservices = append(subservices, streamManager)
 
 	var (
 		pipelineORM    = pipeline.NewORM(db, globalLogger, cfg)
 		bridgeORM      = bridges.NewORM(db, globalLogger, cfg)
 		sessionORM     = sessions.NewORM(db, cfg.SessionTimeout().Duration(), globalLogger)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), globalLogger)
+		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chains.EVM, keyStore.Eth(), keyStore.VRF(), streamManager, globalLogger)
 		jobORM         = job.NewORM(db, chains.EVM, pipelineORM, keyStore, globalLogger, cfg)
 		bptxmORM       = bulletprooftxmanager.NewORM(db, globalLogger, cfg)
 	)
@@ -238,12 +263,19 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		chain.TxManager().RegisterResumeCallback(pipelineRunner.ResumeRun)
 	}
 
+	alertEngine, err := alerts.NewEngine(cfg, db, chains.EVM, keyStore.Eth(), bridgeORM, globalLogger)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewApplication: failed to initialize alert engine")
+	}
+	subservices = append(subservices, alertEngine)
+
 	var (
 		delegates = map[job.Type]job.Delegate{
 			job.DirectRequest: directrequest.NewDelegate(
 				globalLogger,
 				pipelineRunner,
 				pipelineORM,
+				jobORM,
 				chains.EVM),
 			job.Keeper: keeper.NewDelegate(
 				db,
@@ -265,11 +297,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				jobORM,
 				globalLogger),
 			job.BlockhashStore: blockhashstore.NewDelegate(
 				globalLogger,
 				chains.EVM,
-				keyStore.Eth()),
+				keyStore.Eth(),
+				blockhashstore.NewORM(db, globalLogger, cfg)),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
@@ -316,6 +350,22 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 	if cfg.FeatureOffchainReporting2() {
 		globalLogger.Debug("Off-chain reporting v2 enabled")
+		relayPlugins, err := plugin.ParsePlugins(cfg, relay.SupportedRelayers)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to load relay plugins")
+		}
+		// a relayer plugin runs the chains of its network, which the node
+		// must not run too
+		chainsEnabled := map[relaytypes.Network]bool{
+			relaytypes.EVM:    cfg.EVMEnabled(),
+			relaytypes.Solana: cfg.SolanaEnabled(),
+			relaytypes.Terra:  cfg.TerraEnabled(),
+		}
+		for network := range relayPlugins {
+			if chainsEnabled[network] {
+				return nil, errors.Errorf("NewApplication: %s chains must be disabled to run the %[1]s relayer as a plugin", network)
+			}
+		}
 		// master/delegate relay is started once, on app start, as root subservice
 		relay := relay.NewDelegate(keyStore)
 		if cfg.EVMEnabled() {
@@ -330,6 +380,10 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			terraRelayer := pkgterra.NewRelayer(globalLogger.Named("Terra.Relayer"), chains.Terra)
 			relay.AddRelayer(relaytypes.Terra, terraRelayer)
 		}
+		// relayers configured as plugins serve the networks disabled above
+		for network, processConfig := range relayPlugins {
+			relay.AddRelayer(network, plugin.NewRelayer(network, processConfig, globalLogger))
+		}
 		subservices = append(subservices, relay)
 		delegates[job.OffchainReporting2] = offchainreporting2.NewDelegate(
 			db,
@@ -360,6 +414,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
 	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, db, globalLogger, lbs)
+	jobSpawner.OnJobDeleted(pipelineRunner.ForgetJob)
 	subservices = append(subservices, jobSpawner, pipelineRunner)
 
 	// TODO: Make feeds manager compatible with multiple chains
@@ -386,6 +441,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		alertEngine:              alertEngine,
 		sessionORM:               sessionORM,
 		bptxmORM:                 bptxmORM,
 		FeedsService:             feedsService,
@@ -570,6 +626,10 @@ func (app *ChainlinkApplication) BridgeORM() bridges.ORM {
 	return app.bridgeORM
 }
 
+func (app *ChainlinkApplication) AlertEngine() alerts.Engine {
+	return app.alertEngine
+}
+
 func (app *ChainlinkApplication) SessionORM() sessions.ORM {
 	return app.sessionORM
 }
@@ -587,6 +647,10 @@ func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) PipelineRunner() pipeline.Runner {
+	return app.pipelineRunner
+}
+
 func (app *ChainlinkApplication) BPTXMORM() bulletprooftxmanager.ORM {
 	return app.bptxmORM
 }
@@ -686,6 +750,58 @@ func (app *ChainlinkApplication) RunJobV2(
 	return runID, err
 }
 
+// ReplayJobRunV2 re-executes a run of a job with the vars it was originally
+// run with, in a sandbox which never submits transactions, and compares the
+// results. The run is replayed with the pipeline spec it was run with, or with
+// the current pipeline spec of the job if currentSpec is true. Replays are not
+// persisted.
+func (app *ChainlinkApplication) ReplayJobRunV2(
+	ctx context.Context,
+	jobID int32,
+	runID int64,
+	currentSpec bool,
+) (pipeline.Replay, error) {
+	jb, err := app.jobORM.FindJob(ctx, jobID)
+	if err != nil {
+		return pipeline.Replay{}, errors.Wrapf(err, "job ID %v", jobID)
+	}
+	if jb.PipelineSpec == nil {
+		return pipeline.Replay{}, errors.Errorf("job ID %v has no pipeline", jobID)
+	}
+	run, err := app.pipelineORM.FindRun(runID)
+	if err != nil {
+		return pipeline.Replay{}, errors.Wrapf(err, "run ID %v", runID)
+	}
+	if !run.FinishedAt.Valid {
+		return pipeline.Replay{}, errors.Errorf("run ID %v has not finished", runID)
+	}
+	// Runs imported from an archive may belong to a job which no longer
+	// exists, in which case they can be replayed against any job
+	if run.PipelineSpecID != jb.PipelineSpecID {
+		jbs, err2 := app.jobORM.FindJobsByPipelineSpecIDs([]int32{run.PipelineSpecID})
+		if err2 != nil {
+			return pipeline.Replay{}, err2
+		}
+		if len(jbs) > 0 {
+			return pipeline.Replay{}, errors.Errorf("run ID %v does not belong to job ID %v", runID, jobID)
+		}
+	}
+
+	spec := run.PipelineSpec
+	if currentSpec {
+		spec = *jb.PipelineSpec
+	}
+	spec.JobID = jb.ID
+	spec.JobName = jb.Name.ValueOrZero()
+	spec.ExternalJobID = jb.ExternalJobID
+
+	replayed, _, err := app.pipelineRunner.ExecuteReplay(ctx, spec, pipeline.ReplayVars(run), app.logger.With("jobID", jb.ID, "runID", run.ID))
+	if err != nil {
+		return pipeline.Replay{}, errors.Wrapf(err, "failed to replay run ID %v", runID)
+	}
+	return pipeline.NewReplay(run, replayed, currentSpec), nil
+}
+
 func (app *ChainlinkApplication) ResumeJobV2(
 	ctx context.Context,
 	taskID uuid.UUID,
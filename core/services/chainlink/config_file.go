@@ -0,0 +1,121 @@
+package chainlink
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/config/configfile"
+	"github.com/smartcontractkit/chainlink/core/config/envvar"
+)
+
+// ReloadConfigFile re-reads the config file and applies what changed since it
+// was last loaded. Log settings and EVM chains are updated live; all other
+// changes are returned, and logged, as requiring a restart.
+func (app *ChainlinkApplication) ReloadConfigFile() ([]configfile.Change, error) {
+	path := app.Config.ConfigFile()
+	if path == "" {
+		return nil, errors.New("no config file to reload, CONFIG_FILE is not set")
+	}
+	next, err := configfile.Load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	app.configFileMu.Lock()
+	defer app.configFileMu.Unlock()
+
+	if app.configFile == nil {
+		app.configFile = app.Config.LoadedConfigFile()
+	}
+	changes := configfile.Diff(app.configFile, next)
+	if len(changes) == 0 {
+		app.logger.Infow("Config file reloaded, nothing changed", "path", path)
+		return nil, nil
+	}
+
+	if app.Config.EVMEnabled() {
+		if err = evm.ClobberDBFromConfigFile(app.sqlxDB, next, app.logger); err != nil {
+			return nil, err
+		}
+	}
+	app.configFile = next
+
+	var merr error
+	configured := make(map[string]bool)
+	for _, change := range changes {
+		if change.RequiresRestart {
+			app.logger.Warnw("Config file change will only take effect once the node is restarted", "setting", change.Path)
+			continue
+		}
+		if err = app.applyConfigFileChange(change.Path, next, configured); err != nil {
+			merr = multierr.Append(merr, errors.Wrapf(err, "failed to apply %s", change.Path))
+		}
+	}
+	app.logger.Infow("Config file reloaded", "path", path, "changes", changes)
+	return changes, merr
+}
+
+func (app *ChainlinkApplication) applyConfigFileChange(path string, next *configfile.File, configured map[string]bool) error {
+	if strings.HasPrefix(path, "Global.") {
+		key := strings.TrimPrefix(path, "Global.")
+		if _, set := os.LookupEnv(key); set {
+			app.logger.Warnw("Config file setting is overridden by the environment, ignoring it", "setting", path)
+			return nil
+		}
+		str, ok := next.GlobalString(key)
+
+		switch key {
+		case envvar.Name("LogLevel"):
+			lvl := zapcore.InfoLevel
+			if ok {
+				if err := lvl.Set(str); err != nil {
+					return err
+				}
+			}
+			return app.SetLogLevel(lvl)
+		case envvar.Name("LogSQL"):
+			logSQL := false
+			if ok {
+				var err error
+				if logSQL, err = strconv.ParseBool(str); err != nil {
+					return err
+				}
+			}
+			app.Config.SetLogSQL(logSQL)
+			return nil
+		}
+		return errors.Errorf("%s cannot be changed at runtime", key)
+	}
+
+	// The remaining live changes are to EVM chains, which are reconfigured
+	// once no matter how many of their fields changed
+	parts := strings.Split(path, ".")
+	if len(parts) < 2 || parts[0] != "EVM" {
+		return errors.Errorf("unknown setting %s", path)
+	}
+	if !app.Config.EVMEnabled() || configured[parts[1]] {
+		return nil
+	}
+	configured[parts[1]] = true
+
+	chain := next.EVMChain(parts[1])
+	if chain == nil {
+		return errors.Errorf("chain %s is not declared", parts[1])
+	}
+	id, err := chain.ID()
+	if err != nil {
+		return err
+	}
+	cfg, err := chain.ChainCfg()
+	if err != nil {
+		return err
+	}
+	_, err = app.Chains.EVM.Configure(id.ToInt(), chain.IsEnabled(), cfg)
+	return err
+}
@@ -2,7 +2,12 @@ package cron
 
 import (
 	"fmt"
+	"math/rand"
+	"sync"
+	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/robfig/cron/v3"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -11,24 +16,46 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+// Outcomes of a cron fire
+const (
+	FireStarted = "started"
+	FireSkipped = "skipped"
+	FireQueued  = "queued"
+	FireLate    = "late"
+)
+
+var promCronFires = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "cron_job_fires",
+	Help: "The number of times cron jobs fired, by outcome",
+}, []string{"job_id", "outcome"})
+
 // Cron runs a cron jobSpec from a CronSpec
 type Cron struct {
 	cronRunner     *cron.Cron
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	jobORM         job.ORM
 	chStop         chan struct{}
+
+	mu sync.Mutex
+	// inProgress is the number of runs in progress
+	inProgress int
+	// queued is the time of the fire waiting for the run in progress to
+	// complete, if any
+	queued *time.Time
 }
 
 // NewCronFromJobSpec instantiates a job that executes on a predefined schedule.
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	jobORM job.ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
 		"jobID", jobSpec.ID,
-		"schedule", jobSpec.CronSpec.CronSchedule,
+		"schedule", jobSpec.CronSpec.Schedule(),
 	)
 
 	return &Cron{
@@ -36,6 +63,7 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		jobORM:         jobORM,
 		chStop:         make(chan struct{}),
 	}, nil
 }
@@ -44,9 +72,9 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start() error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
+	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.Schedule(), cr.fire)
 	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
+		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.Schedule(), "jobID", cr.jobSpec.ID)
 		return err
 	}
 	cr.cronRunner.Start()
@@ -57,10 +85,89 @@ func (cr *Cron) Start() error {
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
-	cr.cronRunner.Stop()
+	stopped := cr.cronRunner.Stop()
+	close(cr.chStop)
+	// Wait for the fires in progress, which are cancelled by chStop
+	<-stopped.Done()
 	return nil
 }
 
+// fire is called on every tick of the schedule. After the jitter, it starts a
+// run unless the overlap policy says otherwise.
+func (cr *Cron) fire() {
+	firedAt := time.Now()
+	if jitter := cr.jobSpec.CronSpec.Jitter.Duration(); jitter > 0 {
+		select {
+		case <-time.After(time.Duration(rand.Int63n(int64(jitter)))):
+		case <-cr.chStop:
+			return
+		}
+	}
+
+	cr.mu.Lock()
+	if cr.inProgress > 0 {
+		switch cr.jobSpec.CronSpec.OverlapPolicy {
+		case job.CronOverlapSkip:
+			cr.mu.Unlock()
+			cr.recordSkipped(firedAt)
+			return
+		case job.CronOverlapQueueOne:
+			if cr.queued != nil {
+				cr.mu.Unlock()
+				cr.recordSkipped(firedAt)
+				return
+			}
+			cr.queued = &firedAt
+			cr.mu.Unlock()
+			promCronFires.WithLabelValues(fmt.Sprint(cr.jobSpec.ID), FireQueued).Inc()
+			cr.logger.Debugw("Queued cron fire until the run in progress completes", "firedAt", firedAt)
+			return
+		}
+	}
+	cr.inProgress++
+	cr.mu.Unlock()
+
+	promCronFires.WithLabelValues(fmt.Sprint(cr.jobSpec.ID), FireStarted).Inc()
+	cr.runPipeline()
+	for {
+		cr.mu.Lock()
+		queued := cr.queued
+		cr.queued = nil
+		if queued == nil {
+			cr.inProgress--
+			cr.mu.Unlock()
+			return
+		}
+		cr.mu.Unlock()
+
+		select {
+		case <-cr.chStop:
+			cr.mu.Lock()
+			cr.inProgress--
+			cr.mu.Unlock()
+			return
+		default:
+		}
+		cr.recordLate(*queued)
+		cr.runPipeline()
+	}
+}
+
+func (cr *Cron) recordSkipped(firedAt time.Time) {
+	cr.logger.Warnw("Skipped cron fire because a run is still in progress",
+		"firedAt", firedAt, "overlapPolicy", cr.jobSpec.CronSpec.OverlapPolicy)
+	promCronFires.WithLabelValues(fmt.Sprint(cr.jobSpec.ID), FireSkipped).Inc()
+	cr.jobORM.TryRecordError(cr.jobSpec.ID, "Skipped cron fire because a run was still in progress")
+}
+
+func (cr *Cron) recordLate(firedAt time.Time) {
+	delay := time.Since(firedAt)
+	cr.logger.Warnw("Starting queued cron fire late, after the previous run completed",
+		"firedAt", firedAt, "delay", delay)
+	promCronFires.WithLabelValues(fmt.Sprint(cr.jobSpec.ID), FireLate).Inc()
+	cr.jobORM.TryRecordError(cr.jobSpec.ID, "Started queued cron fire late because a run was still in progress")
+}
+
 func (cr *Cron) runPipeline() {
 	ctx, cancel := utils.ContextFromChan(cr.chStop)
 	defer cancel()
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 
@@ -38,7 +39,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.NewV4(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, jobORM, lggr)
 
 	err := jobORM.CreateJob(jb)
 	require.NoError(t, err)
@@ -66,7 +67,7 @@ func TestCronV2Schedule(t *testing.T) {
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Return(false, nil).Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, new(jobmocks.ORM), logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start()
 	require.NoError(t, err)
@@ -74,3 +75,85 @@ func TestCronV2Schedule(t *testing.T) {
 
 	cltest.EventuallyExpectationsMet(t, runner, 10*time.Second, 1*time.Second)
 }
+
+func TestCronV2OverlapPolicy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		skipped = "Skipped cron fire because a run was still in progress"
+		late    = "Started queued cron fire late because a run was still in progress"
+	)
+
+	startCron := func(t *testing.T, policy job.CronOverlapPolicy, runner *pipelinemocks.Runner, jobORM *jobmocks.ORM) {
+		spec := job.Job{
+			ID:            1,
+			Type:          job.Cron,
+			SchemaVersion: 1,
+			CronSpec:      &job.CronSpec{CronSchedule: "@every 1s", OverlapPolicy: policy},
+			PipelineSpec:  &pipeline.Spec{},
+		}
+		service, err := cron.NewCronFromJobSpec(spec, runner, jobORM, logger.TestLogger(t))
+		require.NoError(t, err)
+		require.NoError(t, service.Start())
+		t.Cleanup(func() { require.NoError(t, service.Close()) })
+	}
+	// blockingRun makes the first run block until chRelease is closed, so that
+	// the following fires overlap it
+	blockingRun := func(runner *pipelinemocks.Runner, chRelease chan struct{}) {
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { <-chRelease }).
+			Return(false, nil).Once()
+	}
+	awaitSignal := func(t *testing.T, ch chan struct{}, msg string) {
+		select {
+		case <-ch:
+		case <-time.After(10 * time.Second):
+			t.Fatal(msg)
+		}
+	}
+
+	t.Run("skip", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		jobORM := new(jobmocks.ORM)
+		chRelease := make(chan struct{})
+		chSkipped := make(chan struct{}, 100)
+		blockingRun(runner, chRelease)
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).Maybe()
+		jobORM.On("TryRecordError", int32(1), skipped).
+			Run(func(mock.Arguments) { chSkipped <- struct{}{} })
+
+		startCron(t, job.CronOverlapSkip, runner, jobORM)
+
+		awaitSignal(t, chSkipped, "timed out waiting for a skipped fire")
+		runner.AssertNumberOfCalls(t, "Run", 1)
+		close(chRelease)
+		jobORM.AssertNotCalled(t, "TryRecordError", int32(1), late)
+	})
+
+	t.Run("queueOne", func(t *testing.T) {
+		runner := new(pipelinemocks.Runner)
+		jobORM := new(jobmocks.ORM)
+		chRelease := make(chan struct{})
+		chSkipped := make(chan struct{}, 100)
+		chLate := make(chan struct{})
+		blockingRun(runner, chRelease)
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Run(func(mock.Arguments) { close(chLate) }).
+			Return(false, nil).Once()
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).Maybe()
+		jobORM.On("TryRecordError", int32(1), skipped).
+			Run(func(mock.Arguments) { chSkipped <- struct{}{} })
+		jobORM.On("TryRecordError", int32(1), late)
+
+		startCron(t, job.CronOverlapQueueOne, runner, jobORM)
+
+		// The second fire is queued and the third one is skipped
+		awaitSignal(t, chSkipped, "timed out waiting for a skipped fire")
+		runner.AssertNumberOfCalls(t, "Run", 1)
+		close(chRelease)
+		awaitSignal(t, chLate, "timed out waiting for the queued fire to run")
+		jobORM.AssertCalled(t, "TryRecordError", int32(1), late)
+	})
+}
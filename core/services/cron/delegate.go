@@ -10,14 +10,16 @@ import (
 
 type Delegate struct {
 	pipelineRunner pipeline.Runner
+	jobORM         job.ORM
 	lggr           logger.Logger
 }
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(pipelineRunner pipeline.Runner, lggr logger.Logger) *Delegate {
+func NewDelegate(pipelineRunner pipeline.Runner, jobORM job.ORM, lggr logger.Logger) *Delegate {
 	return &Delegate{
 		pipelineRunner: pipelineRunner,
+		jobORM:         jobORM,
 		lggr:           lggr,
 	}
 }
@@ -33,13 +35,14 @@ func (Delegate) BeforeJobDeleted(spec job.Job) {}
 func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err error) {
 	// TODO: we need to fill these out manually, find a better fix
 	spec.PipelineSpec.JobName = spec.Name.ValueOrZero()
+	spec.PipelineSpec.ExternalJobID = spec.ExternalJobID
 	spec.PipelineSpec.JobID = spec.ID
 
 	if spec.CronSpec == nil {
 		return nil, errors.Errorf("services.Delegate expects a *jobSpec.CronSpec to be present, got %v", spec)
 	}
 
-	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.lggr)
+	cron, err := NewCronFromJobSpec(spec, d.pipelineRunner, d.jobORM, d.lggr)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package cron
 
 import (
+	"strings"
+	"time"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -34,8 +37,27 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
-		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
+	if spec.Timezone != "" {
+		if strings.HasPrefix(spec.CronSchedule, "CRON_TZ=") {
+			return jb, errors.New("cron schedule cannot specify CRON_TZ when timezone is set")
+		}
+		if _, err := time.LoadLocation(spec.Timezone); err != nil {
+			return jb, errors.Wrapf(err, "invalid timezone '%v'", spec.Timezone)
+		}
+	}
+	if err := utils.ValidateCronSchedule(spec.Schedule()); err != nil {
+		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.Schedule())
+	}
+	if spec.Jitter.Duration() < 0 {
+		return jb, errors.Errorf("jitter must not be negative, got %v", spec.Jitter.Duration())
+	}
+	switch spec.OverlapPolicy {
+	case "":
+		spec.OverlapPolicy = job.CronOverlapAllow
+	case job.CronOverlapAllow, job.CronOverlapSkip, job.CronOverlapQueueOne:
+	default:
+		return jb, errors.Errorf("unknown overlapPolicy '%v', must be one of %v, %v or %v",
+			spec.OverlapPolicy, job.CronOverlapAllow, job.CronOverlapSkip, job.CronOverlapQueueOne)
 	}
 
 	return jb, nil
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -18,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -27,6 +30,7 @@ type (
 		logger         logger.Logger
 		pipelineRunner pipeline.Runner
 		pipelineORM    pipeline.ORM
+		jobORM         job.ORM
 		chHeads        chan *evmtypes.Head
 		chainSet       evm.ChainSet
 	}
@@ -37,18 +41,24 @@ type (
 	}
 )
 
-var _ job.Delegate = (*Delegate)(nil)
+var (
+	_ job.Delegate = (*Delegate)(nil)
+
+	tracer = tracing.Tracer("directrequest")
+)
 
 func NewDelegate(
 	logger logger.Logger,
 	pipelineRunner pipeline.Runner,
 	pipelineORM pipeline.ORM,
+	jobORM job.ORM,
 	chainSet evm.ChainSet,
 ) *Delegate {
 	return &Delegate{
 		logger.Named("DirectRequest"),
 		pipelineRunner,
 		pipelineORM,
+		jobORM,
 		make(chan *evmtypes.Head, 1),
 		chainSet,
 	}
@@ -72,6 +82,10 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 	}
 	concreteSpec := job.LoadEnvConfigVarsDR(chain.Config(), *jb.DirectRequestSpec)
 
+	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+	jb.PipelineSpec.ExternalJobID = jb.ExternalJobID
+	jb.PipelineSpec.JobID = jb.ID
+
 	oracle, err := operator_wrapper.NewOperator(concreteSpec.ContractAddress.Address(), chain.Client())
 	if err != nil {
 		return nil, errors.Wrapf(err, "DirectRequest: failed to create an operator wrapper for address: %v", concreteSpec.ContractAddress.Address().String())
@@ -92,11 +106,13 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 		oracle:                   oracle,
 		pipelineRunner:           d.pipelineRunner,
 		pipelineORM:              d.pipelineORM,
+		jobORM:                   d.jobORM,
 		job:                      jb,
 		mbOracleRequests:         utils.NewHighCapacityMailbox(),
 		mbOracleCancelRequests:   utils.NewHighCapacityMailbox(),
 		minIncomingConfirmations: concreteSpec.MinIncomingConfirmations.Uint32,
 		requesters:               concreteSpec.Requesters,
+		requesterRules:           newRequesterRules(concreteSpec.RequesterRules),
 		minContractPayment:       concreteSpec.MinContractPayment,
 		chStop:                   make(chan struct{}),
 	}
@@ -118,6 +134,7 @@ type listener struct {
 	oracle                   operator_wrapper.OperatorInterface
 	pipelineRunner           pipeline.Runner
 	pipelineORM              pipeline.ORM
+	jobORM                   job.ORM
 	job                      job.Job
 	runs                     sync.Map
 	shutdownWaitGroup        sync.WaitGroup
@@ -125,6 +142,7 @@ type listener struct {
 	mbOracleCancelRequests   *utils.Mailbox
 	minIncomingConfirmations uint32
 	requesters               models.AddressCollection
+	requesterRules           *requesterRules
 	minContractPayment       *assets.Link
 	chStop                   chan struct{}
 	utils.StartStopOnce
@@ -294,16 +312,36 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 			"requester", request.Requester,
 			"allowedRequesters", l.requesters.ToStrings(),
 		)
-		l.markLogConsumed(lb)
+		l.rejectRequest(lb, RejectReasonNotAllowed, "Rejected request from a requester not in requesters")
+		return
+	}
+
+	rule, hasRule := l.requesterRules.rule(request.Requester)
+	if hasRule && rule.Blocked {
+		l.logger.Infow("Rejected run for blocked requester", "requester", request.Requester)
+		l.rejectRequest(lb, RejectReasonBlocked, fmt.Sprintf("Rejected request from blocked requester %s", request.Requester))
+		return
+	}
+
+	spec := *l.job.DirectRequestSpec
+	if err := checkRequestShape(spec, request); err != nil {
+		l.logger.Warnw("Rejected run for request not matching the response shape",
+			"responseShape", spec.ResponseShape,
+			"err", err,
+		)
+		l.rejectRequest(lb, RejectReasonResponseShape, fmt.Sprintf("Rejected request: %v", err))
 		return
 	}
 
 	var minContractPayment *assets.Link
-	if l.minContractPayment != nil {
+	if hasRule && rule.MinContractPayment != nil {
+		minContractPayment = rule.MinContractPayment
+	} else if l.minContractPayment != nil {
 		minContractPayment = l.minContractPayment
 	} else {
 		minContractPayment = l.config.MinimumContractPayment()
 	}
+	minContractPayment = minResponsePayment(spec, minContractPayment)
 	if minContractPayment != nil && request.Payment != nil {
 		requestPayment := assets.Link(*request.Payment)
 		if minContractPayment.Cmp(&requestPayment) > 0 {
@@ -311,11 +349,27 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 				"minContractPayment", minContractPayment.String(),
 				"requestPayment", requestPayment.String(),
 			)
-			l.markLogConsumed(lb)
+			description := "Rejected request with insufficient payment"
+			if hasRule {
+				description = fmt.Sprintf("Rejected request from %s paying less than its minimum of %s juels", request.Requester, minContractPayment)
+			}
+			l.rejectRequest(lb, RejectReasonInsufficientPayment, description)
 			return
 		}
 	}
 
+	// Rate limits are checked last, so that only requests which are run count
+	// towards them.
+	if !l.requesterRules.allowRequest(request.Requester) {
+		l.logger.Warnw("Rejected run for rate limited requester",
+			"requester", request.Requester,
+			"maxRequests", rule.MaxRequests,
+			"window", rule.Window.Duration(),
+		)
+		l.rejectRequest(lb, RejectReasonRateLimited, fmt.Sprintf("Rejected request from %s exceeding %d requests per %s", request.Requester, rule.MaxRequests, rule.Window.Duration()))
+		return
+	}
+
 	meta := make(map[string]interface{})
 	meta["oracleRequest"] = oracleRequestToMap(request)
 
@@ -327,21 +381,33 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 	ctx, cancel := utils.CombinedContext(runCloserChannel, context.Background())
 	defer cancel()
 
+	ctx, span := tracer.Start(log.TraceContext(ctx, lb), "DirectRequest.handleOracleRequest", trace.WithAttributes(
+		attribute.Int64("job.id", int64(l.job.ID)),
+		attribute.String("oracle_request.id", formatRequestId(request.RequestId)),
+		attribute.String("oracle_request.requester", request.Requester.Hex()),
+	))
+	defer span.End()
+
+	jobRun := map[string]interface{}{
+		"meta":           meta,
+		"logBlockHash":   request.Raw.BlockHash,
+		"logBlockNumber": request.Raw.BlockNumber,
+		"logTxHash":      request.Raw.TxHash,
+		"logAddress":     request.Raw.Address,
+		"logTopics":      request.Raw.Topics,
+		"logData":        request.Raw.Data,
+	}
+	if spec.ResponseShape != job.DirectRequestResponseUndeclared {
+		jobRun["fulfillmentABI"] = FulfillmentABI(spec.ResponseShape)
+		jobRun["fulfillmentGasLimit"] = FulfillmentGasLimit(spec)
+	}
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
 		"jobSpec": map[string]interface{}{
 			"databaseID":    l.job.ID,
 			"externalJobID": l.job.ExternalJobID,
 			"name":          l.job.Name.ValueOrZero(),
 		},
-		"jobRun": map[string]interface{}{
-			"meta":           meta,
-			"logBlockHash":   request.Raw.BlockHash,
-			"logBlockNumber": request.Raw.BlockNumber,
-			"logTxHash":      request.Raw.TxHash,
-			"logAddress":     request.Raw.Address,
-			"logTopics":      request.Raw.Topics,
-			"logData":        request.Raw.Data,
-		},
+		"jobRun": jobRun,
 	})
 	run := pipeline.NewRun(*l.job.PipelineSpec, vars)
 	_, err := l.pipelineRunner.Run(ctx, &run, l.logger, true, func(tx pg.Queryer) error {
@@ -351,6 +417,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 	if ctx.Err() != nil {
 		return
 	} else if err != nil {
+		tracing.RecordError(span, err)
 		l.logger.Errorw("Failed executing run", "err", err)
 	}
 }
@@ -367,6 +434,15 @@ func (l *listener) allowRequester(requester common.Address) bool {
 	return false
 }
 
+// rejectRequest consumes the log of a rejected request, and records the
+// rejection against the job. Descriptions are deduplicated in the job's errors,
+// so they should only name requesters which have rules.
+func (l *listener) rejectRequest(lb log.Broadcast, reason string, description string) {
+	promRejectedRequests.WithLabelValues(fmt.Sprint(l.job.ID), reason).Inc()
+	l.jobORM.TryRecordError(l.job.ID, description)
+	l.markLogConsumed(lb)
+}
+
 // Cancels runs that haven't been started yet, with the given request ID
 func (l *listener) handleCancelOracleRequest(request *operator_wrapper.OperatorCancelOracleRequest, lb log.Broadcast) {
 	runCloserChannelIf, loaded := l.runs.LoadAndDelete(formatRequestId(request.RequestId))
@@ -0,0 +1,115 @@
+package directrequest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// Reasons for which an oracle request is rejected without running the pipeline
+const (
+	RejectReasonNotAllowed          = "not_allowed"
+	RejectReasonBlocked             = "blocked"
+	RejectReasonRateLimited         = "rate_limited"
+	RejectReasonInsufficientPayment = "insufficient_payment"
+	RejectReasonResponseShape       = "response_shape"
+)
+
+var promRejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "direct_request_rejected_requests",
+	Help: "The number of oracle requests rejected by direct request jobs, by reason",
+}, []string{"job_id", "reason"})
+
+// requesterRules enforces the per-requester rules of a job. Rate limits are
+// kept in memory, so they restart with the node.
+type requesterRules struct {
+	rules map[common.Address]job.DirectRequestRequesterRule
+	now   func() time.Time
+
+	mu sync.Mutex
+	// accepted holds the times at which the requests of rate limited
+	// requesters were accepted within their current window, oldest first.
+	accepted map[common.Address][]time.Time
+}
+
+func newRequesterRules(rules job.DirectRequestRequesterRules) *requesterRules {
+	r := &requesterRules{
+		rules:    make(map[common.Address]job.DirectRequestRequesterRule, len(rules)),
+		now:      time.Now,
+		accepted: make(map[common.Address][]time.Time),
+	}
+	for _, rule := range rules {
+		r.rules[rule.Address] = rule
+	}
+	return r
+}
+
+// rule returns the rule of requester, if any.
+func (r *requesterRules) rule(requester common.Address) (job.DirectRequestRequesterRule, bool) {
+	rule, ok := r.rules[requester]
+	return rule, ok
+}
+
+// allowRequest records a request of requester, returning false, without
+// recording it, if it exceeds the rate limit of the requester.
+func (r *requesterRules) allowRequest(requester common.Address) bool {
+	rule, ok := r.rules[requester]
+	if !ok || rule.MaxRequests == 0 {
+		return true
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := r.now()
+	windowStart := now.Add(-rule.Window.Duration())
+	accepted := r.accepted[requester]
+	for len(accepted) > 0 && !accepted[0].After(windowStart) {
+		accepted = accepted[1:]
+	}
+	if len(accepted) >= int(rule.MaxRequests) {
+		r.accepted[requester] = accepted
+		return false
+	}
+	r.accepted[requester] = append(accepted, now)
+	return true
+}
+
+// validateRequesterRules checks that the requester rules of spec are
+// consistent with each other and with its requesters.
+func validateRequesterRules(spec job.DirectRequestSpec) error {
+	allowed := make(map[common.Address]struct{}, len(spec.Requesters))
+	for _, addr := range spec.Requesters {
+		allowed[addr] = struct{}{}
+	}
+	seen := make(map[common.Address]struct{}, len(spec.RequesterRules))
+	for _, rule := range spec.RequesterRules {
+		if rule.Address == (common.Address{}) {
+			return errors.New("requester rules must have an address")
+		}
+		if _, exists := seen[rule.Address]; exists {
+			return errors.Errorf("requester %s has more than one rule", rule.Address)
+		}
+		seen[rule.Address] = struct{}{}
+		if rule.Blocked {
+			if _, exists := allowed[rule.Address]; exists {
+				return errors.Errorf("requester %s cannot be both in requesters and blocked", rule.Address)
+			}
+			continue
+		}
+		if len(allowed) > 0 {
+			if _, exists := allowed[rule.Address]; !exists {
+				return errors.Errorf("requester %s has a rule but is not in requesters", rule.Address)
+			}
+		}
+		if (rule.MaxRequests == 0) != rule.Window.IsZero() {
+			return errors.Errorf("the rule of requester %s must set both maxRequests and window, or neither", rule.Address)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package directrequest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestRequesterRules_AllowRequest(t *testing.T) {
+	limited := testutils.NewAddress()
+	unlimited := testutils.NewAddress()
+	rules := newRequesterRules(job.DirectRequestRequesterRules{
+		{Address: limited, MaxRequests: 2, Window: models.Interval(time.Minute)},
+		{Address: unlimited},
+	})
+	now := time.Unix(1000, 0)
+	rules.now = func() time.Time { return now }
+
+	assert.True(t, rules.allowRequest(limited))
+	now = now.Add(30 * time.Second)
+	assert.True(t, rules.allowRequest(limited))
+	assert.False(t, rules.allowRequest(limited))
+
+	// The first request leaves the window
+	now = now.Add(31 * time.Second)
+	assert.True(t, rules.allowRequest(limited))
+	assert.False(t, rules.allowRequest(limited))
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, rules.allowRequest(unlimited))
+		assert.True(t, rules.allowRequest(testutils.NewAddress()))
+	}
+}
+
+func TestValidateRequesterRules(t *testing.T) {
+	a, b := testutils.NewAddress(), testutils.NewAddress()
+
+	tests := []struct {
+		name    string
+		spec    job.DirectRequestSpec
+		wantErr string
+	}{
+		{
+			name: "valid",
+			spec: job.DirectRequestSpec{RequesterRules: job.DirectRequestRequesterRules{
+				{Address: a, MaxRequests: 10, Window: models.Interval(time.Hour)},
+				{Address: b, Blocked: true},
+			}},
+		},
+		{
+			name:    "missing address",
+			spec:    job.DirectRequestSpec{RequesterRules: job.DirectRequestRequesterRules{{Blocked: true}}},
+			wantErr: "requester rules must have an address",
+		},
+		{
+			name: "duplicate",
+			spec: job.DirectRequestSpec{RequesterRules: job.DirectRequestRequesterRules{
+				{Address: a, Blocked: true},
+				{Address: a, MaxRequests: 1, Window: models.Interval(time.Hour)},
+			}},
+			wantErr: "requester " + a.String() + " has more than one rule",
+		},
+		{
+			name: "blocked and allowed",
+			spec: job.DirectRequestSpec{
+				Requesters:     []common.Address{a},
+				RequesterRules: job.DirectRequestRequesterRules{{Address: a, Blocked: true}},
+			},
+			wantErr: "requester " + a.String() + " cannot be both in requesters and blocked",
+		},
+		{
+			name: "rule for a requester which is not allowed",
+			spec: job.DirectRequestSpec{
+				Requesters:     []common.Address{a},
+				RequesterRules: job.DirectRequestRequesterRules{{Address: b, MaxRequests: 1, Window: models.Interval(time.Hour)}},
+			},
+			wantErr: "requester " + b.String() + " has a rule but is not in requesters",
+		},
+		{
+			name:    "rate limit without window",
+			spec:    job.DirectRequestSpec{RequesterRules: job.DirectRequestRequesterRules{{Address: a, MaxRequests: 1}}},
+			wantErr: "the rule of requester " + a.String() + " must set both maxRequests and window, or neither",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateRequesterRules(tc.spec)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
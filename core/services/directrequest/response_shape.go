@@ -0,0 +1,212 @@
+package directrequest
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+const (
+	// MinimumConsumerGasLimit is the gas the Operator contract requires to be
+	// left for the consumer callback of a fulfillment.
+	MinimumConsumerGasLimit = 400_000
+
+	// fulfillmentOverheadGas is the gas a fulfillment uses besides the
+	// consumer callback and the response data.
+	fulfillmentOverheadGas = 100_000
+
+	// gasPerResponseWord is the calldata gas of each 32 byte word of a response.
+	gasPerResponseWord = 16 * 32
+)
+
+const (
+	singleWordFulfillmentABI = "fulfillOracleRequest(bytes32 requestId, uint256 payment, address callbackAddress, bytes4 callbackFunctionId, uint256 expiration, bytes32 data)"
+	multiWordFulfillmentABI  = "fulfillOracleRequest2(bytes32 requestId, uint256 payment, address callbackAddress, bytes4 callbackFunctionId, uint256 expiration, bytes data)"
+)
+
+// FulfillmentABI returns the ethabiencode ABI of the Operator function which
+// fulfills the requests of jobs with the given response shape, or an empty
+// string if the shape is undeclared.
+func FulfillmentABI(shape job.DirectRequestResponseShape) string {
+	switch shape {
+	case job.DirectRequestResponseSingleWord:
+		return singleWordFulfillmentABI
+	case job.DirectRequestResponseMultiWord, job.DirectRequestResponseBytes:
+		return multiWordFulfillmentABI
+	default:
+		return ""
+	}
+}
+
+// FulfillmentGasLimit returns the gas limit of the fulfillments of spec, which
+// leaves its callbackGasLimit to the consumer callback.
+func FulfillmentGasLimit(spec job.DirectRequestSpec) uint64 {
+	callbackGasLimit := uint64(spec.CallbackGasLimit)
+	if callbackGasLimit == 0 {
+		callbackGasLimit = MinimumConsumerGasLimit
+	}
+	gasLimit := fulfillmentOverheadGas + callbackGasLimit
+	if spec.ResponseShape == job.DirectRequestResponseMultiWord {
+		// The request ID is the first word of multi-word responses
+		gasLimit += uint64(spec.ResponseWords+1) * gasPerResponseWord
+	}
+	return gasLimit
+}
+
+// minResponsePayment returns the minimum payment of requests to spec, given
+// the minimum payment of any request, or nil if there is none.
+func minResponsePayment(spec job.DirectRequestSpec, minContractPayment *assets.Link) *assets.Link {
+	if spec.ResponseShape != job.DirectRequestResponseMultiWord || spec.MinContractPaymentPerWord == nil {
+		return minContractPayment
+	}
+	total := new(big.Int).Mul(spec.MinContractPaymentPerWord.ToInt(), big.NewInt(int64(spec.ResponseWords)))
+	if minContractPayment != nil {
+		total.Add(total, minContractPayment.ToInt())
+	}
+	return (*assets.Link)(total)
+}
+
+// checkRequestShape returns an error if request cannot be fulfilled with the
+// response shape declared by spec.
+func checkRequestShape(spec job.DirectRequestSpec, request *operator_wrapper.OperatorOracleRequest) error {
+	// The Operator contract rejects fulfillments with a lower data version than
+	// the request, and fulfillOracleRequest has version 1.
+	if spec.ResponseShape == job.DirectRequestResponseSingleWord && request.DataVersion != nil && request.DataVersion.Cmp(big.NewInt(1)) > 0 {
+		return errors.Errorf("request data version %v cannot be fulfilled with a %s response", request.DataVersion, spec.ResponseShape)
+	}
+	return nil
+}
+
+// validateResponseShape checks that the response shape of the spec of jb is
+// consistent, and that the fulfillment tasks of its pipeline match it.
+func validateResponseShape(jb job.Job) error {
+	spec := jb.DirectRequestSpec
+	switch spec.ResponseShape {
+	case job.DirectRequestResponseUndeclared:
+		if spec.ResponseWords != 0 || spec.CallbackGasLimit != 0 || spec.MinContractPaymentPerWord != nil {
+			return errors.New("responseWords, callbackGasLimit and minContractPaymentPerWordLinkJuels require a responseShape")
+		}
+		return nil
+	case job.DirectRequestResponseMultiWord:
+		if spec.ResponseWords == 0 {
+			return errors.Errorf("responseWords must be positive for a %s responseShape", spec.ResponseShape)
+		}
+	case job.DirectRequestResponseSingleWord, job.DirectRequestResponseBytes:
+		if spec.ResponseWords != 0 {
+			return errors.Errorf("responseWords is only supported for a %s responseShape", job.DirectRequestResponseMultiWord)
+		}
+		if spec.MinContractPaymentPerWord != nil {
+			return errors.Errorf("minContractPaymentPerWordLinkJuels is only supported for a %s responseShape", job.DirectRequestResponseMultiWord)
+		}
+	default:
+		return errors.Errorf("invalid responseShape %q, must be one of %s, %s or %s", spec.ResponseShape,
+			job.DirectRequestResponseSingleWord, job.DirectRequestResponseMultiWord, job.DirectRequestResponseBytes)
+	}
+	if spec.CallbackGasLimit != 0 && spec.CallbackGasLimit < MinimumConsumerGasLimit {
+		return errors.Errorf("callbackGasLimit must be at least %d, the minimum the Operator contract leaves to consumers", MinimumConsumerGasLimit)
+	}
+
+	for _, task := range jb.Pipeline.Tasks {
+		var err error
+		switch task := task.(type) {
+		case *pipeline.ETHABIEncodeTask:
+			err = validateEncodeTask(*spec, task)
+		case *pipeline.ETHTxTask:
+			err = validateTxTask(*spec, task)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "task %s", task.DotID())
+		}
+	}
+	return nil
+}
+
+func validateEncodeTask(spec job.DirectRequestSpec, task *pipeline.ETHABIEncodeTask) error {
+	if isVarExpr(task.ABI) {
+		return nil
+	}
+	name, args, err := pipeline.ParseETHABIString(task.ABI)
+	if err != nil {
+		// Invalid ABIs are reported by the task itself
+		return nil
+	}
+	switch {
+	case name == "fulfillOracleRequest" || name == "fulfillOracleRequest2":
+		expectedName, expectedArgs, err := pipeline.ParseETHABIString(FulfillmentABI(spec.ResponseShape))
+		if err != nil {
+			return err
+		}
+		if name != expectedName || !sameTypes(args, expectedArgs) {
+			return errors.Errorf("a %s response is fulfilled with %q", spec.ResponseShape, FulfillmentABI(spec.ResponseShape))
+		}
+	case name == "" && len(args) > 0 && args[0].Type.T == abi.FixedBytesTy && args[0].Type.Size == 32:
+		// An anonymous tuple starting with the request ID encodes the response
+		// data of fulfillOracleRequest2.
+		switch spec.ResponseShape {
+		case job.DirectRequestResponseMultiWord:
+			if len(args) != int(spec.ResponseWords)+1 {
+				return errors.Errorf("expected the request ID and %d response words, got %d arguments", spec.ResponseWords, len(args))
+			}
+			for _, arg := range args[1:] {
+				if !isWord(arg.Type) {
+					return errors.Errorf("response word %s must be a 32 byte static type, got %s", arg.Name, arg.Type)
+				}
+			}
+		case job.DirectRequestResponseBytes:
+			if len(args) != 2 || args[1].Type.T != abi.BytesTy {
+				return errors.New("a bytes response must be encoded as the request ID followed by a single bytes argument")
+			}
+		}
+	}
+	return nil
+}
+
+func validateTxTask(spec job.DirectRequestSpec, task *pipeline.ETHTxTask) error {
+	gasLimitStr := strings.TrimSpace(task.GasLimit)
+	if gasLimitStr == "" || isVarExpr(gasLimitStr) {
+		return nil
+	}
+	gasLimit, err := strconv.ParseUint(gasLimitStr, 10, 64)
+	if err != nil {
+		return nil
+	}
+	if min := FulfillmentGasLimit(spec); gasLimit < min {
+		return errors.Errorf("gasLimit %d is below the %d the %s response needs to leave callbackGasLimit to the consumer", gasLimit, min, spec.ResponseShape)
+	}
+	return nil
+}
+
+func isVarExpr(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "$(") && strings.HasSuffix(s, ")")
+}
+
+// isWord returns whether values of typ are encoded as a single 32 byte word.
+func isWord(typ abi.Type) bool {
+	switch typ.T {
+	case abi.IntTy, abi.UintTy, abi.BoolTy, abi.AddressTy, abi.FixedBytesTy:
+		return true
+	default:
+		return false
+	}
+}
+
+func sameTypes(a, b abi.Arguments) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type.String() != b[i].Type.String() {
+			return false
+		}
+	}
+	return true
+}
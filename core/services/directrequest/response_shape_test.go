@@ -0,0 +1,66 @@
+package directrequest
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestFulfillmentABI(t *testing.T) {
+	assert.Equal(t, "", FulfillmentABI(job.DirectRequestResponseUndeclared))
+	for _, shape := range []job.DirectRequestResponseShape{
+		job.DirectRequestResponseSingleWord,
+		job.DirectRequestResponseMultiWord,
+		job.DirectRequestResponseBytes,
+	} {
+		_, args, err := pipeline.ParseETHABIString(FulfillmentABI(shape))
+		require.NoError(t, err, shape)
+		assert.Len(t, args, 6, shape)
+	}
+}
+
+func TestFulfillmentGasLimit(t *testing.T) {
+	assert.Equal(t, uint64(500_000), FulfillmentGasLimit(job.DirectRequestSpec{ResponseShape: job.DirectRequestResponseSingleWord}))
+	assert.Equal(t, uint64(700_000), FulfillmentGasLimit(job.DirectRequestSpec{ResponseShape: job.DirectRequestResponseBytes, CallbackGasLimit: 600_000}))
+	assert.Equal(t, uint64(500_000+4*512), FulfillmentGasLimit(job.DirectRequestSpec{ResponseShape: job.DirectRequestResponseMultiWord, ResponseWords: 3}))
+}
+
+func TestMinResponsePayment(t *testing.T) {
+	base := assets.NewLinkFromJuels(100)
+	perWord := assets.NewLinkFromJuels(10)
+
+	assert.Equal(t, base, minResponsePayment(job.DirectRequestSpec{}, base))
+	assert.Nil(t, minResponsePayment(job.DirectRequestSpec{ResponseShape: job.DirectRequestResponseBytes, MinContractPaymentPerWord: perWord}, nil))
+	assert.Equal(t, "130", minResponsePayment(job.DirectRequestSpec{
+		ResponseShape:             job.DirectRequestResponseMultiWord,
+		ResponseWords:             3,
+		MinContractPaymentPerWord: perWord,
+	}, base).String())
+	assert.Equal(t, "30", minResponsePayment(job.DirectRequestSpec{
+		ResponseShape:             job.DirectRequestResponseMultiWord,
+		ResponseWords:             3,
+		MinContractPaymentPerWord: perWord,
+	}, nil).String())
+}
+
+func TestCheckRequestShape(t *testing.T) {
+	v1 := &operator_wrapper.OperatorOracleRequest{DataVersion: big.NewInt(1)}
+	v2 := &operator_wrapper.OperatorOracleRequest{DataVersion: big.NewInt(2)}
+
+	singleWord := job.DirectRequestSpec{ResponseShape: job.DirectRequestResponseSingleWord}
+	assert.NoError(t, checkRequestShape(singleWord, v1))
+	assert.EqualError(t, checkRequestShape(singleWord, v2), "request data version 2 cannot be fulfilled with a singleWord response")
+
+	multiWord := job.DirectRequestSpec{ResponseShape: job.DirectRequestResponseMultiWord, ResponseWords: 1}
+	assert.NoError(t, checkRequestShape(multiWord, v1))
+	assert.NoError(t, checkRequestShape(multiWord, v2))
+
+	assert.NoError(t, checkRequestShape(job.DirectRequestSpec{}, v2))
+}
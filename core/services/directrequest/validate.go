@@ -12,10 +12,15 @@ import (
 )
 
 type DirectRequestToml struct {
-	ContractAddress    ethkey.EIP55Address      `toml:"contractAddress"`
-	Requesters         models.AddressCollection `toml:"requesters"`
-	MinContractPayment *assets.Link             `toml:"minContractPaymentLinkJuels"`
-	EVMChainID         *utils.Big               `toml:"evmChainID"`
+	ContractAddress           ethkey.EIP55Address             `toml:"contractAddress"`
+	Requesters                models.AddressCollection        `toml:"requesters"`
+	MinContractPayment        *assets.Link                    `toml:"minContractPaymentLinkJuels"`
+	MinContractPaymentPerWord *assets.Link                    `toml:"minContractPaymentPerWordLinkJuels"`
+	ResponseShape             job.DirectRequestResponseShape  `toml:"responseShape"`
+	ResponseWords             uint32                          `toml:"responseWords"`
+	CallbackGasLimit          uint32                          `toml:"callbackGasLimit"`
+	RequesterRules            job.DirectRequestRequesterRules `toml:"requesterRules"`
+	EVMChainID                *utils.Big                      `toml:"evmChainID"`
 }
 
 func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
@@ -34,14 +39,25 @@ func ValidatedDirectRequestSpec(tomlString string) (job.Job, error) {
 		return jb, err
 	}
 	jb.DirectRequestSpec = &job.DirectRequestSpec{
-		ContractAddress:    spec.ContractAddress,
-		Requesters:         spec.Requesters,
-		MinContractPayment: spec.MinContractPayment,
-		EVMChainID:         spec.EVMChainID,
+		ContractAddress:           spec.ContractAddress,
+		Requesters:                spec.Requesters,
+		MinContractPayment:        spec.MinContractPayment,
+		MinContractPaymentPerWord: spec.MinContractPaymentPerWord,
+		ResponseShape:             spec.ResponseShape,
+		ResponseWords:             spec.ResponseWords,
+		CallbackGasLimit:          spec.CallbackGasLimit,
+		RequesterRules:            spec.RequesterRules,
+		EVMChainID:                spec.EVMChainID,
 	}
 
 	if jb.Type != job.DirectRequest {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
+	if err = validateResponseShape(jb); err != nil {
+		return jb, err
+	}
+	if err = validateRequesterRules(*jb.DirectRequestSpec); err != nil {
+		return jb, err
+	}
 	return jb, nil
 }
@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
 )
 
 func TestValidatedDirectRequestSpec(t *testing.T) {
@@ -34,3 +36,154 @@ observationSource   = """
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.CreatedAt)
 	assert.Equal(t, time.Time{}, s.DirectRequestSpec.UpdatedAt)
 }
+
+func TestValidatedDirectRequestSpec_ResponseShape(t *testing.T) {
+	const header = `
+type                = "directrequest"
+schemaVersion       = 1
+contractAddress     = "0x613a38AC1659769640aaE063C651F48E0250454C"
+`
+	tests := []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, jb job.Job, err error)
+	}{
+		{
+			name: "multi-word response",
+			toml: header + `
+responseShape       = "multiWord"
+responseWords       = 2
+callbackGasLimit    = 500000
+minContractPaymentPerWordLinkJuels = "1000"
+observationSource   = """
+    encode_mwr [type=ethabiencode abi="(bytes32 requestId, uint256 usd, int256 eur)" data=<{"requestId": $(requestId), "usd": $(usd), "eur": $(eur)}>]
+    encode_tx  [type=ethabiencode abi="fulfillOracleRequest2(bytes32 requestId, uint256 payment, address callbackAddress, bytes4 callbackFunctionId, uint256 expiration, bytes data)" data=<{"data": $(encode_mwr)}>]
+    submit_tx  [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode_tx)" gasLimit="700000"]
+    encode_mwr -> encode_tx -> submit_tx
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, job.DirectRequestResponseMultiWord, jb.DirectRequestSpec.ResponseShape)
+				assert.Equal(t, uint32(2), jb.DirectRequestSpec.ResponseWords)
+				assert.Equal(t, uint32(500000), jb.DirectRequestSpec.CallbackGasLimit)
+				assert.Equal(t, "1000", jb.DirectRequestSpec.MinContractPaymentPerWord.String())
+			},
+		},
+		{
+			name: "generated fulfillment",
+			toml: header + `
+responseShape       = "singleWord"
+observationSource   = """
+    encode_tx  [type=ethabiencode abi="$(jobRun.fulfillmentABI)" data=<{"data": $(answer)}>]
+    submit_tx  [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode_tx)" gasLimit="$(jobRun.fulfillmentGasLimit)"]
+    encode_tx -> submit_tx
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "invalid shape",
+			toml: header + `
+responseShape       = "twoWords"
+observationSource   = "ds [type=memo value=1]"
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, `invalid responseShape "twoWords", must be one of singleWord, multiWord or bytes`)
+			},
+		},
+		{
+			name: "multi-word response without words",
+			toml: header + `
+responseShape       = "multiWord"
+observationSource   = "ds [type=memo value=1]"
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "responseWords must be positive for a multiWord responseShape")
+			},
+		},
+		{
+			name: "callback gas limit without shape",
+			toml: header + `
+callbackGasLimit    = 500000
+observationSource   = "ds [type=memo value=1]"
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "require a responseShape")
+			},
+		},
+		{
+			name: "callback gas limit below the Operator minimum",
+			toml: header + `
+responseShape       = "bytes"
+callbackGasLimit    = 100000
+observationSource   = "ds [type=memo value=1]"
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "callbackGasLimit must be at least 400000, the minimum the Operator contract leaves to consumers")
+			},
+		},
+		{
+			name: "fulfillment function not matching the shape",
+			toml: header + `
+responseShape       = "singleWord"
+observationSource   = """
+    encode_tx  [type=ethabiencode abi="fulfillOracleRequest2(bytes32 requestId, uint256 payment, address callbackAddress, bytes4 callbackFunctionId, uint256 expiration, bytes data)" data=<{"data": $(answer)}>]
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "task encode_tx: a singleWord response is fulfilled with")
+			},
+		},
+		{
+			name: "wrong number of response words",
+			toml: header + `
+responseShape       = "multiWord"
+responseWords       = 3
+observationSource   = """
+    encode_mwr [type=ethabiencode abi="(bytes32 requestId, uint256 usd, uint256 eur)" data=<{"requestId": $(requestId), "usd": $(usd), "eur": $(eur)}>]
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "task encode_mwr: expected the request ID and 3 response words, got 3 arguments")
+			},
+		},
+		{
+			name: "dynamic response word",
+			toml: header + `
+responseShape       = "multiWord"
+responseWords       = 1
+observationSource   = """
+    encode_mwr [type=ethabiencode abi="(bytes32 requestId, string name)" data=<{"requestId": $(requestId), "name": $(name)}>]
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "task encode_mwr: response word name must be a 32 byte static type, got string")
+			},
+		},
+		{
+			name: "gas limit too low for the callback",
+			toml: header + `
+responseShape       = "singleWord"
+observationSource   = """
+    submit_tx  [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x" gasLimit="450000"]
+"""
+`,
+			assertion: func(t *testing.T, jb job.Job, err error) {
+				require.EqualError(t, err, "task submit_tx: gasLimit 450000 is below the 500000 the singleWord response needs to leave callbackGasLimit to the consumer")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			jb, err := ValidatedDirectRequestSpec(tc.toml)
+			tc.assertion(t, jb, err)
+		})
+	}
+}
@@ -1,7 +1,10 @@
 package fluxmonitorv2
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -62,12 +65,39 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err erro
 	if err != nil {
 		return nil, err
 	}
-	strategy := bulletprooftxmanager.NewQueueingTxStrategy(jb.ExternalJobID, chain.Config().FMDefaultTransactionQueueDepth())
 	var checker bulletprooftxmanager.TransmitCheckerSpec
 	if chain.Config().FMSimulateTransactions() {
 		checker.CheckerType = bulletprooftxmanager.TransmitCheckerTypeSimulate
 	}
 
+	if len(jb.FluxMonitorSpec.Aggregators) > 0 {
+		// Each aggregator gets its own transaction queue, so that a backlog of
+		// submissions to one aggregator does not evict those to another
+		newORM := func(contractAddress common.Address) ORM {
+			subject := uuid.NewV5(jb.ExternalJobID, contractAddress.Hex())
+			strategy := bulletprooftxmanager.NewQueueingTxStrategy(subject, chain.Config().FMDefaultTransactionQueueDepth())
+			return NewORM(d.db, d.lggr, chain.Config(), chain.TxManager(), strategy, checker)
+		}
+		mfm, err2 := NewMultiFromJobSpec(
+			jb,
+			d.db,
+			newORM,
+			d.jobORM,
+			d.pipelineORM,
+			NewKeyStore(d.ethKeyStore),
+			chain.Client(),
+			chain.LogBroadcaster(),
+			d.pipelineRunner,
+			chain.Config(),
+			d.lggr,
+		)
+		if err2 != nil {
+			return nil, err2
+		}
+		return []job.Service{mfm}, nil
+	}
+
+	strategy := bulletprooftxmanager.NewQueueingTxStrategy(jb.ExternalJobID, chain.Config().FMDefaultTransactionQueueDepth())
 	fm, err := NewFromJobSpec(
 		jb,
 		d.db,
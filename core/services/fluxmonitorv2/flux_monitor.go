@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
@@ -72,11 +73,20 @@ type FluxMonitor struct {
 	flags             Flags
 	fluxAggregator    flux_aggregator_wrapper.FluxAggregatorInterface
 	logBroadcaster    log.Broadcaster
+	// aggregatorVars is set for the aggregators of a multi-aggregator job,
+	// and exposed to the pipeline as $(aggregator)
+	aggregatorVars map[string]interface{}
+	// shared is set for the aggregators of a multi-aggregator job, whose
+	// poll ticker, drumbeat and Flags subscription are owned by the
+	// MultiFluxMonitor
+	shared bool
 
 	logger logger.Logger
 
 	backlog       *utils.BoundedPriorityQueue
 	chProcessLogs chan struct{}
+	chRequestPoll chan sharedPollRequest
+	flagChanges   *utils.Mailbox
 
 	utils.StartStopOnce
 	chStop     chan struct{}
@@ -132,6 +142,8 @@ func NewFluxMonitor(
 		}),
 		StartStopOnce: utils.StartStopOnce{},
 		chProcessLogs: make(chan struct{}, 1),
+		chRequestPoll: make(chan sharedPollRequest, 1),
+		flagChanges:   utils.NewMailbox(1),
 		chStop:        make(chan struct{}),
 		waitOnStop:    make(chan struct{}),
 	}
@@ -164,9 +176,64 @@ func NewFromJobSpec(
 		)
 	}
 
+	return newFromAggregator(
+		jobSpec,
+		job.FluxMonitorAggregator{
+			ContractAddress:   fmSpec.ContractAddress,
+			Threshold:         fmSpec.Threshold,
+			AbsoluteThreshold: fmSpec.AbsoluteThreshold,
+		},
+		pollManagerConfig(fmSpec),
+		db,
+		orm,
+		jobORM,
+		pipelineORM,
+		keyStore,
+		ethClient,
+		logBroadcaster,
+		pipelineRunner,
+		cfg,
+		lggr,
+	)
+}
+
+func pollManagerConfig(fmSpec *job.FluxMonitorSpec) PollManagerConfig {
+	return PollManagerConfig{
+		PollTickerInterval:      fmSpec.PollTimerPeriod,
+		PollTickerDisabled:      fmSpec.PollTimerDisabled,
+		IdleTimerPeriod:         fmSpec.IdleTimerPeriod,
+		IdleTimerDisabled:       fmSpec.IdleTimerDisabled,
+		DrumbeatSchedule:        fmSpec.DrumbeatSchedule,
+		DrumbeatEnabled:         fmSpec.DrumbeatEnabled,
+		DrumbeatRandomDelay:     fmSpec.DrumbeatRandomDelay,
+		HibernationPollPeriod:   DefaultHibernationPollPeriod, // Not currently configurable
+		MinRetryBackoffDuration: 1 * time.Minute,
+		MaxRetryBackoffDuration: 1 * time.Hour,
+	}
+}
+
+// newFromAggregator constructs a FluxMonitor reporting to a single aggregator
+// of jobSpec.
+func newFromAggregator(
+	jobSpec job.Job,
+	aggregator job.FluxMonitorAggregator,
+	pollCfg PollManagerConfig,
+	db *sqlx.DB,
+	orm ORM,
+	jobORM job.ORM,
+	pipelineORM pipeline.ORM,
+	keyStore KeyStoreInterface,
+	ethClient evmclient.Client,
+	logBroadcaster log.Broadcaster,
+	pipelineRunner pipeline.Runner,
+	cfg Config,
+	lggr logger.Logger,
+) (*FluxMonitor, error) {
+	fmSpec := jobSpec.FluxMonitorSpec
+
 	// Set up the flux aggregator
 	fluxAggregator, err := flux_aggregator_wrapper.NewFluxAggregator(
-		fmSpec.ContractAddress.Address(),
+		aggregator.ContractAddress.Address(),
 		ethClient,
 	)
 	if err != nil {
@@ -195,6 +262,7 @@ func NewFromJobSpec(
 
 	jobSpec.PipelineSpec.JobID = jobSpec.ID
 	jobSpec.PipelineSpec.JobName = jobSpec.Name.ValueOrZero()
+	jobSpec.PipelineSpec.ExternalJobID = jobSpec.ExternalJobID
 
 	min, err := fluxAggregator.MinSubmissionValue(nil)
 	if err != nil {
@@ -208,29 +276,15 @@ func NewFromJobSpec(
 
 	fmLogger := lggr.With(
 		"jobID", jobSpec.ID,
-		"contract", fmSpec.ContractAddress.Hex(),
+		"contract", aggregator.ContractAddress.Hex(),
 	)
 
-	pollManager, err := NewPollManager(
-		PollManagerConfig{
-			PollTickerInterval:      fmSpec.PollTimerPeriod,
-			PollTickerDisabled:      fmSpec.PollTimerDisabled,
-			IdleTimerPeriod:         fmSpec.IdleTimerPeriod,
-			IdleTimerDisabled:       fmSpec.IdleTimerDisabled,
-			DrumbeatSchedule:        fmSpec.DrumbeatSchedule,
-			DrumbeatEnabled:         fmSpec.DrumbeatEnabled,
-			DrumbeatRandomDelay:     fmSpec.DrumbeatRandomDelay,
-			HibernationPollPeriod:   DefaultHibernationPollPeriod, // Not currently configurable
-			MinRetryBackoffDuration: 1 * time.Minute,
-			MaxRetryBackoffDuration: 1 * time.Hour,
-		},
-		fmLogger,
-	)
+	pollManager, err := NewPollManager(pollCfg, fmLogger)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewFluxMonitor(
+	fm, err := NewFluxMonitor(
 		pipelineRunner,
 		jobSpec,
 		*jobSpec.PipelineSpec,
@@ -241,11 +295,11 @@ func NewFromJobSpec(
 		keyStore,
 		pollManager,
 		paymentChecker,
-		fmSpec.ContractAddress.Address(),
+		aggregator.ContractAddress.Address(),
 		contractSubmitter,
 		NewDeviationChecker(
-			float64(fmSpec.Threshold),
-			float64(fmSpec.AbsoluteThreshold),
+			float64(aggregator.Threshold),
+			float64(aggregator.AbsoluteThreshold),
 			fmLogger,
 		),
 		NewSubmissionChecker(min, max),
@@ -254,6 +308,17 @@ func NewFromJobSpec(
 		logBroadcaster,
 		fmLogger,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if len(fmSpec.Aggregators) > 0 {
+		fm.shared = true
+		fm.aggregatorVars = map[string]interface{}{}
+		for k, v := range aggregator.Variables {
+			fm.aggregatorVars[k] = v
+		}
+	}
+	return fm, nil
 }
 
 const (
@@ -362,7 +427,7 @@ func (fm *FluxMonitor) consume() {
 	})
 	defer unsubscribe()
 
-	if fm.flags.ContractExists() {
+	if fm.flags.ContractExists() && !fm.shared {
 		unsubscribe := fm.logBroadcaster.Register(fm, log.ListenerOpts{
 			Contract: fm.flags.Address(),
 			ParseLog: fm.flags.ParseLog,
@@ -393,37 +458,59 @@ func (fm *FluxMonitor) consume() {
 		case at := <-fm.pollManager.PollTickerTicks():
 			tickLogger.Debugf("Poll ticker fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
-				fm.pollIfEligible(PollRequestTypePoll, fm.deviationChecker, nil)
+				fm.pollIfEligible(PollRequestTypePoll, fm.deviationChecker, nil, nil)
 			})
 
 		case at := <-fm.pollManager.IdleTimerTicks():
 			tickLogger.Debugf("Idle timer fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
-				fm.pollIfEligible(PollRequestTypeIdle, NewZeroDeviationChecker(fm.logger), nil)
+				fm.pollIfEligible(PollRequestTypeIdle, NewZeroDeviationChecker(fm.logger), nil, nil)
 			})
 
 		case at := <-fm.pollManager.RoundTimerTicks():
 			tickLogger.Debugf("Round timer fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
-				fm.pollIfEligible(PollRequestTypeRound, fm.deviationChecker, nil)
+				fm.pollIfEligible(PollRequestTypeRound, fm.deviationChecker, nil, nil)
 			})
 
 		case at := <-fm.pollManager.HibernationTimerTicks():
 			tickLogger.Debugf("Hibernation timer fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
-				fm.pollIfEligible(PollRequestTypeHibernation, NewZeroDeviationChecker(fm.logger), nil)
+				fm.pollIfEligible(PollRequestTypeHibernation, NewZeroDeviationChecker(fm.logger), nil, nil)
 			})
 
 		case at := <-fm.pollManager.RetryTickerTicks():
 			tickLogger.Debugf("Retry ticker fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
-				fm.pollIfEligible(PollRequestTypeRetry, NewZeroDeviationChecker(fm.logger), nil)
+				fm.pollIfEligible(PollRequestTypeRetry, NewZeroDeviationChecker(fm.logger), nil, nil)
 			})
 
 		case at := <-fm.pollManager.DrumbeatTicks():
 			tickLogger.Debugf("Drumbeat ticker fired on %v", formatTime(at))
 			recovery.WrapRecover(fm.logger, func() {
-				fm.pollIfEligible(PollRequestTypeDrumbeat, NewZeroDeviationChecker(fm.logger), nil)
+				fm.pollIfEligible(PollRequestTypeDrumbeat, NewZeroDeviationChecker(fm.logger), nil, nil)
+			})
+
+		case request := <-fm.chRequestPoll:
+			checker := fm.deviationChecker
+			if request.Type == PollRequestTypeDrumbeat {
+				checker = NewZeroDeviationChecker(fm.logger)
+			}
+			recovery.WrapRecover(fm.logger, func() {
+				fm.pollIfEligible(request.Type, checker, nil, request.observation)
+			})
+
+		case <-fm.flagChanges.Notify():
+			raised, ok := fm.flagChanges.Retrieve()
+			if !ok {
+				continue
+			}
+			recovery.WrapRecover(fm.logger, func() {
+				if raised.(bool) {
+					fm.respondToFlagsRaisedLog()
+				} else {
+					fm.respondToFlagsLoweredLog(nil)
+				}
 			})
 
 		case request := <-fm.pollManager.Poll():
@@ -432,13 +519,32 @@ func (fm *FluxMonitor) consume() {
 				break
 			default:
 				recovery.WrapRecover(fm.logger, func() {
-					fm.pollIfEligible(request.Type, fm.deviationChecker, nil)
+					fm.pollIfEligible(request.Type, fm.deviationChecker, nil, nil)
 				})
 			}
 		}
 	}
 }
 
+// sharedPollRequest is a shared tick of a multi-aggregator job, along with
+// the observation shared by the aggregators with the same variables.
+type sharedPollRequest struct {
+	PollRequest
+	observation *observation
+}
+
+// requestPoll asks the FluxMonitor to poll, independently of its own
+// PollManager, using obs rather than a pipeline run of its own. It is used to
+// fan out the shared ticks of a multi-aggregator job. It never blocks: the
+// request is dropped if one is already pending.
+func (fm *FluxMonitor) requestPoll(request PollRequest, obs *observation) {
+	select {
+	case fm.chRequestPoll <- sharedPollRequest{request, obs}:
+	default:
+		fm.logger.Debugw("Dropping poll request, a poll is already pending", "type", request.Type)
+	}
+}
+
 func formatTime(at time.Time) string {
 	ago := time.Since(at)
 	return fmt.Sprintf("%v (%v ago)", at.UTC().Format(time.RFC3339), ago)
@@ -520,11 +626,7 @@ func (fm *FluxMonitor) processBroadcast(broadcast log.Broadcast) {
 		fm.respondToFlagsRaisedLog()
 		fm.markLogAsConsumed(broadcast, decodedLog, started)
 	case *flags_wrapper.FlagsFlagLowered:
-		// Only reactivate if it is hibernating
-		if fm.pollManager.isHibernating.Load() {
-			fm.pollManager.Awaken(fm.initialRoundState())
-			fm.pollIfEligible(PollRequestTypeAwaken, NewZeroDeviationChecker(fm.logger), broadcast)
-		}
+		fm.respondToFlagsLoweredLog(broadcast)
 	default:
 		fm.logger.Errorf("unknown log %v of type %T", log, log)
 	}
@@ -548,6 +650,21 @@ func (fm *FluxMonitor) respondToFlagsRaisedLog() {
 	}
 }
 
+// respondToFlagsLoweredLog reactivates the FluxMonitor if it is hibernating.
+// broadcast is nil when the log was consumed by a MultiFluxMonitor.
+func (fm *FluxMonitor) respondToFlagsLoweredLog(broadcast log.Broadcast) {
+	if fm.pollManager.isHibernating.Load() {
+		fm.pollManager.Awaken(fm.initialRoundState())
+		fm.pollIfEligible(PollRequestTypeAwaken, NewZeroDeviationChecker(fm.logger), broadcast, nil)
+	}
+}
+
+// flagChanged passes on a Flags log received by a MultiFluxMonitor. Only the
+// latest change is kept if the FluxMonitor is busy.
+func (fm *FluxMonitor) flagChanged(raised bool) {
+	fm.flagChanges.Deliver(raised)
+}
+
 // The AnswerUpdated log tells us that round has successfully closed with a new
 // answer.  We update our view of the oracleRoundState in case this log was
 // generated by a chain reorg.
@@ -717,16 +834,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 		}
 	}
 
-	vars := pipeline.NewVarsFrom(map[string]interface{}{
-		"jobSpec": map[string]interface{}{
-			"databaseID":    fm.jobSpec.ID,
-			"externalJobID": fm.jobSpec.ExternalJobID,
-			"name":          fm.jobSpec.Name.ValueOrZero(),
-		},
-		"jobRun": map[string]interface{}{
-			"meta": metaDataForBridge,
-		},
-	})
+	vars := fm.pipelineVars(metaDataForBridge)
 
 	// Call the v2 pipeline to execute a new job run
 	run, results, err := fm.runner.ExecuteRun(context.Background(), fm.spec, vars, fm.logger)
@@ -795,7 +903,9 @@ func (fm *FluxMonitor) checkEligibilityAndAggregatorFunding(roundState flux_aggr
 	return nil
 }
 
-func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker *DeviationChecker, broadcast log.Broadcast) {
+// pollIfEligible polls and submits to the aggregator if eligible. The answer
+// is taken from obs when given, rather than from a pipeline run of its own.
+func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker *DeviationChecker, broadcast log.Broadcast, obs *observation) {
 	started := time.Now()
 
 	l := fm.logger.With(
@@ -905,50 +1015,24 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		return
 	}
 
-	var metaDataForBridge map[string]interface{}
-	lrd, err := fm.fluxAggregator.LatestRoundData(nil)
-	if err != nil {
-		l.Warnw("Couldn't read latest round data for request meta", "err", err)
+	var run pipeline.Run
+	var answer decimal.Decimal
+	if obs != nil {
+		// The aggregators of a multi-aggregator job with the same variables
+		// share one pipeline run per tick
+		answer, err = obs.answer()
+		if err != nil {
+			l.Errorw("can't fetch answer", "err", err)
+			fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
+			return
+		}
 	} else {
-		metaDataForBridge, err = bridges.MarshalBridgeMetaData(lrd.Answer, lrd.UpdatedAt)
+		run, answer, err = fm.observe(l)
 		if err != nil {
-			l.Warnw("Error marshalling roundState for request meta", "err", err)
+			return
 		}
 	}
 
-	// Call the v2 pipeline to execute a new pipeline run
-	// Note: we expect the FM pipeline to scale the fetched answer by the same
-	// amount as "decimals" in the FM contract.
-
-	vars := pipeline.NewVarsFrom(map[string]interface{}{
-		"jobSpec": map[string]interface{}{
-			"databaseID":    fm.jobSpec.ID,
-			"externalJobID": fm.jobSpec.ExternalJobID,
-			"name":          fm.jobSpec.Name.ValueOrZero(),
-		},
-		"jobRun": map[string]interface{}{
-			"meta": metaDataForBridge,
-		},
-	})
-
-	run, results, err := fm.runner.ExecuteRun(context.Background(), fm.spec, vars, fm.logger)
-	if err != nil {
-		l.Errorw("can't fetch answer", "err", err)
-		fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
-		return
-	}
-	result, err := results.FinalResult(l).SingularResult()
-	if err != nil || result.Error != nil {
-		l.Errorw("can't fetch answer", "err", err, "result", result)
-		fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
-		return
-	}
-	answer, err := utils.ToDecimal(result.Value)
-	if err != nil {
-		l.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
-		return
-	}
-
 	if !fm.isValidSubmission(l, answer, started) {
 		return
 	}
@@ -977,11 +1061,8 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 		l.Error("roundState.PaymentAmount shouldn't be nil")
 	}
 
-	err = fm.q.Transaction(func(tx pg.Queryer) error {
-		if err2 := fm.runner.InsertFinishedRun(&run, true, pg.WithQueryer(tx)); err2 != nil {
-			return err2
-		}
-		if err2 := fm.queueTransactionForBPTXM(tx, run.ID, answer, roundState.RoundId, nil); err2 != nil {
+	submit := func(tx pg.Queryer, runID int64) error {
+		if err2 := fm.queueTransactionForBPTXM(tx, runID, answer, roundState.RoundId, nil); err2 != nil {
 			return err2
 		}
 		if broadcast != nil {
@@ -989,7 +1070,17 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 			return fm.logBroadcaster.MarkConsumed(broadcast, pg.WithQueryer(tx))
 		}
 		return nil
-	})
+	}
+	if obs != nil {
+		err = obs.transact(fm.q, submit)
+	} else {
+		err = fm.q.Transaction(func(tx pg.Queryer) error {
+			if err2 := fm.runner.InsertFinishedRun(&run, true, pg.WithQueryer(tx)); err2 != nil {
+				return err2
+			}
+			return submit(tx, run.ID)
+		})
+	}
 	// Either the tx failed and we want to reprocess the log, or it succeeded and already marked it consumed
 	markConsumed = false
 	if err != nil {
@@ -1001,6 +1092,47 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 	promfm.SetUint32(promfm.ReportedRound.WithLabelValues(jobID), roundState.RoundId)
 }
 
+// observe executes the pipeline for a poll, and returns the run with its
+// answer. Errors are logged and recorded against the job.
+func (fm *FluxMonitor) observe(l logger.Logger) (pipeline.Run, decimal.Decimal, error) {
+	var metaDataForBridge map[string]interface{}
+	lrd, err := fm.fluxAggregator.LatestRoundData(nil)
+	if err != nil {
+		l.Warnw("Couldn't read latest round data for request meta", "err", err)
+	} else {
+		metaDataForBridge, err = bridges.MarshalBridgeMetaData(lrd.Answer, lrd.UpdatedAt)
+		if err != nil {
+			l.Warnw("Error marshalling roundState for request meta", "err", err)
+		}
+	}
+
+	// Call the v2 pipeline to execute a new pipeline run
+	// Note: we expect the FM pipeline to scale the fetched answer by the same
+	// amount as "decimals" in the FM contract.
+
+	vars := fm.pipelineVars(metaDataForBridge)
+
+	run, results, err := fm.runner.ExecuteRun(context.Background(), fm.spec, vars, fm.logger)
+	if err != nil {
+		l.Errorw("can't fetch answer", "err", err)
+		fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
+		return pipeline.Run{}, decimal.Decimal{}, err
+	}
+	result, err := results.FinalResult(l).SingularResult()
+	if err != nil || result.Error != nil {
+		l.Errorw("can't fetch answer", "err", err, "result", result)
+		fm.jobORM.TryRecordError(fm.spec.JobID, "Error polling")
+		return pipeline.Run{}, decimal.Decimal{}, multierr.Combine(err, result.Error)
+	}
+	answer, err := utils.ToDecimal(result.Value)
+	if err != nil {
+		l.Errorw(fmt.Sprintf("error executing new run for job ID %v name %v", fm.spec.JobID, fm.spec.JobName), "err", err)
+		return pipeline.Run{}, decimal.Decimal{}, err
+	}
+
+	return run, answer, nil
+}
+
 // If the answer is outside the allowable range, log an error and don't submit.
 // to avoid an onchain reversion.
 func (fm *FluxMonitor) isValidSubmission(l logger.Logger, answer decimal.Decimal, started time.Time) bool {
@@ -1025,6 +1157,23 @@ func (fm *FluxMonitor) isValidSubmission(l logger.Logger, answer decimal.Decimal
 	return false
 }
 
+func (fm *FluxMonitor) pipelineVars(metaDataForBridge map[string]interface{}) pipeline.Vars {
+	vars := map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    fm.jobSpec.ID,
+			"externalJobID": fm.jobSpec.ExternalJobID,
+			"name":          fm.jobSpec.Name.ValueOrZero(),
+		},
+		"jobRun": map[string]interface{}{
+			"meta": metaDataForBridge,
+		},
+	}
+	if fm.aggregatorVars != nil {
+		vars["aggregator"] = fm.aggregatorVars
+	}
+	return pipeline.NewVarsFrom(vars)
+}
+
 func (fm *FluxMonitor) roundState(roundID uint32) (flux_aggregator_wrapper.OracleRoundState, error) {
 	return fm.fluxAggregator.OracleRoundState(nil, fm.oracleAddress, roundID)
 }
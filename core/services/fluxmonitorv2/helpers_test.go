@@ -3,11 +3,12 @@ package fluxmonitorv2
 import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 func (fm *FluxMonitor) ExportedPollIfEligible(threshold, absoluteThreshold float64) {
-	fm.pollIfEligible(PollRequestTypePoll, NewDeviationChecker(threshold, absoluteThreshold, fm.logger), nil)
+	fm.pollIfEligible(PollRequestTypePoll, NewDeviationChecker(threshold, absoluteThreshold, fm.logger), nil, nil)
 }
 
 func (fm *FluxMonitor) ExportedProcessLogs() {
@@ -35,3 +36,10 @@ func (fm *FluxMonitor) rotateSelectLoop() {
 	// the PollRequest is sent to 'rotate' the main select loop, so that new timers will be evaluated
 	fm.pollManager.chPoll <- PollRequest{Type: PollRequestTypeUnknown}
 }
+
+func NewTestMultiFluxMonitor(pollManager *PollManager, initialPoll bool, flags Flags, logBroadcaster log.Broadcaster, lggr logger.Logger, monitors ...*FluxMonitor) *MultiFluxMonitor {
+	for _, fm := range monitors {
+		fm.shared = true
+	}
+	return newMultiFluxMonitor(0, pollManager, initialPoll, flags, logBroadcaster, lggr, monitors)
+}
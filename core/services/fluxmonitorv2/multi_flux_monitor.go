@@ -0,0 +1,431 @@
+package fluxmonitorv2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	mrand "math/rand"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/log"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flags_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/recovery"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/sqlx"
+)
+
+// MultiFluxMonitor runs a multi-aggregator FluxMonitor job. It owns a single
+// PollManager whose poll ticker and drumbeat are shared by every aggregator of
+// the job, and fans each tick out to one FluxMonitor per aggregator, along
+// with an observation shared by the aggregators with the same variables. It
+// also owns the job's subscription to the Flags contract.
+//
+// The per-aggregator FluxMonitors keep their own idle, round, retry and
+// hibernation timers, since those depend on each aggregator's round state, as
+// well as their own deviation thresholds and round stats.
+type MultiFluxMonitor struct {
+	jobID       int32
+	pollManager *PollManager
+	monitors    []*FluxMonitor
+	groups      [][]*FluxMonitor
+	// initialPoll is true if the shared PollManager is responsible for the
+	// initial poll, that is if the aggregators' idle timers are disabled
+	initialPoll    bool
+	flags          Flags
+	logBroadcaster log.Broadcaster
+	logger         logger.Logger
+
+	flagLogs *utils.Mailbox
+
+	utils.StartStopOnce
+	chStop      chan struct{}
+	waitOnStop  chan struct{}
+	unsubscribe func()
+}
+
+// NewMultiFromJobSpec constructs a MultiFluxMonitor for a job with
+// aggregators. newORM is called once per aggregator, so that transactions are
+// queued per aggregator rather than per job.
+func NewMultiFromJobSpec(
+	jobSpec job.Job,
+	db *sqlx.DB,
+	newORM func(contractAddress common.Address) ORM,
+	jobORM job.ORM,
+	pipelineORM pipeline.ORM,
+	keyStore KeyStoreInterface,
+	ethClient evmclient.Client,
+	logBroadcaster log.Broadcaster,
+	pipelineRunner pipeline.Runner,
+	cfg Config,
+	lggr logger.Logger,
+) (*MultiFluxMonitor, error) {
+	fmSpec := jobSpec.FluxMonitorSpec
+	if len(fmSpec.Aggregators) == 0 {
+		return nil, errors.New("MultiFluxMonitor expects at least one aggregator")
+	}
+
+	if !validatePollTimer(fmSpec.PollTimerDisabled, MinimumPollingInterval(cfg), fmSpec.PollTimerPeriod) {
+		return nil, fmt.Errorf(
+			"PollTimerPeriod (%s), must be equal or greater than DEFAULT_HTTP_TIMEOUT (%s) ",
+			fmSpec.PollTimerPeriod,
+			MinimumPollingInterval(cfg),
+		)
+	}
+
+	mfmLogger := lggr.With("jobID", jobSpec.ID, "aggregators", len(fmSpec.Aggregators))
+
+	// The shared PollManager only drives the poll ticker and drumbeat
+	sharedCfg := pollManagerConfig(fmSpec)
+	sharedCfg.IdleTimerDisabled = true
+	pollManager, err := NewPollManager(sharedCfg, mfmLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	// whereas the aggregators' PollManagers drive everything else
+	aggregatorCfg := pollManagerConfig(fmSpec)
+	aggregatorCfg.PollTickerDisabled = true
+	aggregatorCfg.DrumbeatEnabled = false
+
+	flags, err := NewFlags(cfg.FlagsContractAddress(), ethClient)
+	lggr.ErrorIf(err,
+		fmt.Sprintf(
+			"Error creating Flags contract instance, check address: %s",
+			cfg.FlagsContractAddress(),
+		),
+	)
+
+	var monitors []*FluxMonitor
+	for _, aggregator := range fmSpec.Aggregators {
+		fm, err := newFromAggregator(
+			jobSpec,
+			aggregator,
+			aggregatorCfg,
+			db,
+			newORM(aggregator.ContractAddress.Address()),
+			jobORM,
+			pipelineORM,
+			keyStore,
+			ethClient,
+			logBroadcaster,
+			pipelineRunner,
+			cfg,
+			lggr,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create FluxMonitor for aggregator %s", aggregator.ContractAddress)
+		}
+		monitors = append(monitors, fm)
+	}
+	return newMultiFluxMonitor(jobSpec.ID, pollManager, fmSpec.IdleTimerDisabled, flags, logBroadcaster, mfmLogger, monitors), nil
+}
+
+func newMultiFluxMonitor(
+	jobID int32,
+	pollManager *PollManager,
+	initialPoll bool,
+	flags Flags,
+	logBroadcaster log.Broadcaster,
+	lggr logger.Logger,
+	monitors []*FluxMonitor,
+) *MultiFluxMonitor {
+	return &MultiFluxMonitor{
+		jobID:          jobID,
+		pollManager:    pollManager,
+		monitors:       monitors,
+		groups:         groupByVariables(monitors),
+		initialPoll:    initialPoll,
+		flags:          flags,
+		logBroadcaster: logBroadcaster,
+		logger:         lggr,
+		flagLogs:       utils.NewMailbox(100),
+		chStop:         make(chan struct{}),
+		waitOnStop:     make(chan struct{}),
+		unsubscribe:    func() {},
+	}
+}
+
+// groupByVariables groups the aggregators whose variables are the same, and
+// can therefore share an observation. The order of the job spec is kept.
+func groupByVariables(monitors []*FluxMonitor) (groups [][]*FluxMonitor) {
+	index := make(map[string]int)
+	for _, fm := range monitors {
+		// json.Marshal sorts map keys, so equal variables give equal keys
+		b, err := json.Marshal(fm.aggregatorVars)
+		if err != nil {
+			// not comparable, so the aggregator observes on its own
+			groups = append(groups, []*FluxMonitor{fm})
+			continue
+		}
+		key := string(b)
+		if i, exists := index[key]; exists {
+			groups[i] = append(groups[i], fm)
+			continue
+		}
+		index[key] = len(groups)
+		groups = append(groups, []*FluxMonitor{fm})
+	}
+	return groups
+}
+
+// Start implements the job.Service interface.
+func (mfm *MultiFluxMonitor) Start() error {
+	return mfm.StartOnce("MultiFluxMonitor", func() (err error) {
+		mfm.logger.Debug("Starting multi-aggregator Flux Monitor for job")
+
+		for i, fm := range mfm.monitors {
+			if err = fm.Start(); err != nil {
+				for _, started := range mfm.monitors[:i] {
+					err = multierr.Combine(err, started.Close())
+				}
+				return err
+			}
+		}
+
+		if mfm.flags.ContractExists() {
+			mfm.unsubscribe = mfm.logBroadcaster.Register(mfm, log.ListenerOpts{
+				Contract: mfm.flags.Address(),
+				ParseLog: mfm.flags.ParseLog,
+				LogsWithTopics: map[common.Hash][][]log.Topic{
+					flags_wrapper.FlagsFlagLowered{}.Topic(): nil,
+					flags_wrapper.FlagsFlagRaised{}.Topic():  nil,
+				},
+				MinIncomingConfirmations: 0,
+			})
+		}
+
+		// The round state only matters to the idle and round timers, which
+		// are disabled on the shared PollManager
+		mfm.pollManager.Start(false, flux_aggregator_wrapper.OracleRoundState{})
+		go mfm.run()
+
+		return nil
+	})
+}
+
+// Close implements the job.Service interface.
+func (mfm *MultiFluxMonitor) Close() error {
+	return mfm.StopOnce("MultiFluxMonitor", func() (err error) {
+		mfm.pollManager.Stop()
+		close(mfm.chStop)
+		<-mfm.waitOnStop
+		mfm.unsubscribe()
+
+		for _, fm := range mfm.monitors {
+			err = multierr.Combine(err, fm.Close())
+		}
+		return err
+	})
+}
+
+// Monitors returns the FluxMonitor of each aggregator, in the order of the
+// job spec.
+func (mfm *MultiFluxMonitor) Monitors() []*FluxMonitor {
+	return mfm.monitors
+}
+
+// JobID implements the log.Listener interface.
+func (mfm *MultiFluxMonitor) JobID() int32 { return mfm.jobID }
+
+// HandleLog implements the log.Listener interface. Flags logs are consumed
+// once for the job, and passed on to the aggregators they concern.
+func (mfm *MultiFluxMonitor) HandleLog(broadcast log.Broadcast) {
+	decodedLog := broadcast.DecodedLog()
+	if decodedLog == nil || reflect.ValueOf(decodedLog).IsNil() {
+		mfm.logger.Error("HandleLog: ignoring nil value")
+		return
+	}
+	if wasOverCapacity := mfm.flagLogs.Deliver(broadcast); wasOverCapacity {
+		mfm.logger.Error("Flags log mailbox is over capacity - dropped the oldest log")
+	}
+}
+
+func (mfm *MultiFluxMonitor) run() {
+	defer close(mfm.waitOnStop)
+
+	// Because the drumbeat may fire at the same time on multiple nodes, the
+	// drumbeat poll is delayed by a short random duration, once for all
+	// aggregators
+	var drumbeatDelay <-chan time.Time
+
+	for {
+		select {
+		case <-mfm.chStop:
+			return
+
+		case <-mfm.flagLogs.Notify():
+			recovery.WrapRecover(mfm.logger, mfm.processFlagLogs)
+
+		case at := <-mfm.pollManager.PollTickerTicks():
+			mfm.logger.Debugf("Shared poll ticker fired on %v", formatTime(at))
+			mfm.fanOut(PollRequest{Type: PollRequestTypePoll, Timestamp: at})
+
+		case at := <-mfm.pollManager.DrumbeatTicks():
+			mfm.logger.Debugf("Shared drumbeat ticker fired on %v", formatTime(at))
+			if max := mfm.pollManager.cfg.DrumbeatRandomDelay; max > 0 {
+				// #nosec
+				delay := time.Duration(mrand.Int63n(int64(max)))
+				mfm.logger.Infof("waiting %v (of max: %v) before continuing...", delay, max)
+				drumbeatDelay = time.After(delay)
+				continue
+			}
+			mfm.fanOut(PollRequest{Type: PollRequestTypeDrumbeat, Timestamp: at})
+
+		case at := <-drumbeatDelay:
+			drumbeatDelay = nil
+			mfm.fanOut(PollRequest{Type: PollRequestTypeDrumbeat, Timestamp: at})
+
+		case request := <-mfm.pollManager.Poll():
+			// Aggregators with an idle timer already perform their own
+			// initial poll
+			if request.Type == PollRequestTypeInitial && !mfm.initialPoll {
+				continue
+			}
+			mfm.fanOut(request)
+		}
+	}
+}
+
+func (mfm *MultiFluxMonitor) fanOut(request PollRequest) {
+	recovery.WrapRecover(mfm.logger, func() {
+		for _, group := range mfm.groups {
+			obs := newObservation(group[0], mfm.logger)
+			for _, fm := range group {
+				fm.requestPoll(request, obs)
+			}
+		}
+	})
+}
+
+func (mfm *MultiFluxMonitor) processFlagLogs() {
+	for {
+		x, exists := mfm.flagLogs.Retrieve()
+		if !exists {
+			return
+		}
+		broadcast, ok := x.(log.Broadcast)
+		if !ok {
+			mfm.logger.Errorf("Failed to convert flag log into LogBroadcast. Type is %T", x)
+			continue
+		}
+
+		consumed, err := mfm.logBroadcaster.WasAlreadyConsumed(broadcast)
+		if err != nil {
+			mfm.logger.Errorf("Error determining if log was already consumed: %v", err)
+			continue
+		} else if consumed {
+			mfm.logger.Debug("Log was already consumed by Flux Monitor, skipping")
+			continue
+		}
+
+		var subject common.Address
+		var raised bool
+		switch log := broadcast.DecodedLog().(type) {
+		case *flags_wrapper.FlagsFlagRaised:
+			subject, raised = log.Subject, true
+		case *flags_wrapper.FlagsFlagLowered:
+			subject, raised = log.Subject, false
+		default:
+			mfm.logger.Warnf("unexpected log type %T", log)
+			continue
+		}
+		for _, fm := range mfm.monitors {
+			if subject == utils.ZeroAddress || subject == fm.contractAddress {
+				fm.flagChanged(raised)
+			}
+		}
+
+		if err := mfm.logBroadcaster.MarkConsumed(broadcast); err != nil {
+			mfm.logger.Errorw("Failed to mark log as consumed", "err", err, "log", broadcast.String())
+		}
+	}
+}
+
+// observation is the answer of one pipeline run, shared by the aggregators of
+// a multi-aggregator job with the same variables for a single tick. The run
+// is executed by the first aggregator eligible to submit, so that none is
+// executed on ticks where no aggregator is.
+type observation struct {
+	runner pipeline.Runner
+	spec   pipeline.Spec
+	vars   pipeline.Vars
+	logger logger.Logger
+
+	once sync.Once
+	ans  decimal.Decimal
+	err  error
+
+	// mu serializes the transactions of the aggregators submitting the
+	// observation, so that its run is inserted exactly once
+	mu  sync.Mutex
+	run pipeline.Run
+}
+
+func newObservation(fm *FluxMonitor, lggr logger.Logger) *observation {
+	return &observation{
+		runner: fm.runner,
+		spec:   fm.spec,
+		vars:   fm.pipelineVars(nil),
+		logger: lggr,
+	}
+}
+
+// answer executes the pipeline on the first call, and returns its answer.
+func (o *observation) answer() (decimal.Decimal, error) {
+	o.once.Do(func() {
+		run, results, err := o.runner.ExecuteRun(context.Background(), o.spec, o.vars, o.logger)
+		if err != nil {
+			o.err = errors.Wrap(err, "error executing run")
+			return
+		}
+		result, err := results.FinalResult(o.logger).SingularResult()
+		if err != nil {
+			o.err = err
+			return
+		} else if result.Error != nil {
+			o.err = result.Error
+			return
+		}
+		o.ans, o.err = utils.ToDecimal(result.Value)
+		o.mu.Lock()
+		o.run = run
+		o.mu.Unlock()
+	})
+	return o.ans, o.err
+}
+
+// transact calls fn in a transaction, along with the ID of the run of the
+// observation, which is inserted by the first aggregator to submit it.
+func (o *observation) transact(q pg.Q, fn func(tx pg.Queryer, runID int64) error) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	inserted := false
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if o.run.ID == 0 {
+			if err := o.runner.InsertFinishedRun(&o.run, true, pg.WithQueryer(tx)); err != nil {
+				return err
+			}
+			inserted = true
+		}
+		return fn(tx, o.run.ID)
+	})
+	if err != nil && inserted {
+		// the run was rolled back along with the transaction
+		o.run.ID = 0
+	}
+	return err
+}
@@ -0,0 +1,172 @@
+package fluxmonitorv2_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	fmmocks "github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/config"
+)
+
+func newSharedPollManager(t *testing.T) *fluxmonitorv2.PollManager {
+	pollManager, err := fluxmonitorv2.NewPollManager(
+		fluxmonitorv2.PollManagerConfig{
+			PollTickerInterval:      100 * time.Millisecond,
+			IdleTimerDisabled:       true,
+			HibernationPollPeriod:   fluxmonitorv2.DefaultHibernationPollPeriod,
+			MinRetryBackoffDuration: 1 * time.Minute,
+			MaxRetryBackoffDuration: 1 * time.Hour,
+		},
+		logger.TestLogger(t),
+	)
+	require.NoError(t, err)
+	return pollManager
+}
+
+func noFlags() *fmmocks.Flags {
+	flags := new(fmmocks.Flags)
+	flags.On("ContractExists").Return(false)
+	return flags
+}
+
+func TestMultiFluxMonitor_SharedPollTickerFansOut(t *testing.T) {
+	t.Parallel()
+
+	db, nodeAddr := setupStoreWithKey(t)
+	oracles := []common.Address{nodeAddr, testutils.NewAddress()}
+
+	var monitors []*fluxmonitorv2.FluxMonitor
+	var polled []chan struct{}
+	for i := 0; i < 2; i++ {
+		fm, tm := setup(t, db, disablePollTicker(true), disableIdleTimer(true))
+		chPolled := make(chan struct{}, 1)
+
+		tm.keyStore.On("SendingKeys").Return([]ethkey.KeyV2{{Address: ethkey.EIP55AddressFromAddress(nodeAddr)}}, nil)
+		tm.fluxAggregator.On("Address").Return(common.Address{})
+		tm.fluxAggregator.On("GetOracles", nilOpts).Return(oracles, nil)
+		tm.fluxAggregator.On("LatestRoundData", nilOpts).Return(freshContractRoundDataResponse()).Maybe()
+		tm.logBroadcaster.On("Register", mock.Anything, mock.Anything).Return(func() {})
+		tm.logBroadcaster.On("IsConnected").Return(true).Maybe()
+
+		// Each poll asks the aggregator for its round state, which here
+		// makes the node ineligible so that nothing is submitted
+		tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+			Return(flux_aggregator_wrapper.OracleRoundState{RoundId: 1, EligibleToSubmit: false, StartedAt: now()}, nil).
+			Run(func(mock.Arguments) {
+				select {
+				case chPolled <- struct{}{}:
+				default:
+				}
+			})
+		tm.orm.On("FindOrCreateFluxMonitorRoundStats", contractAddress, uint32(1), mock.Anything).
+			Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: 1}, nil)
+
+		monitors = append(monitors, fm)
+		polled = append(polled, chPolled)
+	}
+
+	lggr := logger.TestLogger(t)
+	mfm := fluxmonitorv2.NewTestMultiFluxMonitor(newSharedPollManager(t), true, noFlags(), nil, lggr, monitors...)
+	require.NoError(t, mfm.Start())
+	t.Cleanup(func() { require.NoError(t, mfm.Close()) })
+
+	for i, chPolled := range polled {
+		select {
+		case <-chPolled:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected aggregator %d to be polled", i)
+		}
+	}
+}
+
+func TestMultiFluxMonitor_SharesOneObservation(t *testing.T) {
+	t.Parallel()
+
+	db, nodeAddr := setupStoreWithKey(t)
+	oracles := []common.Address{nodeAddr, testutils.NewAddress()}
+	minPayment := config.DefaultMinimumContractPayment.ToInt()
+
+	var monitors []*fluxmonitorv2.FluxMonitor
+	var tms []*testMocks
+	submitted := make(chan struct{}, 2)
+	for i := 0; i < 2; i++ {
+		fm, tm := setup(t, db, disablePollTicker(true), disableIdleTimer(true))
+
+		tm.keyStore.On("SendingKeys").Return([]ethkey.KeyV2{{Address: ethkey.EIP55AddressFromAddress(nodeAddr)}}, nil)
+		tm.fluxAggregator.On("Address").Return(common.Address{})
+		tm.fluxAggregator.On("GetOracles", nilOpts).Return(oracles, nil)
+		tm.fluxAggregator.On("LatestRoundData", nilOpts).Return(freshContractRoundDataResponse()).Maybe()
+		tm.logBroadcaster.On("Register", mock.Anything, mock.Anything).Return(func() {})
+		tm.logBroadcaster.On("IsConnected").Return(true).Maybe()
+
+		tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+			Return(flux_aggregator_wrapper.OracleRoundState{
+				RoundId:          1,
+				EligibleToSubmit: true,
+				LatestSubmission: big.NewInt(0),
+				AvailableFunds:   big.NewInt(1).Mul(big.NewInt(10000), minPayment),
+				PaymentAmount:    minPayment,
+				OracleCount:      2,
+				StartedAt:        now(),
+			}, nil).Once()
+		// Further ticks find the node ineligible
+		tm.fluxAggregator.On("OracleRoundState", nilOpts, nodeAddr, uint32(0)).
+			Return(flux_aggregator_wrapper.OracleRoundState{RoundId: 1, EligibleToSubmit: false, StartedAt: now()}, nil).Maybe()
+		tm.orm.On("FindOrCreateFluxMonitorRoundStats", contractAddress, uint32(1), mock.Anything).
+			Return(fluxmonitorv2.FluxMonitorRoundStatsV2{Aggregator: contractAddress, RoundID: 1}, nil)
+
+		tm.contractSubmitter.On("Submit", big.NewInt(1), big.NewInt(100), mock.Anything).Return(nil).Once()
+		// Both aggregators refer to the same, single run
+		tm.orm.On("UpdateFluxMonitorRoundStats", contractAddress, uint32(1), int64(1), mock.Anything, mock.Anything).
+			Return(nil).Once().
+			Run(func(mock.Arguments) { submitted <- struct{}{} })
+
+		monitors = append(monitors, fm)
+		tms = append(tms, tm)
+	}
+
+	// The observation is made by the runner of the first aggregator, once
+	tms[0].pipelineRunner.On("ExecuteRun", context.Background(), pipelineSpec, mock.Anything, mock.Anything).
+		Return(pipeline.Run{}, pipeline.TaskRunResults{
+			{
+				Result: pipeline.Result{Value: decimal.NewFromInt(100)},
+				Task:   &pipeline.HTTPTask{},
+			},
+		}, nil).Once()
+	tms[0].pipelineRunner.On("InsertFinishedRun", mock.Anything, true, mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			args.Get(0).(*pipeline.Run).ID = 1
+		}).
+		Once()
+
+	lggr := logger.TestLogger(t)
+	mfm := fluxmonitorv2.NewTestMultiFluxMonitor(newSharedPollManager(t), true, noFlags(), nil, lggr, monitors...)
+	require.NoError(t, mfm.Start())
+	t.Cleanup(func() { require.NoError(t, mfm.Close()) })
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-submitted:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected both aggregators to submit")
+		}
+	}
+	for _, tm := range tms {
+		tm.pipelineRunner.AssertExpectations(t)
+		tm.contractSubmitter.AssertExpectations(t)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -57,6 +58,17 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
 
+	if tree.Has("aggregators") {
+		spec.Aggregators, err = parseAggregators(tree)
+		if err != nil {
+			return jb, errors.Wrap(err, "while parsing aggregators")
+		}
+		if spec.ContractAddress != "" {
+			return jb, errors.New("contractAddress must not be set when aggregators are given, set it on each aggregator instead")
+		}
+		spec.ContractAddress = spec.Aggregators[0].ContractAddress
+	}
+
 	// Find the smallest of all the timeouts
 	// and ensure the polling period is greater than that.
 	minTaskTimeout, aTimeoutSet, err := jb.Pipeline.MinTimeout()
@@ -95,6 +107,62 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 	return jb, nil
 }
 
+// parseAggregators reads the [[aggregators]] tables of a multi-aggregator
+// spec. They are read by hand rather than unmarshaled so that, as with the
+// top level thresholds, integer thresholds are accepted.
+func parseAggregators(tree *toml.Tree) (job.FluxMonitorAggregators, error) {
+	trees, ok := tree.Get("aggregators").([]*toml.Tree)
+	if !ok || len(trees) == 0 {
+		return nil, errors.New("aggregators must be a non-empty array of tables")
+	}
+	var aggregators job.FluxMonitorAggregators
+	seen := make(map[ethkey.EIP55Address]struct{})
+	for i, t := range trees {
+		var agg job.FluxMonitorAggregator
+		addr, ok := t.Get("contractAddress").(string)
+		if !ok {
+			return nil, errors.Errorf("aggregator %d: contractAddress is required", i)
+		}
+		a, err := ethkey.NewEIP55Address(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "aggregator %d", i)
+		}
+		if _, exists := seen[a]; exists {
+			return nil, errors.Errorf("aggregator %d: duplicate contractAddress %s", i, a)
+		}
+		seen[a] = struct{}{}
+		agg.ContractAddress = a
+		if agg.Threshold, err = float32FromTree(t, "threshold"); err != nil {
+			return nil, errors.Wrapf(err, "aggregator %d", i)
+		}
+		if agg.AbsoluteThreshold, err = float32FromTree(t, "absoluteThreshold"); err != nil {
+			return nil, errors.Wrapf(err, "aggregator %d", i)
+		}
+		if t.Has("variables") {
+			vars, ok := t.Get("variables").(*toml.Tree)
+			if !ok {
+				return nil, errors.Errorf("aggregator %d: variables must be a table", i)
+			}
+			agg.Variables = vars.ToMap()
+		}
+		aggregators = append(aggregators, agg)
+	}
+	return aggregators, nil
+}
+
+func float32FromTree(t *toml.Tree, key string) (float32, error) {
+	switch v := t.Get(key).(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return float32(v), nil
+	case float64:
+		return float32(v), nil
+	default:
+		return 0, errors.Errorf("%s must be a number, got %T", key, v)
+	}
+}
+
 // validatePollTime validates the period is greater than the min timeout for an
 // enabled poll timer.
 func validatePollTimer(disabled bool, minTimeout time.Duration, period time.Duration) bool {
@@ -206,6 +206,95 @@ externalJobID = "cfa3fa6b-2850-446b-b973-8f4c3b29d519"
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "multiple aggregators",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion     = 1
+name              = "example multi-aggregator flux monitor spec"
+idleTimerPeriod   = "1m"
+pollTimerPeriod   = "1m"
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": $(aggregator.coin)}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+
+[[aggregators]]
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold         = 0.5
+absoluteThreshold = 0.01
+variables         = { coin = "ETH" }
+
+[[aggregators]]
+contractAddress   = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+threshold         = 2
+`,
+			assertion: func(t *testing.T, j job.Job, err error) {
+				require.NoError(t, err)
+				spec := j.FluxMonitorSpec
+				require.NotNil(t, spec)
+				require.Len(t, spec.Aggregators, 2)
+				assert.Equal(t, "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42", spec.ContractAddress.String(), "contract address should default to the first aggregator")
+
+				assert.Equal(t, "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42", spec.Aggregators[0].ContractAddress.String())
+				assert.Equal(t, float32(0.5), spec.Aggregators[0].Threshold)
+				assert.Equal(t, float32(0.01), spec.Aggregators[0].AbsoluteThreshold)
+				assert.Equal(t, map[string]interface{}{"coin": "ETH"}, spec.Aggregators[0].Variables)
+
+				assert.Equal(t, "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36", spec.Aggregators[1].ContractAddress.String())
+				assert.Equal(t, float32(2), spec.Aggregators[1].Threshold)
+				assert.Equal(t, float32(0), spec.Aggregators[1].AbsoluteThreshold)
+				assert.Nil(t, spec.Aggregators[1].Variables)
+			},
+		},
+		{
+			name: "aggregators with top level contract address",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion     = 1
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+idleTimerPeriod   = "1m"
+pollTimerPeriod   = "1m"
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": $(aggregator.coin)}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+
+[[aggregators]]
+contractAddress   = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+threshold         = 0.5
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "contractAddress must not be set when aggregators are given")
+			},
+		},
+		{
+			name: "duplicate aggregators",
+			toml: `
+type              = "fluxmonitor"
+schemaVersion     = 1
+idleTimerPeriod   = "1m"
+pollTimerPeriod   = "1m"
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com" requestData="{\\"coin\\": $(aggregator.coin)}"];
+ds1_parse [type=jsonparse path="latest"];
+ds1 -> ds1_parse;
+"""
+
+[[aggregators]]
+contractAddress   = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+
+[[aggregators]]
+contractAddress   = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "duplicate contractAddress")
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
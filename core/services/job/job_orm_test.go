@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
@@ -528,6 +529,47 @@ func Test_FindJob(t *testing.T) {
 	})
 }
 
+func Test_FindJobIDByAddress_FluxMonitorAggregators(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db, config)
+
+	pipelineORM := pipeline.NewORM(db, logger.TestLogger(t), config)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: config})
+	orm := job.NewTestORM(t, db, cc, pipelineORM, keyStore, config)
+
+	jb, err := fluxmonitorv2.ValidatedFluxMonitorSpec(config, `
+type              = "fluxmonitor"
+schemaVersion     = 1
+idleTimerPeriod   = "1m"
+pollTimerPeriod   = "1m"
+observationSource = """
+ds1 [type=http method=GET url="https://pricesource1.com"];
+"""
+
+[[aggregators]]
+contractAddress   = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+threshold         = 0.5
+
+[[aggregators]]
+contractAddress   = "0x3e4a23dB81D1F1268983f0CE78F1a9dC329A5b36"
+threshold         = 2
+`)
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateJob(&jb))
+
+	for _, aggregator := range jb.FluxMonitorSpec.Aggregators {
+		jbID, err := orm.FindJobIDByAddress(aggregator.ContractAddress)
+		require.NoError(t, err)
+		assert.Equal(t, jb.ID, jbID)
+	}
+
+	_, err = orm.FindJobIDByAddress("0x0000000000000000000000000000000000000001")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
 func Test_FindJobsByPipelineSpecIDs(t *testing.T) {
 	t.Parallel()
 
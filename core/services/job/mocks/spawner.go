@@ -100,6 +100,22 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// OnJobDeleted provides a mock function with given fields: fn
+func (_m *Spawner) OnJobDeleted(fn func(int32)) func() {
+	ret := _m.Called(fn)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(func(int32)) func()); ok {
+		r0 = rf(fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -228,6 +228,7 @@ type OffchainReportingOracleSpec struct {
 	ObservationGracePeriodEnv                 bool
 	ContractTransmitterTransmitTimeout        *models.Interval `toml:"contractTransmitterTransmitTimeout"`
 	ContractTransmitterTransmitTimeoutEnv     bool
+	ShadowObservationSource                   string    `toml:"shadowObservationSource"`
 	CreatedAt                                 time.Time `toml:"-"`
 	UpdatedAt                                 time.Time `toml:"-"`
 }
@@ -264,12 +265,43 @@ func (r *RelayConfig) Scan(value interface{}) error {
 	return json.Unmarshal(b, &r)
 }
 
+// OCR2PluginType identifies the reporting plugin of an OCR2 job.
+type OCR2PluginType string
+
+const (
+	// OCR2Median is the numerical median reporting plugin, which is the
+	// default when no pluginType is given.
+	OCR2Median OCR2PluginType = "median"
+)
+
+// OCR2PluginConfig is reporting plugin specific config of an OCR2 job.
+type OCR2PluginConfig map[string]interface{}
+
+func (c OCR2PluginConfig) Bytes() []byte {
+	b, _ := json.Marshal(c)
+	return b
+}
+
+func (c OCR2PluginConfig) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *OCR2PluginConfig) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, &c)
+}
+
 // Relay config is chain specific config for a relay (chain adapter).
 type OffchainReporting2OracleSpec struct {
 	ID                                int32              `toml:"-"`
 	ContractID                        string             `toml:"contractID"`
 	Relay                             relaytypes.Network `toml:"relay"`
 	RelayConfig                       RelayConfig        `toml:"relayConfig"`
+	PluginType                        OCR2PluginType     `toml:"pluginType"`
+	PluginConfig                      OCR2PluginConfig   `toml:"pluginConfig"`
 	P2PBootstrapPeers                 pq.StringArray     `toml:"p2pBootstrapPeers"`
 	OCRKeyBundleID                    null.String        `toml:"ocrKeyBundleID"`
 	MonitoringEndpoint                null.String        `toml:"monitoringEndpoint"`
@@ -278,6 +310,7 @@ type OffchainReporting2OracleSpec struct {
 	ContractConfigTrackerPollInterval models.Interval    `toml:"contractConfigTrackerPollInterval"`
 	ContractConfigConfirmations       uint16             `toml:"contractConfigConfirmations"`
 	JuelsPerFeeCoinPipeline           string             `toml:"juelsPerFeeCoinSource"`
+	ShadowObservationSource           string             `toml:"shadowObservationSource"`
 	CreatedAt                         time.Time          `toml:"-"`
 	UpdatedAt                         time.Time          `toml:"-"`
 }
@@ -306,8 +339,11 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// RequestSchema is the JSON Schema that run request bodies must conform
+	// to, if any.
+	RequestSchema null.String `json:"requestSchema" toml:"-"`
+	CreatedAt     time.Time   `json:"createdAt" toml:"-"`
+	UpdatedAt     time.Time   `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -323,23 +359,108 @@ func (w *WebhookSpec) SetID(value string) error {
 	return nil
 }
 
+// DirectRequestResponseShape is the shape of the responses of a direct
+// request job, which determines the Operator function fulfilling requests.
+type DirectRequestResponseShape string
+
+const (
+	// DirectRequestResponseUndeclared jobs build their fulfillment freely.
+	DirectRequestResponseUndeclared DirectRequestResponseShape = ""
+	// DirectRequestResponseSingleWord jobs respond with a single bytes32 via
+	// fulfillOracleRequest.
+	DirectRequestResponseSingleWord DirectRequestResponseShape = "singleWord"
+	// DirectRequestResponseMultiWord jobs respond with responseWords 32 byte
+	// words via fulfillOracleRequest2.
+	DirectRequestResponseMultiWord DirectRequestResponseShape = "multiWord"
+	// DirectRequestResponseBytes jobs respond with dynamically sized bytes via
+	// fulfillOracleRequest2.
+	DirectRequestResponseBytes DirectRequestResponseShape = "bytes"
+)
+
 type DirectRequestSpec struct {
-	ID                          int32                    `toml:"-"`
-	ContractAddress             ethkey.EIP55Address      `toml:"contractAddress"`
-	MinIncomingConfirmations    clnull.Uint32            `toml:"minIncomingConfirmations"`
-	MinIncomingConfirmationsEnv bool                     `toml:"minIncomingConfirmationsEnv"`
-	Requesters                  models.AddressCollection `toml:"requesters"`
-	MinContractPayment          *assets.Link             `toml:"minContractPaymentLinkJuels"`
-	EVMChainID                  *utils.Big               `toml:"evmChainID"`
-	CreatedAt                   time.Time                `toml:"-"`
-	UpdatedAt                   time.Time                `toml:"-"`
+	ID                          int32                      `toml:"-"`
+	ContractAddress             ethkey.EIP55Address        `toml:"contractAddress"`
+	MinIncomingConfirmations    clnull.Uint32              `toml:"minIncomingConfirmations"`
+	MinIncomingConfirmationsEnv bool                       `toml:"minIncomingConfirmationsEnv"`
+	Requesters                  models.AddressCollection   `toml:"requesters"`
+	MinContractPayment          *assets.Link               `toml:"minContractPaymentLinkJuels"`
+	MinContractPaymentPerWord   *assets.Link               `toml:"minContractPaymentPerWordLinkJuels"`
+	ResponseShape               DirectRequestResponseShape `toml:"responseShape"`
+	ResponseWords               uint32                     `toml:"responseWords"`
+	CallbackGasLimit            uint32                     `toml:"callbackGasLimit"`
+	// RequesterRules override the minimum payment, and may rate limit or
+	// block, the requests of specific requesters.
+	RequesterRules DirectRequestRequesterRules `toml:"requesterRules"`
+	EVMChainID     *utils.Big                  `toml:"evmChainID"`
+	CreatedAt      time.Time                   `toml:"-"`
+	UpdatedAt      time.Time                   `toml:"-"`
+}
+
+// DirectRequestRequesterRule applies to the requests of a single requester.
+// MaxRequests, if not zero, is the number of requests accepted per Window.
+type DirectRequestRequesterRule struct {
+	Address            common.Address  `toml:"address" json:"address"`
+	MinContractPayment *assets.Link    `toml:"minContractPaymentLinkJuels" json:"minContractPaymentLinkJuels,omitempty"`
+	MaxRequests        uint32          `toml:"maxRequests" json:"maxRequests,omitempty"`
+	Window             models.Interval `toml:"window" json:"window,omitempty"`
+	Blocked            bool            `toml:"blocked" json:"blocked,omitempty"`
+}
+
+type DirectRequestRequesterRules []DirectRequestRequesterRule
+
+func (r DirectRequestRequesterRules) Value() (driver.Value, error) {
+	if len(r) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(r)
+}
+
+func (r *DirectRequestRequesterRules) Scan(value interface{}) error {
+	if value == nil {
+		*r = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, r)
 }
 
+// CronOverlapPolicy decides what happens when a cron job fires while a run
+// started by a previous fire is still in progress.
+type CronOverlapPolicy string
+
+const (
+	// CronOverlapAllow starts overlapping runs.
+	CronOverlapAllow CronOverlapPolicy = "allow"
+	// CronOverlapSkip skips fires while a run is in progress.
+	CronOverlapSkip CronOverlapPolicy = "skip"
+	// CronOverlapQueueOne starts at most one fire late, once the run in
+	// progress completes, and skips any other.
+	CronOverlapQueueOne CronOverlapPolicy = "queueOne"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID           int32  `toml:"-"`
+	CronSchedule string `toml:"schedule"`
+	// Timezone is the IANA time zone of the schedule, as an alternative to a
+	// CRON_TZ prefix.
+	Timezone string `toml:"timezone"`
+	// Jitter is the maximum random delay of each run after its fire.
+	Jitter        models.Interval   `toml:"jitter"`
+	OverlapPolicy CronOverlapPolicy `toml:"overlapPolicy"`
+	CreatedAt     time.Time         `toml:"-"`
+	UpdatedAt     time.Time         `toml:"-"`
+}
+
+// Schedule returns the schedule of the job, prefixed with its timezone if it
+// has one.
+func (s CronSpec) Schedule() string {
+	if s.Timezone == "" {
+		return s.CronSchedule
+	}
+	return fmt.Sprintf("CRON_TZ=%s %s", s.Timezone, s.CronSchedule)
 }
 
 func (s CronSpec) GetID() string {
@@ -392,8 +513,44 @@ type FluxMonitorSpec struct {
 	DrumbeatEnabled     bool
 	MinPayment          *assets.Link
 	EVMChainID          *utils.Big `toml:"evmChainID"`
-	CreatedAt           time.Time  `toml:"-"`
-	UpdatedAt           time.Time  `toml:"-"`
+	// Aggregators, when present, turns the job into a multi-aggregator job
+	// which reports to each aggregator using the same observation pipeline.
+	// ContractAddress is then set to the address of the first aggregator.
+	Aggregators FluxMonitorAggregators `toml:"-"`
+	CreatedAt   time.Time              `toml:"-"`
+	UpdatedAt   time.Time              `toml:"-"`
+}
+
+// FluxMonitorAggregator is a single aggregator reported to by a
+// multi-aggregator FluxMonitor job. Variables are made available to the
+// pipeline as $(aggregator.<name>). Aggregators with the same variables share
+// one pipeline run per poll.
+type FluxMonitorAggregator struct {
+	ContractAddress   ethkey.EIP55Address    `json:"contractAddress"`
+	Threshold         float32                `json:"threshold"`
+	AbsoluteThreshold float32                `json:"absoluteThreshold"`
+	Variables         map[string]interface{} `json:"variables,omitempty"`
+}
+
+type FluxMonitorAggregators []FluxMonitorAggregator
+
+func (a FluxMonitorAggregators) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *FluxMonitorAggregators) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, a)
 }
 
 type KeeperSpec struct {
@@ -402,8 +559,49 @@ type KeeperSpec struct {
 	MinIncomingConfirmations *uint32             `toml:"minIncomingConfirmations"`
 	FromAddress              ethkey.EIP55Address `toml:"fromAddress"`
 	EVMChainID               *utils.Big          `toml:"evmChainID"`
-	CreatedAt                time.Time           `toml:"-"`
-	UpdatedAt                time.Time           `toml:"-"`
+	// MaxGasPrice is the gas price in wei above which upkeeps that do not
+	// belong to a priority tier are skipped. With EIP-1559 enabled, it is
+	// compared against the effective price, which is the base fee plus the
+	// tip, capped at the fee cap.
+	MaxGasPrice *utils.Big `toml:"maxGasPrice"`
+	// PriorityTiers are performed first, in order, each with its own
+	// MaxGasPrice.
+	PriorityTiers KeeperPriorityTiers `toml:"priorityTiers"`
+	// AllowedUpkeepIDs, if not empty, restricts the upkeeps performed by the
+	// job to those listed, and DeniedUpkeepIDs are never performed.
+	AllowedUpkeepIDs pq.Int64Array `toml:"allowedUpkeepIDs" db:"allowed_upkeep_ids"`
+	DeniedUpkeepIDs  pq.Int64Array `toml:"deniedUpkeepIDs" db:"denied_upkeep_ids"`
+	CreatedAt        time.Time     `toml:"-"`
+	UpdatedAt        time.Time     `toml:"-"`
+}
+
+// KeeperPriorityTier is a set of upkeeps which are performed ahead of those
+// of lower tiers, and may tolerate a higher gas price. A nil MaxGasPrice
+// means the upkeeps are performed regardless of the gas price.
+type KeeperPriorityTier struct {
+	UpkeepIDs   []int64    `toml:"upkeepIDs" json:"upkeepIDs"`
+	MaxGasPrice *utils.Big `toml:"maxGasPrice" json:"maxGasPrice,omitempty"`
+}
+
+type KeeperPriorityTiers []KeeperPriorityTier
+
+func (t KeeperPriorityTiers) Value() (driver.Value, error) {
+	if len(t) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *KeeperPriorityTiers) Scan(value interface{}) error {
+	if value == nil {
+		*t = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, t)
 }
 
 type VRFSpec struct {
@@ -418,8 +616,17 @@ type VRFSpec struct {
 	PollPeriodEnv            bool
 	RequestedConfsDelay      int64         `toml:"requestedConfsDelay"` // For v2 jobs. Optional, defaults to 0 if not provided.
 	RequestTimeout           time.Duration `toml:"requestTimeout"`      // For v2 jobs. Optional, defaults to 24hr if not provided.
-	CreatedAt                time.Time     `toml:"-"`
-	UpdatedAt                time.Time     `toml:"-"`
+
+	// BatchFulfillmentEnabled makes v2 jobs fulfill several requests in a
+	// single transaction through the BatchCoordinatorAddress contract.
+	BatchFulfillmentEnabled bool                 `toml:"batchFulfillmentEnabled"`
+	BatchCoordinatorAddress *ethkey.EIP55Address `toml:"batchCoordinatorAddress"`
+	// BatchFulfillmentGasBudget is the maximum gas limit of a batch
+	// fulfillment transaction. Optional, defaults to 2.5M if not provided.
+	BatchFulfillmentGasBudget uint64 `toml:"batchFulfillmentGasBudget"`
+
+	CreatedAt time.Time `toml:"-"`
+	UpdatedAt time.Time `toml:"-"`
 }
 
 // BlockhashStoreSpec defines the job spec for the blockhash store feeder.
@@ -28,6 +28,9 @@ type (
 		CreateJob(jb *Job, qopts ...pg.QOpt) error
 		DeleteJob(jobID int32, qopts ...pg.QOpt) error
 		ActiveJobs() map[int32]Job
+		// OnJobDeleted registers fn to be called with the ID of each job once
+		// it has been deleted. The returned function unregisters it.
+		OnJobDeleted(fn func(jobID int32)) (unregister func())
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
 		// to start a job that was previously manually inserted into DB
@@ -43,6 +46,10 @@ type (
 		q                pg.Q
 		lggr             logger.Logger
 
+		jobDeletedMu     sync.RWMutex
+		jobDeletedHooks  map[int]func(int32)
+		jobDeletedNextID int
+
 		utils.StartStopOnce
 		chStop              chan struct{}
 		lbDependentAwaiters []utils.DependentAwaiter
@@ -80,6 +87,7 @@ func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *
 		activeJobs:          make(map[int32]activeJob),
 		chStop:              make(chan struct{}),
 		lbDependentAwaiters: lbDependentAwaiters,
+		jobDeletedHooks:     make(map[int]func(int32)),
 	}
 	return s
 }
@@ -275,9 +283,32 @@ func (js *spawner) DeleteJob(jobID int32, qopts ...pg.QOpt) error {
 
 	lggr.Infow("Stopped and deleted job")
 
+	js.jobDeleted(jobID)
+
 	return nil
 }
 
+func (js *spawner) OnJobDeleted(fn func(jobID int32)) (unregister func()) {
+	js.jobDeletedMu.Lock()
+	defer js.jobDeletedMu.Unlock()
+	id := js.jobDeletedNextID
+	js.jobDeletedNextID++
+	js.jobDeletedHooks[id] = fn
+	return func() {
+		js.jobDeletedMu.Lock()
+		defer js.jobDeletedMu.Unlock()
+		delete(js.jobDeletedHooks, id)
+	}
+}
+
+func (js *spawner) jobDeleted(jobID int32) {
+	js.jobDeletedMu.RLock()
+	defer js.jobDeletedMu.RUnlock()
+	for _, fn := range js.jobDeletedHooks {
+		fn(jobID)
+	}
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -207,6 +207,9 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		jobSpecIDA := jobA.ID
 		delegateA.jobID = jobSpecIDA
 
+		var deletedJobIDs []int32
+		spawner.OnJobDeleted(func(jobID int32) { deletedJobIDs = append(deletedJobIDs, jobID) })
+
 		spawner.Start()
 		defer spawner.Close()
 
@@ -227,6 +230,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		require.NoError(t, err)
 
 		eventuallyClose.AwaitOrFail(t)
+		assert.Equal(t, []int32{jobSpecIDA}, deletedJobIDs)
 
 		// Wait for the claim lock to be released
 		gomega.NewWithT(t).Eventually(func() bool {
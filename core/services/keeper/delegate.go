@@ -52,6 +52,7 @@ func (Delegate) BeforeJobDeleted(spec job.Job) {}
 func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err error) {
 	// TODO: we need to fill these out manually, find a better fix
 	spec.PipelineSpec.JobName = spec.Name.ValueOrZero()
+	spec.PipelineSpec.ExternalJobID = spec.ExternalJobID
 	spec.PipelineSpec.JobID = spec.ID
 
 	if spec.KeeperSpec == nil {
@@ -98,6 +99,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) (services []job.Service, err er
 	upkeepExecuter := NewUpkeepExecuter(
 		spec,
 		orm,
+		d.jrm,
 		d.pr,
 		chain.Client(),
 		chain.HeadBroadcaster(),
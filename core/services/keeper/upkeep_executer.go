@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strconv"
 	"sync"
@@ -41,6 +42,12 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promUpkeepsSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeeps_skipped",
+		Help: "The number of eligible upkeeps which were not performed, by reason",
+	},
+		[]string{"jobID", "reason"},
+	)
 )
 
 // UpkeepExecuter implements the logic to communicate with KeeperRegistry
@@ -52,18 +59,31 @@ type UpkeepExecuter struct {
 	headBroadcaster httypes.HeadBroadcasterRegistry
 	gasEstimator    gas.Estimator
 	job             job.Job
+	jobORM          job.ORM
 	mailbox         *utils.Mailbox
 	orm             ORM
 	pr              pipeline.Runner
+	policy          upkeepPolicy
 	logger          logger.Logger
 	wgDone          sync.WaitGroup
 	utils.StartStopOnce
+
+	skippedMu sync.Mutex
+	// skipped holds the reasons upkeeps were skipped for since they were last
+	// performed, so that each is only recorded once
+	skipped map[skippedUpkeep]struct{}
+}
+
+type skippedUpkeep struct {
+	upkeepID int64
+	reason   string
 }
 
 // NewUpkeepExecuter is the constructor of UpkeepExecuter
 func NewUpkeepExecuter(
 	job job.Job,
 	orm ORM,
+	jobORM job.ORM,
 	pr pipeline.Runner,
 	ethClient evmclient.Client,
 	headBroadcaster httypes.HeadBroadcaster,
@@ -78,11 +98,14 @@ func NewUpkeepExecuter(
 		headBroadcaster: headBroadcaster,
 		gasEstimator:    gasEstimator,
 		job:             job,
+		jobORM:          jobORM,
 		mailbox:         utils.NewMailbox(1),
 		config:          config,
 		orm:             orm,
 		pr:              pr,
+		policy:          newUpkeepPolicy(job.KeeperSpec),
 		logger:          logger.Named("UpkeepExecuter"),
+		skipped:         make(map[skippedUpkeep]struct{}),
 	}
 }
 
@@ -153,24 +176,35 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 		return
 	}
 
+	upkeeps := activeUpkeeps[:0]
+	for _, reg := range activeUpkeeps {
+		if reason := ex.policy.skipReason(reg.UpkeepID); reason != "" {
+			ex.skip(reg.UpkeepID, reason, ex.logger.With("blockNum", head.Number, "upkeepID", reg.UpkeepID))
+			continue
+		}
+		upkeeps = append(upkeeps, reg)
+	}
+	ex.policy.sortByPriority(upkeeps)
+
 	wg := sync.WaitGroup{}
-	wg.Add(len(activeUpkeeps))
+	wg.Add(len(upkeeps))
 	done := func() {
 		<-ex.executionQueue
 		wg.Done()
 	}
-	for _, reg := range activeUpkeeps {
+	for _, reg := range upkeeps {
 		ex.executionQueue <- struct{}{}
-		go ex.execute(reg, head.Number, done)
+		go ex.execute(reg, head, done)
 	}
 
 	wg.Wait()
 }
 
 // execute triggers the pipeline run
-func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, done func()) {
+func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, head *evmtypes.Head, done func()) {
 	defer done()
 
+	headNumber := head.Number
 	start := time.Now()
 	svcLogger := ex.logger.With("blockNum", headNumber, "upkeepID", upkeep.UpkeepID)
 	svcLogger.Debug("checking upkeep")
@@ -184,13 +218,26 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 	}
 
 	var gasPrice, gasTipCap, gasFeeCap *big.Int
-	if ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
+	ceiling := ex.policy.gasPriceCeiling(upkeep.UpkeepID)
+	if ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() || ceiling != nil {
 		price, fee, err := ex.estimateGasPrice(upkeep)
 		if err != nil {
 			svcLogger.Error(errors.Wrap(err, "estimating gas price"))
 			return
 		}
-		gasPrice, gasTipCap, gasFeeCap = price, fee.TipCap, fee.FeeCap
+		if ceiling != nil {
+			effective := price
+			if ex.config.EvmEIP1559DynamicFees() {
+				effective = effectiveGasPrice(fee, head.BaseFeePerGas)
+			}
+			if effective != nil && effective.Cmp(ceiling) > 0 {
+				ex.skip(upkeep.UpkeepID, SkipReasonGasPriceTooHigh, svcLogger.With("gasPrice", effective, "maxGasPrice", ceiling))
+				return
+			}
+		}
+		if ex.config.KeeperCheckUpkeepGasPriceFeatureEnabled() {
+			gasPrice, gasTipCap, gasFeeCap = price, fee.TipCap, fee.FeeCap
+		}
 	}
 
 	vars := pipeline.NewVarsFrom(map[string]interface{}{
@@ -221,6 +268,7 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 		if err != nil {
 			svcLogger.With("error", err).Errorw("failed to set last run height for upkeep")
 		}
+		ex.forgetSkipped(upkeep.UpkeepID)
 
 		elapsed := time.Since(start)
 		promCheckUpkeepExecutionTime.
@@ -229,6 +277,52 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 	}
 }
 
+// skip counts a skipped upkeep. It is only logged as a warning and recorded as
+// a job error the first time the upkeep is skipped for reason, since upkeeps
+// are skipped on every head until they are performed again.
+func (ex *UpkeepExecuter) skip(upkeepID int64, reason string, lggr logger.Logger) {
+	promUpkeepsSkipped.WithLabelValues(strconv.Itoa(int(ex.job.ID)), reason).Inc()
+
+	ex.skippedMu.Lock()
+	key := skippedUpkeep{upkeepID, reason}
+	_, recorded := ex.skipped[key]
+	ex.skipped[key] = struct{}{}
+	ex.skippedMu.Unlock()
+
+	if recorded {
+		lggr.Debugw("skipping upkeep", "reason", reason)
+		return
+	}
+	lggr.Warnw("skipping upkeep", "reason", reason)
+	ex.jobORM.TryRecordError(ex.job.ID, fmt.Sprintf("skipped upkeep %d: %s", upkeepID, reason))
+}
+
+// forgetSkipped forgets the reasons upkeepID was skipped for once it has been
+// performed, so that it is recorded again if it is skipped later on.
+func (ex *UpkeepExecuter) forgetSkipped(upkeepID int64) {
+	ex.skippedMu.Lock()
+	defer ex.skippedMu.Unlock()
+	for key := range ex.skipped {
+		if key.upkeepID == upkeepID {
+			delete(ex.skipped, key)
+		}
+	}
+}
+
+// effectiveGasPrice returns the price per gas paid with the dynamic fee,
+// which is the fee cap unless the base fee plus tip is lower. The fee cap is
+// returned if the base fee is unknown.
+func effectiveGasPrice(fee gas.DynamicFee, baseFee *utils.Big) *big.Int {
+	if fee.FeeCap == nil || fee.TipCap == nil || baseFee == nil {
+		return fee.FeeCap
+	}
+	price := bigmath.Add(baseFee.ToInt(), fee.TipCap)
+	if price.Cmp(fee.FeeCap) > 0 {
+		return fee.FeeCap
+	}
+	return price
+}
+
 func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice *big.Int, fee gas.DynamicFee, err error) {
 	var performTxData []byte
 	performTxData, err = RegistryABI.Pack(
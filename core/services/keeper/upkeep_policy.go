@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// Reasons for which an eligible upkeep is not performed
+const (
+	SkipReasonDenied          = "denied"
+	SkipReasonNotAllowed      = "not_allowed"
+	SkipReasonGasPriceTooHigh = "gas_price_too_high"
+)
+
+// upkeepPolicy decides which eligible upkeeps of a job are performed, in
+// which order, and at what maximum gas price, as configured on the KeeperSpec.
+type upkeepPolicy struct {
+	allowed map[int64]struct{}
+	denied  map[int64]struct{}
+	// tiers maps upkeep IDs to the index of their priority tier
+	tiers          map[int64]int
+	tierCeilings   []*big.Int
+	defaultCeiling *big.Int
+}
+
+func newUpkeepPolicy(spec *job.KeeperSpec) upkeepPolicy {
+	p := upkeepPolicy{
+		allowed: make(map[int64]struct{}, len(spec.AllowedUpkeepIDs)),
+		denied:  make(map[int64]struct{}, len(spec.DeniedUpkeepIDs)),
+		tiers:   make(map[int64]int),
+	}
+	for _, id := range spec.AllowedUpkeepIDs {
+		p.allowed[id] = struct{}{}
+	}
+	for _, id := range spec.DeniedUpkeepIDs {
+		p.denied[id] = struct{}{}
+	}
+	for i, tier := range spec.PriorityTiers {
+		for _, id := range tier.UpkeepIDs {
+			p.tiers[id] = i
+		}
+		var ceiling *big.Int
+		if tier.MaxGasPrice != nil {
+			ceiling = tier.MaxGasPrice.ToInt()
+		}
+		p.tierCeilings = append(p.tierCeilings, ceiling)
+	}
+	if spec.MaxGasPrice != nil {
+		p.defaultCeiling = spec.MaxGasPrice.ToInt()
+	}
+	return p
+}
+
+// skipReason returns the reason for which upkeepID must not be performed
+// regardless of the gas price, or an empty string if it may be performed.
+func (p upkeepPolicy) skipReason(upkeepID int64) string {
+	if _, denied := p.denied[upkeepID]; denied {
+		return SkipReasonDenied
+	}
+	if len(p.allowed) > 0 {
+		if _, allowed := p.allowed[upkeepID]; !allowed {
+			return SkipReasonNotAllowed
+		}
+	}
+	return ""
+}
+
+// priority returns the tier index of upkeepID, lower being performed first.
+// Upkeeps without a tier come last.
+func (p upkeepPolicy) priority(upkeepID int64) int {
+	if tier, ok := p.tiers[upkeepID]; ok {
+		return tier
+	}
+	return len(p.tierCeilings)
+}
+
+// gasPriceCeiling returns the maximum gas price at which upkeepID is
+// performed, or nil if there is none.
+func (p upkeepPolicy) gasPriceCeiling(upkeepID int64) *big.Int {
+	if tier, ok := p.tiers[upkeepID]; ok {
+		return p.tierCeilings[tier]
+	}
+	return p.defaultCeiling
+}
+
+// sortByPriority stably sorts upkeeps so that higher priority tiers come first.
+func (p upkeepPolicy) sortByPriority(upkeeps []UpkeepRegistration) {
+	sort.SliceStable(upkeeps, func(i, j int) bool {
+		return p.priority(upkeeps[i].UpkeepID) < p.priority(upkeeps[j].UpkeepID)
+	})
+}
+
+// validateUpkeepPolicy checks that the upkeep lists of spec are consistent.
+func validateUpkeepPolicy(spec *job.KeeperSpec) error {
+	if spec.MaxGasPrice != nil && spec.MaxGasPrice.ToInt().Sign() <= 0 {
+		return errors.New("maxGasPrice must be positive")
+	}
+	denied := make(map[int64]struct{}, len(spec.DeniedUpkeepIDs))
+	for _, id := range spec.DeniedUpkeepIDs {
+		denied[id] = struct{}{}
+	}
+	for _, id := range spec.AllowedUpkeepIDs {
+		if _, exists := denied[id]; exists {
+			return errors.Errorf("upkeep %d cannot be both allowed and denied", id)
+		}
+	}
+	tiers := make(map[int64]int)
+	for i, tier := range spec.PriorityTiers {
+		if len(tier.UpkeepIDs) == 0 {
+			return errors.Errorf("priority tier %d has no upkeeps", i)
+		}
+		if tier.MaxGasPrice != nil && tier.MaxGasPrice.ToInt().Sign() <= 0 {
+			return errors.Errorf("maxGasPrice of priority tier %d must be positive", i)
+		}
+		for _, id := range tier.UpkeepIDs {
+			if prev, exists := tiers[id]; exists {
+				return errors.Errorf("upkeep %d belongs to both priority tiers %d and %d", id, prev, i)
+			}
+			tiers[id] = i
+		}
+	}
+	return nil
+}
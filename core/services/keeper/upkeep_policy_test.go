@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	jobmocks "github.com/smartcontractkit/chainlink/core/services/job/mocks"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestUpkeepPolicy(t *testing.T) {
+	t.Parallel()
+
+	spec := &job.KeeperSpec{
+		MaxGasPrice: utils.NewBigI(100),
+		PriorityTiers: job.KeeperPriorityTiers{
+			{UpkeepIDs: []int64{3}},
+			{UpkeepIDs: []int64{2, 4}, MaxGasPrice: utils.NewBigI(500)},
+		},
+		AllowedUpkeepIDs: pq.Int64Array{1, 2, 3, 4},
+		DeniedUpkeepIDs:  pq.Int64Array{5},
+	}
+	p := newUpkeepPolicy(spec)
+
+	t.Run("skipReason", func(t *testing.T) {
+		assert.Equal(t, "", p.skipReason(1))
+		assert.Equal(t, SkipReasonDenied, p.skipReason(5))
+		assert.Equal(t, SkipReasonNotAllowed, p.skipReason(6))
+		assert.Equal(t, "", newUpkeepPolicy(&job.KeeperSpec{}).skipReason(6))
+	})
+
+	t.Run("gasPriceCeiling", func(t *testing.T) {
+		assert.Equal(t, big.NewInt(100), p.gasPriceCeiling(1))
+		assert.Nil(t, p.gasPriceCeiling(3))
+		assert.Equal(t, big.NewInt(500), p.gasPriceCeiling(4))
+		assert.Nil(t, newUpkeepPolicy(&job.KeeperSpec{}).gasPriceCeiling(1))
+	})
+
+	t.Run("sortByPriority", func(t *testing.T) {
+		upkeeps := []UpkeepRegistration{{UpkeepID: 1}, {UpkeepID: 4}, {UpkeepID: 6}, {UpkeepID: 2}, {UpkeepID: 3}}
+		p.sortByPriority(upkeeps)
+		var ids []int64
+		for _, u := range upkeeps {
+			ids = append(ids, u.UpkeepID)
+		}
+		assert.Equal(t, []int64{3, 4, 2, 1, 6}, ids)
+	})
+}
+
+func TestEffectiveGasPrice(t *testing.T) {
+	t.Parallel()
+
+	fee := gas.DynamicFee{FeeCap: big.NewInt(100), TipCap: big.NewInt(10)}
+	assert.Equal(t, big.NewInt(60), effectiveGasPrice(fee, utils.NewBigI(50)))
+	assert.Equal(t, big.NewInt(100), effectiveGasPrice(fee, utils.NewBigI(95)))
+	assert.Equal(t, big.NewInt(100), effectiveGasPrice(fee, nil))
+}
+
+func TestValidatedKeeperSpec_UpkeepPolicy(t *testing.T) {
+	t.Parallel()
+
+	base := testspecs.GenerateKeeperSpec(testspecs.KeeperSpecParams{
+		ContractAddress: "0x9E40733cC9df84636505f4e6Db28DCa0dC5D1bba",
+		FromAddress:     "0xa8037A20989AFcBC51798de9762b351D63ff462e",
+	}).Toml()
+
+	t.Run("valid", func(t *testing.T) {
+		jb, err := ValidatedKeeperSpec(base + `
+maxGasPrice      = 100000000000
+allowedUpkeepIDs = [1, 2, 3]
+deniedUpkeepIDs  = [4]
+
+[[priorityTiers]]
+upkeepIDs   = [1]
+
+[[priorityTiers]]
+upkeepIDs   = [2, 3]
+maxGasPrice = 500000000000
+`)
+		require.NoError(t, err)
+
+		spec := jb.KeeperSpec
+		assert.Equal(t, "100000000000", spec.MaxGasPrice.String())
+		assert.Equal(t, pq.Int64Array{1, 2, 3}, spec.AllowedUpkeepIDs)
+		assert.Equal(t, pq.Int64Array{4}, spec.DeniedUpkeepIDs)
+		require.Len(t, spec.PriorityTiers, 2)
+		assert.Equal(t, []int64{1}, spec.PriorityTiers[0].UpkeepIDs)
+		assert.Nil(t, spec.PriorityTiers[0].MaxGasPrice)
+		assert.Equal(t, []int64{2, 3}, spec.PriorityTiers[1].UpkeepIDs)
+		assert.Equal(t, "500000000000", spec.PriorityTiers[1].MaxGasPrice.String())
+	})
+
+	for _, tt := range []struct {
+		name, toml string
+	}{
+		{"zero maxGasPrice", `maxGasPrice = 0`},
+		{"allowed and denied", `
+allowedUpkeepIDs = [1, 2]
+deniedUpkeepIDs  = [2]`},
+		{"empty tier", `
+[[priorityTiers]]
+maxGasPrice = 1`},
+		{"zero tier maxGasPrice", `
+[[priorityTiers]]
+upkeepIDs   = [1]
+maxGasPrice = 0`},
+		{"upkeep in two tiers", `
+[[priorityTiers]]
+upkeepIDs = [1, 2]
+
+[[priorityTiers]]
+upkeepIDs = [2]`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidatedKeeperSpec(base + tt.toml)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestUpkeepExecuter_skip(t *testing.T) {
+	t.Parallel()
+
+	jobORM := new(jobmocks.ORM)
+	jobORM.Test(t)
+	ex := &UpkeepExecuter{job: job.Job{ID: 1}, jobORM: jobORM, skipped: make(map[skippedUpkeep]struct{})}
+	lggr := logger.TestLogger(t)
+
+	jobORM.On("TryRecordError", int32(1), "skipped upkeep 5: "+SkipReasonDenied).Once()
+	jobORM.On("TryRecordError", int32(1), "skipped upkeep 6: "+SkipReasonNotAllowed).Once()
+	// Skips are recorded once per upkeep and reason
+	ex.skip(5, SkipReasonDenied, lggr)
+	ex.skip(5, SkipReasonDenied, lggr)
+	ex.skip(6, SkipReasonNotAllowed, lggr)
+	ex.skip(6, SkipReasonNotAllowed, lggr)
+	jobORM.AssertExpectations(t)
+
+	// and again once the upkeep has been performed
+	ex.forgetSkipped(5)
+	jobORM.On("TryRecordError", int32(1), "skipped upkeep 5: "+SkipReasonDenied).Once()
+	ex.skip(5, SkipReasonDenied, lggr)
+	ex.skip(6, SkipReasonNotAllowed, lggr)
+	jobORM.AssertExpectations(t)
+}
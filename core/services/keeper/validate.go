@@ -83,5 +83,9 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 		return j, errors.New("invalid observation source provided")
 	}
 
+	if err := validateUpkeepPolicy(&spec); err != nil {
+		return j, err
+	}
+
 	return j, nil
 }
@@ -0,0 +1,232 @@
+package ocrcommon
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// shadowComparisonsKept is the number of most recent comparisons kept per job.
+const shadowComparisonsKept = 100
+
+var (
+	promShadowObservations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "ocr_shadow_observations",
+		Help: "The number of shadow pipeline observations by job and status (compared, shadow_errored or live_errored)",
+	}, []string{"job_id", "status"})
+	promShadowDeviation = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ocr_shadow_observation_deviation_percent",
+		Help:    "The deviation in percent of shadow pipeline observations from the live observations",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2, 5, 10, 25, 50, 100},
+	}, []string{"job_id"})
+)
+
+// ShadowComparison is the outcome of a single observation of a job's shadow
+// pipeline next to its live data source.
+type ShadowComparison struct {
+	Timestamp   time.Time
+	Live        *big.Int
+	Shadow      *big.Int
+	LiveError   string
+	ShadowError string
+	// DeviationPercent is only set if both observations succeeded
+	DeviationPercent *float64
+}
+
+// ShadowStats summarizes the shadow pipeline observations of a job since it
+// was created.
+type ShadowStats struct {
+	JobID                int32
+	Observations         uint64
+	Compared             uint64
+	LiveErrors           uint64
+	ShadowErrors         uint64
+	MeanDeviationPercent float64
+	MaxDeviationPercent  float64
+	// Recent comparisons, newest first
+	Recent []ShadowComparison
+}
+
+type shadowRecorder struct {
+	jobID             int32
+	orm               ShadowORM
+	lggr              logger.Logger
+	promCompared      prometheus.Counter
+	promLiveErrored   prometheus.Counter
+	promShadowErrored prometheus.Counter
+	promDeviation     prometheus.Observer
+}
+
+func newShadowRecorder(jobID int32, orm ShadowORM, lggr logger.Logger) *shadowRecorder {
+	id := fmt.Sprintf("%d", jobID)
+	return &shadowRecorder{
+		jobID:             jobID,
+		orm:               orm,
+		lggr:              lggr,
+		promCompared:      promShadowObservations.WithLabelValues(id, "compared"),
+		promLiveErrored:   promShadowObservations.WithLabelValues(id, "live_errored"),
+		promShadowErrored: promShadowObservations.WithLabelValues(id, "shadow_errored"),
+		promDeviation:     promShadowDeviation.WithLabelValues(id),
+	}
+}
+
+// ForgetShadowMetrics discards the shadow pipeline metrics of jobID. Its
+// persisted stats are deleted along with the job.
+func ForgetShadowMetrics(jobID int32) {
+	id := fmt.Sprintf("%d", jobID)
+	promShadowObservations.DeleteLabelValues(id, "compared")
+	promShadowObservations.DeleteLabelValues(id, "live_errored")
+	promShadowObservations.DeleteLabelValues(id, "shadow_errored")
+	promShadowDeviation.DeleteLabelValues(id)
+}
+
+func (r *shadowRecorder) record(c ShadowComparison) {
+	switch {
+	case c.LiveError != "":
+		r.promLiveErrored.Inc()
+	case c.ShadowError != "":
+		r.promShadowErrored.Inc()
+	default:
+		deviation := deviationPercent(c.Live, c.Shadow)
+		c.DeviationPercent = &deviation
+		r.promCompared.Inc()
+		r.promDeviation.Observe(deviation)
+	}
+	if err := r.orm.RecordShadowComparison(r.jobID, c); err != nil {
+		r.lggr.Errorw("Failed to record shadow observation", "err", err)
+	}
+}
+
+// deviationPercent returns the absolute deviation of shadow from live in
+// percent of live. Any non-zero shadow deviates 100% from a zero live value.
+func deviationPercent(live, shadow *big.Int) float64 {
+	if live.Sign() == 0 {
+		if shadow.Sign() == 0 {
+			return 0
+		}
+		return 100
+	}
+	diff := new(big.Float).SetInt(new(big.Int).Sub(shadow, live))
+	ratio, _ := new(big.Float).Quo(diff.Abs(diff), new(big.Float).SetInt(new(big.Int).Abs(live))).Float64()
+	return ratio * 100
+}
+
+// shadowDataSource runs a candidate pipeline alongside the live data source
+// on every observation and records how far its results deviate. Only the
+// live observation is ever returned, so the shadow results never make it
+// into a report.
+type shadowDataSource struct {
+	shadow   median.DataSource
+	recorder *shadowRecorder
+	lggr     logger.Logger
+}
+
+type shadowResult struct {
+	value *big.Int
+	err   error
+}
+
+func (ds *shadowDataSource) observe(ctx context.Context, live func(context.Context) (*big.Int, error)) (*big.Int, error) {
+	chShadow := make(chan shadowResult, 1)
+	go func() {
+		value, err := ds.shadow.Observe(ctx)
+		chShadow <- shadowResult{value, err}
+	}()
+
+	value, err := live(ctx)
+
+	go func() {
+		shadow := <-chShadow
+		c := ShadowComparison{Timestamp: time.Now(), Live: value, Shadow: shadow.value}
+		if err != nil {
+			c.LiveError = err.Error()
+		}
+		if shadow.err != nil {
+			c.ShadowError = shadow.err.Error()
+			ds.lggr.Debugw("Shadow pipeline observation failed", "err", shadow.err)
+		}
+		ds.recorder.record(c)
+	}()
+	return value, err
+}
+
+type shadowDataSourceV1 struct {
+	shadowDataSource
+	live ocrtypes.DataSource
+}
+
+var _ ocrtypes.DataSource = (*shadowDataSourceV1)(nil)
+
+func (ds *shadowDataSourceV1) Observe(ctx context.Context) (ocrtypes.Observation, error) {
+	return ds.observe(ctx, func(ctx context.Context) (*big.Int, error) {
+		return ds.live.Observe(ctx)
+	})
+}
+
+type shadowDataSourceV2 struct {
+	shadowDataSource
+	live median.DataSource
+}
+
+var _ median.DataSource = (*shadowDataSourceV2)(nil)
+
+func (ds *shadowDataSourceV2) Observe(ctx context.Context) (*big.Int, error) {
+	return ds.observe(ctx, ds.live.Observe)
+}
+
+// NewShadowDataSourceV1 wraps live so that the shadowSource pipeline runs
+// next to it on every observation.
+func NewShadowDataSourceV1(live ocrtypes.DataSource, pr pipeline.Runner, orm ShadowORM, jb job.Job, shadowSource string, lggr logger.Logger) ocrtypes.DataSource {
+	return &shadowDataSourceV1{newShadowDataSource(pr, orm, jb, shadowSource, lggr), live}
+}
+
+// NewShadowDataSourceV2 wraps live so that the shadowSource pipeline runs
+// next to it on every observation.
+func NewShadowDataSourceV2(live median.DataSource, pr pipeline.Runner, orm ShadowORM, jb job.Job, shadowSource string, lggr logger.Logger) median.DataSource {
+	return &shadowDataSourceV2{newShadowDataSource(pr, orm, jb, shadowSource, lggr), live}
+}
+
+func newShadowDataSource(pr pipeline.Runner, orm ShadowORM, jb job.Job, shadowSource string, lggr logger.Logger) shadowDataSource {
+	lggr = lggr.Named("Shadow")
+	spec := pipeline.Spec{
+		ID:              jb.ID,
+		DotDagSource:    shadowSource,
+		MaxTaskDuration: jb.MaxTaskDuration,
+		CreatedAt:       time.Now(),
+		JobID:           jb.ID,
+		JobName:         jb.Name.ValueOrZero() + " (shadow)",
+		ExternalJobID:   jb.ExternalJobID,
+	}
+	return shadowDataSource{
+		shadow:   NewInMemoryDataSource(pr, jb, spec, lggr),
+		recorder: newShadowRecorder(jb.ID, orm, lggr),
+		lggr:     lggr,
+	}
+}
+
+// ValidateShadowObservationSource checks that source is a valid pipeline
+// without side effects, since it runs on every observation without ever
+// being reported.
+func ValidateShadowObservationSource(source string) error {
+	p, err := pipeline.Parse(source)
+	if err != nil {
+		return errors.Wrap(err, "invalid shadowObservationSource pipeline")
+	}
+	for _, task := range p.Tasks {
+		if task.Type() == pipeline.TaskTypeETHTx {
+			return errors.Errorf("shadowObservationSource must not contain %s tasks", pipeline.TaskTypeETHTx)
+		}
+	}
+	return nil
+}
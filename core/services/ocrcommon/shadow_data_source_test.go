@@ -0,0 +1,102 @@
+package ocrcommon_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	ocrcommonmocks "github.com/smartcontractkit/chainlink/core/services/ocrcommon/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func Test_ShadowDataSource(t *testing.T) {
+	const shadowSource = `ds [type=memo value="110"];`
+	jb := job.Job{ID: 42}
+	t.Cleanup(func() { ocrcommon.ForgetShadowMetrics(jb.ID) })
+
+	runner := new(pipelinemocks.Runner)
+	runner.On("ExecuteRun", mock.Anything, mock.MatchedBy(func(spec pipeline.Spec) bool {
+		return spec.DotDagSource == shadowSource
+	}), mock.Anything, mock.Anything).
+		Return(pipeline.Run{}, pipeline.TaskRunResults{
+			{
+				Result: pipeline.Result{Value: "110"},
+				Task:   &pipeline.MemoTask{},
+			},
+		}, nil).Once()
+	runner.On("ExecuteRun", mock.Anything, mock.MatchedBy(func(spec pipeline.Spec) bool {
+		return spec.DotDagSource == shadowSource
+	}), mock.Anything, mock.Anything).
+		Return(pipeline.Run{}, nil, errors.New("bridge down")).Once()
+	runner.On("ExecuteRun", mock.Anything, mock.MatchedBy(func(spec pipeline.Spec) bool {
+		return spec.DotDagSource != shadowSource
+	}), mock.Anything, mock.Anything).
+		Return(pipeline.Run{}, pipeline.TaskRunResults{
+			{
+				Result: pipeline.Result{Value: "100"},
+				Task:   &pipeline.HTTPTask{},
+			},
+		}, nil)
+
+	orm := new(ocrcommonmocks.ShadowORM)
+	chRecorded := make(chan ocrcommon.ShadowComparison, 2)
+	orm.On("RecordShadowComparison", jb.ID, mock.Anything).
+		Run(func(args mock.Arguments) { chRecorded <- args.Get(1).(ocrcommon.ShadowComparison) }).
+		Return(nil).Twice()
+
+	live := ocrcommon.NewInMemoryDataSource(runner, jb, pipeline.Spec{}, logger.TestLogger(t))
+	ds := ocrcommon.NewShadowDataSourceV2(live, runner, orm, jb, shadowSource, logger.TestLogger(t))
+
+	// Only the live observation is returned
+	val, err := ds.Observe(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "100", val.String())
+
+	var c ocrcommon.ShadowComparison
+	select {
+	case c = <-chRecorded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for shadow comparison")
+	}
+	assert.Equal(t, "100", c.Live.String())
+	assert.Equal(t, "110", c.Shadow.String())
+	assert.Empty(t, c.ShadowError)
+	require.NotNil(t, c.DeviationPercent)
+	assert.InDelta(t, 10, *c.DeviationPercent, 1e-9)
+
+	val, err = ds.Observe(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "100", val.String())
+
+	select {
+	case c = <-chRecorded:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for shadow comparison")
+	}
+	assert.Equal(t, "100", c.Live.String())
+	assert.Contains(t, c.ShadowError, "bridge down")
+	assert.Nil(t, c.DeviationPercent)
+
+	runner.AssertExpectations(t)
+	orm.AssertExpectations(t)
+}
+
+func Test_ValidateShadowObservationSource(t *testing.T) {
+	require.NoError(t, ocrcommon.ValidateShadowObservationSource(`ds [type=memo value="1"];`))
+
+	err := ocrcommon.ValidateShadowObservationSource(`->`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid shadowObservationSource pipeline")
+
+	err = ocrcommon.ValidateShadowObservationSource(`tx [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x"];`)
+	require.EqualError(t, err, "shadowObservationSource must not contain ethtx tasks")
+}
@@ -0,0 +1,151 @@
+package ocrcommon
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name ShadowORM --output ./mocks/ --case=underscore
+
+// ShadowORM persists the shadow pipeline stats of OCR jobs, so that they
+// survive restarts. Rows are deleted along with their job.
+type ShadowORM interface {
+	RecordShadowComparison(jobID int32, c ShadowComparison, qopts ...pg.QOpt) error
+	FindShadowStats(jobID int32, qopts ...pg.QOpt) (ShadowStats, error)
+}
+
+type shadowORM struct {
+	q pg.Q
+}
+
+var _ ShadowORM = (*shadowORM)(nil)
+
+// NewShadowORM returns a ShadowORM backed by db.
+func NewShadowORM(db *sqlx.DB, lggr logger.Logger, cfg pg.LogConfig) ShadowORM {
+	return &shadowORM{pg.NewQ(db, lggr.Named("ShadowORM"), cfg)}
+}
+
+// RecordShadowComparison adds c to the stats of jobID and keeps only the
+// most recent comparisons.
+func (o *shadowORM) RecordShadowComparison(jobID int32, c ShadowComparison, qopts ...pg.QOpt) error {
+	var compared, liveErrors, shadowErrors int
+	var deviation float64
+	switch {
+	case c.LiveError != "":
+		liveErrors = 1
+	case c.ShadowError != "":
+		shadowErrors = 1
+	case c.DeviationPercent != nil:
+		compared = 1
+		deviation = *c.DeviationPercent
+	}
+	var live, shadow *utils.Big
+	if c.Live != nil {
+		live = utils.NewBig(c.Live)
+	}
+	if c.Shadow != nil {
+		shadow = utils.NewBig(c.Shadow)
+	}
+	q := o.q.WithOpts(qopts...)
+	err := q.Transaction(func(tx pg.Queryer) error {
+		if _, err := tx.Exec(`
+INSERT INTO ocr_shadow_stats (job_id, observations, compared, live_errors, shadow_errors, deviation_total, max_deviation_percent, updated_at)
+VALUES ($1, 1, $2, $3, $4, $5, $5, $6) ON CONFLICT (job_id) DO UPDATE SET
+	observations = ocr_shadow_stats.observations + 1,
+	compared = ocr_shadow_stats.compared + EXCLUDED.compared,
+	live_errors = ocr_shadow_stats.live_errors + EXCLUDED.live_errors,
+	shadow_errors = ocr_shadow_stats.shadow_errors + EXCLUDED.shadow_errors,
+	deviation_total = ocr_shadow_stats.deviation_total + EXCLUDED.deviation_total,
+	max_deviation_percent = GREATEST(ocr_shadow_stats.max_deviation_percent, EXCLUDED.max_deviation_percent),
+	updated_at = EXCLUDED.updated_at
+`, jobID, compared, liveErrors, shadowErrors, deviation, c.Timestamp); err != nil {
+			return errors.Wrap(err, "failed to upsert shadow stats")
+		}
+		if _, err := tx.Exec(`
+INSERT INTO ocr_shadow_comparisons (job_id, live, shadow, live_error, shadow_error, deviation_percent, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`, jobID, live, shadow, c.LiveError, c.ShadowError, c.DeviationPercent, c.Timestamp); err != nil {
+			return errors.Wrap(err, "failed to insert shadow comparison")
+		}
+		_, err := tx.Exec(`
+DELETE FROM ocr_shadow_comparisons WHERE job_id = $1 AND id < (
+	SELECT id FROM ocr_shadow_comparisons WHERE job_id = $1 ORDER BY id DESC OFFSET $2 LIMIT 1
+)
+`, jobID, shadowComparisonsKept-1)
+		return errors.Wrap(err, "failed to prune shadow comparisons")
+	})
+	return errors.Wrapf(err, "RecordShadowComparison failed for job %d", jobID)
+}
+
+// FindShadowStats returns the shadow pipeline stats of jobID, which are empty
+// if the job has not observed yet.
+func (o *shadowORM) FindShadowStats(jobID int32, qopts ...pg.QOpt) (stats ShadowStats, err error) {
+	q := o.q.WithOpts(qopts...)
+	var row struct {
+		Observations        uint64
+		Compared            uint64
+		LiveErrors          uint64
+		ShadowErrors        uint64
+		DeviationTotal      float64
+		MaxDeviationPercent float64
+	}
+	err = q.Get(&row, `
+SELECT observations, compared, live_errors, shadow_errors, deviation_total, max_deviation_percent
+FROM ocr_shadow_stats WHERE job_id = $1
+`, jobID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ShadowStats{JobID: jobID}, nil
+	} else if err != nil {
+		return stats, errors.Wrapf(err, "failed to find shadow stats for job %d", jobID)
+	}
+	stats = ShadowStats{
+		JobID:               jobID,
+		Observations:        row.Observations,
+		Compared:            row.Compared,
+		LiveErrors:          row.LiveErrors,
+		ShadowErrors:        row.ShadowErrors,
+		MaxDeviationPercent: row.MaxDeviationPercent,
+	}
+	if stats.Compared > 0 {
+		stats.MeanDeviationPercent = row.DeviationTotal / float64(stats.Compared)
+	}
+
+	var comparisons []struct {
+		Live             *utils.Big
+		Shadow           *utils.Big
+		LiveError        string
+		ShadowError      string
+		DeviationPercent *float64
+		CreatedAt        time.Time
+	}
+	err = q.Select(&comparisons, `
+SELECT live, shadow, live_error, shadow_error, deviation_percent, created_at
+FROM ocr_shadow_comparisons WHERE job_id = $1 ORDER BY id DESC LIMIT $2
+`, jobID, shadowComparisonsKept)
+	if err != nil {
+		return stats, errors.Wrapf(err, "failed to find shadow comparisons for job %d", jobID)
+	}
+	stats.Recent = make([]ShadowComparison, len(comparisons))
+	for i, c := range comparisons {
+		stats.Recent[i] = ShadowComparison{
+			Timestamp:        c.CreatedAt,
+			LiveError:        c.LiveError,
+			ShadowError:      c.ShadowError,
+			DeviationPercent: c.DeviationPercent,
+		}
+		if c.Live != nil {
+			stats.Recent[i].Live = c.Live.ToInt()
+		}
+		if c.Shadow != nil {
+			stats.Recent[i].Shadow = c.Shadow.ToInt()
+		}
+	}
+	return stats, nil
+}
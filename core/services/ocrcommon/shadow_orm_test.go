@@ -0,0 +1,87 @@
+package ocrcommon_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+)
+
+func Test_ShadowORM(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	orm := ocrcommon.NewShadowORM(db, logger.TestLogger(t), pgtest.NewPGCfg(true))
+	jb := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+
+	t.Run("returns empty stats for a job which has not observed", func(t *testing.T) {
+		stats, err := orm.FindShadowStats(jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, ocrcommon.ShadowStats{JobID: jb.ID}, stats)
+	})
+
+	t.Run("records comparisons", func(t *testing.T) {
+		deviation10, deviation20 := 10.0, 20.0
+		require.NoError(t, orm.RecordShadowComparison(jb.ID, ocrcommon.ShadowComparison{
+			Timestamp: time.Now(), Live: big.NewInt(100), Shadow: big.NewInt(110), DeviationPercent: &deviation10,
+		}))
+		require.NoError(t, orm.RecordShadowComparison(jb.ID, ocrcommon.ShadowComparison{
+			Timestamp: time.Now(), Live: big.NewInt(100), Shadow: big.NewInt(80), DeviationPercent: &deviation20,
+		}))
+		require.NoError(t, orm.RecordShadowComparison(jb.ID, ocrcommon.ShadowComparison{
+			Timestamp: time.Now(), Live: big.NewInt(100), ShadowError: "bridge down",
+		}))
+		require.NoError(t, orm.RecordShadowComparison(jb.ID, ocrcommon.ShadowComparison{
+			Timestamp: time.Now(), LiveError: "no data",
+		}))
+
+		stats, err := orm.FindShadowStats(jb.ID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), stats.Observations)
+		assert.Equal(t, uint64(2), stats.Compared)
+		assert.Equal(t, uint64(1), stats.ShadowErrors)
+		assert.Equal(t, uint64(1), stats.LiveErrors)
+		assert.InDelta(t, 15, stats.MeanDeviationPercent, 1e-9)
+		assert.InDelta(t, 20, stats.MaxDeviationPercent, 1e-9)
+
+		require.Len(t, stats.Recent, 4)
+		assert.Equal(t, "no data", stats.Recent[0].LiveError)
+		assert.Nil(t, stats.Recent[0].Live)
+		assert.Equal(t, "bridge down", stats.Recent[1].ShadowError)
+		assert.Nil(t, stats.Recent[1].Shadow)
+		assert.Equal(t, "80", stats.Recent[2].Shadow.String())
+		require.NotNil(t, stats.Recent[3].DeviationPercent)
+		assert.InDelta(t, 10, *stats.Recent[3].DeviationPercent, 1e-9)
+	})
+
+	t.Run("keeps only the most recent comparisons", func(t *testing.T) {
+		other := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+		for i := 0; i < 105; i++ {
+			require.NoError(t, orm.RecordShadowComparison(other.ID, ocrcommon.ShadowComparison{
+				Timestamp: time.Now(), Live: big.NewInt(int64(i)), ShadowError: "bridge down",
+			}))
+		}
+
+		stats, err := orm.FindShadowStats(other.ID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(105), stats.Observations)
+		require.Len(t, stats.Recent, 100)
+		assert.Equal(t, "104", stats.Recent[0].Live.String())
+		assert.Equal(t, "5", stats.Recent[99].Live.String())
+
+		var count int
+		require.NoError(t, db.Get(&count, `SELECT count(*) FROM ocr_shadow_comparisons WHERE job_id = $1`, other.ID))
+		assert.Equal(t, 100, count)
+
+		// Other jobs are unaffected
+		stats, err = orm.FindShadowStats(jb.ID)
+		require.NoError(t, err)
+		assert.Len(t, stats.Recent, 4)
+	})
+}
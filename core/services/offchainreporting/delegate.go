@@ -67,8 +67,10 @@ func (d Delegate) JobType() job.Type {
 	return job.OffchainReporting
 }
 
-func (Delegate) AfterJobCreated(spec job.Job)  {}
-func (Delegate) BeforeJobDeleted(spec job.Job) {}
+func (Delegate) AfterJobCreated(spec job.Job) {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {
+	ocrcommon.ForgetShadowMetrics(spec.ID)
+}
 
 // ServicesForSpec returns the OCR services that need to run for this job
 func (d Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err error) {
@@ -197,6 +199,7 @@ func (d Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err error
 
 		runResults := make(chan pipeline.Run, chain.Config().JobPipelineResultWriteQueueDepth())
 		jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+		jb.PipelineSpec.ExternalJobID = jb.ExternalJobID
 		jb.PipelineSpec.JobID = jb.ID
 
 		var configOverrider ocrtypes.ConfigOverrider
@@ -218,15 +221,20 @@ func (d Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err error
 			configOverrider = configOverriderService
 		}
 
+		dataSource := ocrcommon.NewDataSourceV1(
+			d.pipelineRunner,
+			jb,
+			*jb.PipelineSpec,
+			lggr,
+			runResults,
+		)
+		if concreteSpec.ShadowObservationSource != "" {
+			dataSource = ocrcommon.NewShadowDataSourceV1(dataSource, d.pipelineRunner, ocrcommon.NewShadowORM(d.db, lggr, chain.Config()), jb, concreteSpec.ShadowObservationSource, lggr)
+		}
+
 		oracle, err := ocr.NewOracle(ocr.OracleArgs{
-			Database: ocrDB,
-			Datasource: ocrcommon.NewDataSourceV1(
-				d.pipelineRunner,
-				jb,
-				*jb.PipelineSpec,
-				lggr,
-				runResults,
-			),
+			Database:                     ocrDB,
+			Datasource:                   dataSource,
 			LocalConfig:                  lc,
 			ContractTransmitter:          contractTransmitter,
 			ContractConfigTracker:        tracker,
@@ -137,5 +137,8 @@ func validateNonBootstrapSpec(tree *toml.Tree, config ValidationConfig, spec job
 			return errors.Errorf("individual max task duration must be < observation timeout")
 		}
 	}
+	if spec.OffchainreportingOracleSpec.ShadowObservationSource != "" {
+		return ocrcommon.ValidateShadowObservationSource(spec.OffchainreportingOracleSpec.ShadowObservationSource)
+	}
 	return nil
 }
@@ -154,6 +154,44 @@ observationSource = """
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "shadow observation source",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer    = false
+observationSource = """
+ds1 [type=bridge name=voter_turnout];
+"""
+shadowObservationSource = """
+ds1 [type=bridge name=voter_turnout_v2];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, "ds1 [type=bridge name=voter_turnout_v2];\n", os.OffchainreportingOracleSpec.ShadowObservationSource)
+			},
+		},
+		{
+			name: "shadow observation source with ethtx task",
+			toml: `
+type               = "offchainreporting"
+schemaVersion      = 1
+contractAddress    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer    = false
+observationSource = """
+ds1 [type=bridge name=voter_turnout];
+"""
+shadowObservationSource = """
+tx [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="0x"];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "shadowObservationSource must not contain ethtx tasks")
+			},
+		},
 		{
 			name: "invalid peer address",
 			toml: `
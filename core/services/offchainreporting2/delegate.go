@@ -1,11 +1,8 @@
 package offchainreporting2
 
 import (
-	"time"
-
 	"github.com/pkg/errors"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2"
-	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	"github.com/smartcontractkit/sqlx"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -67,14 +64,20 @@ func (d Delegate) JobType() job.Type {
 func (Delegate) OnJobCreated(spec job.Job) {}
 func (Delegate) OnJobDeleted(spec job.Job) {}
 
-func (Delegate) AfterJobCreated(spec job.Job)  {}
-func (Delegate) BeforeJobDeleted(spec job.Job) {}
+func (Delegate) AfterJobCreated(spec job.Job) {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {
+	ocrcommon.ForgetShadowMetrics(spec.ID)
+}
 
 func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err error) {
 	spec := jobSpec.Offchainreporting2OracleSpec
 	if spec == nil {
 		return nil, errors.Errorf("offchainreporting.Delegate expects an *job.Offchainreporting2OracleSpec to be present, got %v", jobSpec)
 	}
+	plugin, err := GetReportingPlugin(spec.PluginType)
+	if err != nil {
+		return nil, err
+	}
 
 	ocr2Provider, err := d.relayer.NewOCR2Provider(jobSpec.ExternalJobID, &relay.OCR2ProviderArgs{
 		ID:              spec.ID,
@@ -145,24 +148,20 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 	// run it uses them to create identifiable prometheus metrics.
 	// TODO SC-30421 Move pipeline population to job spawner
 	jobSpec.PipelineSpec.JobName = jobSpec.Name.ValueOrZero()
+	jobSpec.PipelineSpec.ExternalJobID = jobSpec.ExternalJobID
 	jobSpec.PipelineSpec.JobID = jobSpec.ID
 
-	juelsPerFeeCoinPipelineSpec := pipeline.Spec{
-		ID:           jobSpec.ID,
-		DotDagSource: spec.JuelsPerFeeCoinPipeline,
-		CreatedAt:    time.Now(),
-	}
-	numericalMedianFactory := median.NumericalMedianFactory{
-		ContractTransmitter: ocr2Provider.MedianContract(),
-		DataSource: ocrcommon.NewDataSourceV2(d.pipelineRunner,
-			jobSpec,
-			*jobSpec.PipelineSpec,
-			loggerWith,
-			runResults,
-		),
-		JuelsPerFeeCoinDataSource: ocrcommon.NewInMemoryDataSource(d.pipelineRunner, jobSpec, juelsPerFeeCoinPipelineSpec, loggerWith),
-		ReportCodec:               ocr2Provider.ReportCodec(),
-		Logger:                    ocrLogger,
+	reportingPluginFactory, err := plugin.NewReportingPluginFactory(ReportingPluginArgs{
+		Job:            jobSpec,
+		Provider:       ocr2Provider,
+		PipelineRunner: d.pipelineRunner,
+		ShadowORM:      ocrcommon.NewShadowORM(d.db, loggerWith, d.cfg),
+		RunResults:     runResults,
+		Logger:         loggerWith,
+		OCRLogger:      ocrLogger,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating %s reporting plugin", spec.PluginType)
 	}
 
 	oracle, err := libocr2.NewOracle(libocr2.OracleArgs{
@@ -177,7 +176,7 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 		OffchainConfigDigester:       offchainConfigDigester,
 		OffchainKeyring:              kb,
 		OnchainKeyring:               kb,
-		ReportingPluginFactory:       numericalMedianFactory,
+		ReportingPluginFactory:       reportingPluginFactory,
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "error calling NewOracle")
@@ -0,0 +1,71 @@
+package offchainreporting2
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+func init() {
+	RegisterReportingPlugin(job.OCR2Median, medianPlugin{})
+}
+
+// medianPlugin reports the median of the observation pipeline results
+// along with the juels per fee coin ratio.
+type medianPlugin struct{}
+
+var _ ReportingPlugin = medianPlugin{}
+
+func (medianPlugin) Params() []string {
+	return []string{"juelsPerFeeCoinSource"}
+}
+
+func (medianPlugin) ValidateSpec(jb job.Job) error {
+	spec := jb.Offchainreporting2OracleSpec
+	if len(spec.PluginConfig) > 0 {
+		return errors.New("the median plugin does not take a pluginConfig")
+	}
+	// validate that the JuelsPerFeeCoinPipeline is valid (not checked later because it's not a normal pipeline)
+	if _, err := pipeline.Parse(spec.JuelsPerFeeCoinPipeline); err != nil {
+		return errors.Wrap(err, "invalid juelsPerFeeCoinSource pipeline")
+	}
+	if spec.ShadowObservationSource != "" {
+		return ocrcommon.ValidateShadowObservationSource(spec.ShadowObservationSource)
+	}
+	return nil
+}
+
+func (medianPlugin) NewReportingPluginFactory(args ReportingPluginArgs) (ocr2types.ReportingPluginFactory, error) {
+	provider, ok := args.Provider.(types.MedianProvider)
+	if !ok {
+		return nil, errors.Errorf("relay %s does not support the median plugin", args.Job.Offchainreporting2OracleSpec.Relay)
+	}
+	juelsPerFeeCoinPipelineSpec := pipeline.Spec{
+		ID:           args.Job.ID,
+		DotDagSource: args.Job.Offchainreporting2OracleSpec.JuelsPerFeeCoinPipeline,
+		CreatedAt:    time.Now(),
+	}
+	dataSource := ocrcommon.NewDataSourceV2(args.PipelineRunner,
+		args.Job,
+		*args.Job.PipelineSpec,
+		args.Logger,
+		args.RunResults,
+	)
+	if shadowSource := args.Job.Offchainreporting2OracleSpec.ShadowObservationSource; shadowSource != "" {
+		dataSource = ocrcommon.NewShadowDataSourceV2(dataSource, args.PipelineRunner, args.ShadowORM, args.Job, shadowSource, args.Logger)
+	}
+	return median.NumericalMedianFactory{
+		ContractTransmitter:       provider.MedianContract(),
+		DataSource:                dataSource,
+		JuelsPerFeeCoinDataSource: ocrcommon.NewInMemoryDataSource(args.PipelineRunner, args.Job, juelsPerFeeCoinPipelineSpec, args.Logger),
+		ReportCodec:               provider.ReportCodec(),
+		Logger:                    args.OCRLogger,
+	}, nil
+}
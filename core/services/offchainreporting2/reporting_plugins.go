@@ -0,0 +1,83 @@
+package offchainreporting2
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/libocr/commontypes"
+	ocr2types "github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+// ReportingPluginArgs are the dependencies available to a reporting plugin
+// when it is instantiated for a job.
+type ReportingPluginArgs struct {
+	Job            job.Job
+	Provider       types.OCR2Provider
+	PipelineRunner pipeline.Runner
+	// ShadowORM persists the stats of the job's shadow pipeline, if any
+	ShadowORM ocrcommon.ShadowORM
+	// RunResults receives the runs of the job's observation pipeline to be saved
+	RunResults chan pipeline.Run
+	Logger     logger.Logger
+	OCRLogger  commontypes.Logger
+}
+
+// ReportingPlugin is a kind of OCR2 reporting plugin which jobs can select
+// with pluginType.
+type ReportingPlugin interface {
+	// Params returns the keys which must be explicitly set in job specs of
+	// this plugin, besides the ones common to all OCR2 jobs.
+	Params() []string
+	// ValidateSpec validates the plugin specific parts of jb, including its
+	// pluginConfig.
+	ValidateSpec(jb job.Job) error
+	// NewReportingPluginFactory returns the factory passed to the oracle.
+	NewReportingPluginFactory(args ReportingPluginArgs) (ocr2types.ReportingPluginFactory, error)
+}
+
+var (
+	reportingPluginsMu sync.RWMutex
+	reportingPlugins   = make(map[job.OCR2PluginType]ReportingPlugin)
+)
+
+// RegisterReportingPlugin makes a reporting plugin available under
+// pluginType. It panics if pluginType is already registered.
+func RegisterReportingPlugin(pluginType job.OCR2PluginType, plugin ReportingPlugin) {
+	reportingPluginsMu.Lock()
+	defer reportingPluginsMu.Unlock()
+	if _, exists := reportingPlugins[pluginType]; exists {
+		panic("offchainreporting2: reporting plugin " + string(pluginType) + " registered twice")
+	}
+	reportingPlugins[pluginType] = plugin
+}
+
+// GetReportingPlugin returns the reporting plugin registered under
+// pluginType, defaulting to the median plugin.
+func GetReportingPlugin(pluginType job.OCR2PluginType) (ReportingPlugin, error) {
+	if pluginType == "" {
+		pluginType = job.OCR2Median
+	}
+	reportingPluginsMu.RLock()
+	defer reportingPluginsMu.RUnlock()
+	plugin, exists := reportingPlugins[pluginType]
+	if !exists {
+		return nil, errors.Errorf("no such reporting plugin %q, must be one of %v", pluginType, reportingPluginTypes())
+	}
+	return plugin, nil
+}
+
+func reportingPluginTypes() []string {
+	var pluginTypes []string
+	for pluginType := range reportingPlugins {
+		pluginTypes = append(pluginTypes, string(pluginType))
+	}
+	sort.Strings(pluginTypes)
+	return pluginTypes
+}
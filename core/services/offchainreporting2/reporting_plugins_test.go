@@ -0,0 +1,29 @@
+package offchainreporting2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestGetReportingPlugin(t *testing.T) {
+	plugin, err := GetReportingPlugin("")
+	require.NoError(t, err)
+	assert.Equal(t, medianPlugin{}, plugin)
+
+	plugin, err = GetReportingPlugin(job.OCR2Median)
+	require.NoError(t, err)
+	assert.Equal(t, medianPlugin{}, plugin)
+
+	_, err = GetReportingPlugin("blerg")
+	require.EqualError(t, err, `no such reporting plugin "blerg", must be one of [median]`)
+}
+
+func TestRegisterReportingPlugin(t *testing.T) {
+	assert.PanicsWithValue(t, "offchainreporting2: reporting plugin median registered twice", func() {
+		RegisterReportingPlugin(job.OCR2Median, medianPlugin{})
+	})
+}
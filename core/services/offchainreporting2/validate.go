@@ -7,7 +7,6 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
-	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/relay"
 	libocr2 "github.com/smartcontractkit/libocr/offchainreporting2"
 )
@@ -35,6 +34,13 @@ func ValidatedOracleSpecToml(config Config, tomlString string) (job.Job, error)
 		// Empty but non-null, field is non-nullable.
 		jb.Offchainreporting2OracleSpec.P2PBootstrapPeers = pq.StringArray{}
 	}
+	if jb.Offchainreporting2OracleSpec.PluginType == "" {
+		jb.Offchainreporting2OracleSpec.PluginType = job.OCR2Median
+	}
+	if jb.Offchainreporting2OracleSpec.PluginConfig == nil {
+		// Empty but non-null, field is non-nullable.
+		jb.Offchainreporting2OracleSpec.PluginConfig = job.OCR2PluginConfig{}
+	}
 
 	if jb.Type != job.OffchainReporting2 {
 		return jb, errors.Errorf("the only supported type is currently 'offchainreporting2', got %s", jb.Type)
@@ -62,13 +68,12 @@ func ValidatedOracleSpecToml(config Config, tomlString string) (job.Job, error)
 var (
 	// Common to both bootstrap and non-boostrap
 	params = map[string]struct{}{
-		"type":                  {},
-		"schemaVersion":         {},
-		"contractID":            {},
-		"relay":                 {},
-		"relayConfig":           {},
-		"observationSource":     {},
-		"juelsPerFeeCoinSource": {},
+		"type":              {},
+		"schemaVersion":     {},
+		"contractID":        {},
+		"relay":             {},
+		"relayConfig":       {},
+		"observationSource": {},
 	}
 	notExpectedParams = map[string]struct{}{
 		"isBootstrapPeer": {},
@@ -81,17 +86,19 @@ func validateTimingParameters(config Config, spec job.OffchainReporting2OracleSp
 }
 
 func validateSpec(tree *toml.Tree, spec job.Job) error {
+	plugin, err := GetReportingPlugin(spec.Offchainreporting2OracleSpec.PluginType)
+	if err != nil {
+		return err
+	}
 	expected, notExpected := ocrcommon.CloneSet(params), ocrcommon.CloneSet(notExpectedParams)
+	for _, param := range plugin.Params() {
+		expected[param] = struct{}{}
+	}
 	if err := ocrcommon.ValidateExplicitlySetKeys(tree, expected, notExpected, "ocr2"); err != nil {
 		return err
 	}
 	if spec.Pipeline.Source == "" {
 		return errors.New("no pipeline specified")
 	}
-	// validate that the JuelsPerFeeCoinPipeline is valid (not checked later because it's not a normal pipeline)
-	if _, err := pipeline.Parse(spec.Offchainreporting2OracleSpec.JuelsPerFeeCoinPipeline); err != nil {
-		return errors.Wrap(err, "invalid juelsPerFeeCoinSource pipeline")
-	}
-
-	return nil
+	return plugin.ValidateSpec(spec)
 }
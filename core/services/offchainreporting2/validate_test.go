@@ -55,6 +55,8 @@ chainID = 1337
 				var r job.OffchainReporting2OracleSpec
 				err = jsonapi.Unmarshal(b, &r)
 				require.NoError(t, err)
+				assert.Equal(t, job.OCR2Median, os.Offchainreporting2OracleSpec.PluginType)
+				assert.Equal(t, job.OCR2PluginConfig{}, os.Offchainreporting2OracleSpec.PluginConfig)
 			},
 		},
 		{
@@ -278,6 +280,70 @@ chainID = 1337
 				require.Contains(t, err.Error(), "invalid juelsPerFeeCoinSource pipeline")
 			},
 		},
+		{
+			name: "unknown plugin type",
+			toml: `
+type               = "offchainreporting2"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+pluginType         = "blerg"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "no such reporting plugin \"blerg\", must be one of [median]")
+			},
+		},
+		{
+			name: "median plugin with pluginConfig",
+			toml: `
+type               = "offchainreporting2"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+pluginType         = "median"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+juelsPerFeeCoinSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+[pluginConfig]
+foo = "bar"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "the median plugin does not take a pluginConfig")
+			},
+		},
+		{
+			name: "median plugin without juelsPerFeeCoinSource",
+			toml: `
+type               = "offchainreporting2"
+schemaVersion      = 1
+relay              = "evm"
+contractID         = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+"""
+[relayConfig]
+chainID = 1337
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "missing required key juelsPerFeeCoinSource")
+			},
+		},
 		{
 			name: "invalid relay",
 			toml: `
@@ -1,7 +1,17 @@
 package pg
 
-// Postgres channel to listen for new eth_txes
+// Postgres channels to listen for changes to the database
 const (
+	// ChannelInsertOnEthTx is notified with the hex from address of new eth_txes
 	ChannelInsertOnEthTx    = "insert_on_eth_txes"
 	ChannelInsertOnTerraMsg = "insert_on_terra_msg"
+
+	// ChannelInsertOnPipelineRun is notified with the ID of new pipeline_runs
+	ChannelInsertOnPipelineRun = "insert_on_pipeline_runs"
+	// ChannelEthTxStateChange is notified with the ID of eth_txes that are
+	// inserted or change state
+	ChannelEthTxStateChange = "eth_tx_state_change"
+	// ChannelUpsertOnJobSpecError is notified with the ID of job_spec_errors
+	// that are recorded or occur again
+	ChannelUpsertOnJobSpecError = "upsert_on_job_spec_errors"
 )
@@ -36,7 +36,8 @@ func NewLockedDB(cfg config.GeneralConfig, lggr logger.Logger) LockedDB {
 
 // OpenUnlockedDB just opens DB connection, without any DB locks.
 // This should be used carefully, when we know we don't need any locks.
-// Currently this is used by RebroadcastTransactions command only.
+// Currently this is used by the RebroadcastTransactions and RelayPlugin
+// commands only.
 func OpenUnlockedDB(cfg config.GeneralConfig, lggr logger.Logger) (db *sqlx.DB, err error) {
 	return openDB(cfg, lggr)
 }
@@ -0,0 +1,150 @@
+package pipeline
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// ArchivedRun is a finished run, along with its task runs and pipeline spec,
+// as exported by the reaper before the run is deleted. Archives hold one
+// ArchivedRun per line, as gzipped NDJSON.
+type ArchivedRun struct {
+	ID              int64            `json:"id"`
+	JobID           null.Int         `json:"jobID"`
+	JobName         null.String      `json:"jobName"`
+	JobType         null.String      `json:"jobType"`
+	PipelineSpecID  int32            `json:"pipelineSpecID"`
+	DotDagSource    string           `json:"dotDagSource"`
+	MaxTaskDuration models.Interval  `json:"maxTaskDuration"`
+	Meta            JSONSerializable `json:"meta"`
+	AllErrors       RunErrors        `json:"allErrors"`
+	FatalErrors     RunErrors        `json:"fatalErrors"`
+	Inputs          JSONSerializable `json:"inputs"`
+	Outputs         JSONSerializable `json:"outputs"`
+	State           RunStatus        `json:"state"`
+	CreatedAt       time.Time        `json:"createdAt"`
+	FinishedAt      null.Time        `json:"finishedAt"`
+	TaskRuns        []TaskRun        `json:"taskRuns"`
+}
+
+// archivedJob is the job, if any, which a reaped run belongs to
+type archivedJob struct {
+	PipelineSpecID int32
+	ID             int64
+	Name           null.String
+	Type           string
+}
+
+func newArchivedRun(run Run, job *archivedJob) ArchivedRun {
+	ar := ArchivedRun{
+		ID:              run.ID,
+		PipelineSpecID:  run.PipelineSpecID,
+		DotDagSource:    run.PipelineSpec.DotDagSource,
+		MaxTaskDuration: run.PipelineSpec.MaxTaskDuration,
+		Meta:            run.Meta,
+		AllErrors:       run.AllErrors,
+		FatalErrors:     run.FatalErrors,
+		Inputs:          run.Inputs,
+		Outputs:         run.Outputs,
+		State:           run.State,
+		CreatedAt:       run.CreatedAt,
+		FinishedAt:      run.FinishedAt,
+		TaskRuns:        run.PipelineTaskRuns,
+	}
+	if job != nil {
+		ar.JobID = null.IntFrom(job.ID)
+		ar.JobName = job.Name
+		ar.JobType = null.StringFrom(job.Type)
+	}
+	return ar
+}
+
+// ArchiveWriter writes runs to an archive file.
+type ArchiveWriter struct {
+	path string
+	file *os.File
+	gz   *gzip.Writer
+	enc  *json.Encoder
+	n    int
+}
+
+// CreateArchive creates a new archive file in dir, named after the given time.
+func CreateArchive(dir string, now time.Time) (*ArchiveWriter, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrap(err, "failed to create archive directory")
+	}
+	path := filepath.Join(dir, fmt.Sprintf("pipeline_runs_%s.ndjson.gz", now.UTC().Format("20060102T150405.000Z")))
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create archive")
+	}
+	gz := gzip.NewWriter(file)
+	return &ArchiveWriter{path: path, file: file, gz: gz, enc: json.NewEncoder(gz)}, nil
+}
+
+// Path returns the path of the archive file.
+func (w *ArchiveWriter) Path() string {
+	return w.path
+}
+
+// Count returns the number of runs written so far.
+func (w *ArchiveWriter) Count() int {
+	return w.n
+}
+
+// Write appends runs to the archive, and flushes them to disk so that they
+// are safely archived before they are deleted.
+func (w *ArchiveWriter) Write(runs []ArchivedRun) error {
+	for _, run := range runs {
+		if err := w.enc.Encode(run); err != nil {
+			return errors.Wrapf(err, "failed to archive run %d", run.ID)
+		}
+	}
+	if err := w.gz.Flush(); err != nil {
+		return errors.Wrap(err, "failed to flush archive")
+	}
+	if err := w.file.Sync(); err != nil {
+		return errors.Wrap(err, "failed to sync archive")
+	}
+	w.n += len(runs)
+	return nil
+}
+
+// Close finishes the archive.
+func (w *ArchiveWriter) Close() error {
+	return multierr.Combine(w.gz.Close(), w.file.Close())
+}
+
+// ReadArchive calls fn with each of the runs of a gzipped NDJSON archive.
+func ReadArchive(r io.Reader, fn func(ArchivedRun) error) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return errors.Wrap(err, "archive is not gzipped")
+	}
+	defer gz.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(gz))
+	for line := 1; ; line++ {
+		var run ArchivedRun
+		if err = dec.Decode(&run); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return errors.Wrapf(err, "invalid run on line %d of archive", line)
+		}
+		if err = fn(run); err != nil {
+			return err
+		}
+	}
+}
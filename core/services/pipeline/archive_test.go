@@ -0,0 +1,90 @@
+package pipeline_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func Test_Archive(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Now()
+
+	runs := []pipeline.ArchivedRun{
+		{
+			ID:             1,
+			JobID:          null.IntFrom(42),
+			JobName:        null.StringFrom("example"),
+			JobType:        null.StringFrom("webhook"),
+			PipelineSpecID: 7,
+			DotDagSource:   `ds1 [type=http method=GET url="https://chain.link/voter_turnout/USA-2020"];`,
+			State:          pipeline.RunStatusCompleted,
+			CreatedAt:      now,
+			FinishedAt:     null.TimeFrom(now),
+			TaskRuns: []pipeline.TaskRun{{
+				ID:    uuid.NewV4(),
+				Type:  pipeline.TaskTypeHTTP,
+				DotID: "ds1",
+			}},
+		},
+		{
+			ID:             2,
+			PipelineSpecID: 7,
+			State:          pipeline.RunStatusErrored,
+			FatalErrors:    pipeline.RunErrors{null.StringFrom("boom")},
+			CreatedAt:      now,
+			FinishedAt:     null.TimeFrom(now),
+		},
+	}
+
+	w, err := pipeline.CreateArchive(dir, now)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(runs[:1]))
+	require.NoError(t, w.Write(runs[1:]))
+	assert.Equal(t, 2, w.Count())
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, dir, filepath.Dir(w.Path()))
+	assert.True(t, strings.HasSuffix(w.Path(), ".ndjson.gz"))
+	info, err := os.Stat(w.Path())
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// Archives are never overwritten
+	_, err = pipeline.CreateArchive(dir, now)
+	require.Error(t, err)
+
+	f, err := os.Open(w.Path())
+	require.NoError(t, err)
+	defer f.Close()
+
+	var read []pipeline.ArchivedRun
+	require.NoError(t, pipeline.ReadArchive(f, func(run pipeline.ArchivedRun) error {
+		read = append(read, run)
+		return nil
+	}))
+
+	require.Len(t, read, 2)
+	assert.Equal(t, runs[0].ID, read[0].ID)
+	assert.Equal(t, runs[0].JobID, read[0].JobID)
+	assert.Equal(t, runs[0].JobName, read[0].JobName)
+	assert.Equal(t, runs[0].JobType, read[0].JobType)
+	assert.Equal(t, runs[0].DotDagSource, read[0].DotDagSource)
+	require.Len(t, read[0].TaskRuns, 1)
+	assert.Equal(t, runs[0].TaskRuns[0].ID, read[0].TaskRuns[0].ID)
+	assert.Equal(t, runs[1].ID, read[1].ID)
+	assert.Equal(t, pipeline.RunStatusErrored, read[1].State)
+	assert.Equal(t, runs[1].FatalErrors, read[1].FatalErrors)
+	assert.False(t, read[1].JobID.Valid)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	cnull "github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -51,9 +52,15 @@ type (
 		DefaultHTTPTimeout() models.Duration
 		DefaultHTTPAllowUnrestrictedNetworkAccess() bool
 		TriggerFallbackDBPollInterval() time.Duration
+		JobPipelineArchiveDir() string
 		JobPipelineMaxRunDuration() time.Duration
+		JobPipelineMetricsMaxBridges() uint32
+		JobPipelineMetricsMaxJobs() uint32
+		JobPipelineReaperErroredThreshold() time.Duration
 		JobPipelineReaperInterval() time.Duration
 		JobPipelineReaperThreshold() time.Duration
+		JobPipelineRetentionPolicies() string
+		SecretsResolver() secrets.Resolver
 	}
 )
 
@@ -296,6 +303,9 @@ const (
 	TaskTypeMean             TaskType = "mean"
 	TaskTypeMedian           TaskType = "median"
 	TaskTypeMode             TaskType = "mode"
+	TaskTypeWeightedMedian   TaskType = "weightedmedian"
+	TaskTypeTrimmedMean      TaskType = "trimmedmean"
+	TaskTypeOutlierFilter    TaskType = "outlierfilter"
 	TaskTypeSum              TaskType = "sum"
 	TaskTypeMultiply         TaskType = "multiply"
 	TaskTypeDivide           TaskType = "divide"
@@ -314,6 +324,7 @@ const (
 	TaskTypeMerge            TaskType = "merge"
 	TaskTypeLowercase        TaskType = "lowercase"
 	TaskTypeUppercase        TaskType = "uppercase"
+	TaskTypeStream           TaskType = "stream"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -354,6 +365,12 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &MedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMode:
 		task = &ModeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWeightedMedian:
+		task = &WeightedMedianTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeTrimmedMean:
+		task = &TrimmedMeanTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeOutlierFilter:
+		task = &OutlierFilterTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeSum:
 		task = &SumTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeAny:
@@ -394,6 +411,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &LowercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeUppercase:
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeStream:
+		task = &StreamTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
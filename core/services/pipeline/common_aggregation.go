@@ -0,0 +1,87 @@
+package pipeline
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// Reasons recorded for the inputs an aggregation task leaves out of its result
+const (
+	DropReasonErrored = "errored"
+	DropReasonTrimmed = "trimmed"
+	DropReasonOutlier = "outlier"
+)
+
+// indexedDecimal is a value of an aggregation task, along with its index among
+// the values of the task, so that the sources it is dropped from can be
+// reported.
+type indexedDecimal struct {
+	index int
+	value decimal.Decimal
+}
+
+// aggregationValues leaves the errors out of the values of an aggregation
+// task, erroring if there are more of them than allowed, and converts the
+// rest to decimals. The errors are returned as dropped values.
+func aggregationValues(taskType TaskType, valuesAndErrs SliceParam, maybeAllowedFaults MaybeUint64Param) (values []indexedDecimal, dropped []interface{}, err error) {
+	allowedFaults := len(valuesAndErrs) - 1
+	if allowed, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(allowed)
+	}
+
+	for i, v := range valuesAndErrs {
+		if verr, is := v.(error); is {
+			dropped = append(dropped, droppedValue(i, verr.Error(), DropReasonErrored))
+			continue
+		}
+		var d DecimalParam
+		if err = d.UnmarshalPipelineParam(v); err != nil {
+			return nil, nil, errors.Wrapf(ErrBadInput, "values: %v", err)
+		}
+		values = append(values, indexedDecimal{i, d.Decimal()})
+	}
+
+	if len(dropped) > allowedFaults {
+		return nil, nil, errors.Wrapf(ErrTooManyErrors, "Number of faulty inputs %v to %s task > number allowed faults %v", len(dropped), taskType, allowedFaults)
+	} else if len(values) == 0 {
+		return nil, nil, errors.Wrap(ErrWrongInputCardinality, "values")
+	}
+	return values, dropped, nil
+}
+
+// droppedValue records why an input of an aggregation task was left out of
+// its result, in the output of the task.
+func droppedValue(index int, value interface{}, reason string) map[string]interface{} {
+	return map[string]interface{}{
+		"index":  index,
+		"value":  value,
+		"reason": reason,
+	}
+}
+
+func sortIndexedDecimals(values []indexedDecimal) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].value.LessThan(values[j].value)
+	})
+}
+
+// sortedMedian returns the median of values, which must be sorted and non-empty.
+func sortedMedian(values []decimal.Decimal) decimal.Decimal {
+	k := len(values) / 2
+	if len(values)%2 == 1 {
+		return values[k]
+	}
+	return values[k].Add(values[k-1]).Div(decimal.NewFromInt(2))
+}
+
+// median returns the median of values, which must be non-empty.
+func median(values []decimal.Decimal) decimal.Decimal {
+	sorted := make([]decimal.Decimal, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LessThan(sorted[j])
+	})
+	return sortedMedian(sorted)
+}
@@ -93,6 +93,14 @@ func ParseETHABIArgsString(theABI []byte, isLog bool) (args abi.Arguments, index
 	return args, indexedArgs, nil
 }
 
+// ParseETHABIString parses an ABI string of the form used by the ethabiencode
+// task, e.g. "transfer(address to, uint256 amount)", returning the method name,
+// which is empty for anonymous tuples, and its arguments.
+func ParseETHABIString(theABI string) (name string, args abi.Arguments, err error) {
+	name, args, _, err = parseETHABIString([]byte(theABI), false)
+	return name, args, err
+}
+
 func parseETHABIString(theABI []byte, isLog bool) (name string, args abi.Arguments, indexedArgs abi.Arguments, err error) {
 	matches := ethABIRegex.FindAllSubmatch(theABI, -1)
 	if len(matches) != 1 || len(matches[0]) != 3 {
@@ -19,6 +19,7 @@ func makeHTTPRequest(
 	method StringParam,
 	url URLParam,
 	requestData MapParam,
+	requestHeaders http.Header,
 	allowUnrestrictedNetworkAccess BoolParam,
 	httpLimit int64,
 ) ([]byte, int, http.Header, time.Duration, error) {
@@ -36,6 +37,11 @@ func makeHTTPRequest(
 	if err != nil {
 		return nil, 0, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
+	for key, values := range requestHeaders {
+		for _, value := range values {
+			request.Header.Add(key, value)
+		}
+	}
 	request.Header.Set("Content-Type", "application/json")
 
 	httpRequest := HTTPRequest{
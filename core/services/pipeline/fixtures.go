@@ -0,0 +1,235 @@
+package pipeline
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// TaskStub is called in place of running a task. It returns false if the task
+// should be run as usual.
+type TaskStub func(ctx context.Context, task Task, vars Vars, inputs []Result) (result Result, stubbed bool)
+
+// NewOfflineRunner returns a runner which executes pipelines in memory without
+// a database, chains or keystores, so that pipelines can be tried out outside
+// of a node. Tasks which need any of those must be answered by stub. The
+// runner is only meant for ExecuteRun.
+func NewOfflineRunner(config Config, stub TaskStub, lggr logger.Logger) Runner {
+	r := NewRunner(nil, config, nil, nil, nil, nil, lggr)
+	r.stub = stub
+	// Its runs are not runs of a job, so they are left out of job metrics
+	r.metrics = nil
+	return r
+}
+
+// Fixtures are canned responses for the tasks of a pipeline which would
+// otherwise reach a bridge, a URL, a stream or a chain. They are read from
+// TOML:
+//
+//	[vars.jobRun.meta]
+//	foo = "bar"
+//
+//	[[http]]
+//	method = "GET"
+//	url = "https://example.com/price"
+//	response = '{"USD": 1}'
+//
+//	[[bridge]]
+//	name = "coinmarketcap"
+//	error = "timeout"
+//
+//	[[ethcall]]
+//	contract = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+//	data = "0xfeaf968c"
+//	result = "0x0000000000000000000000000000000000000000000000000000000000000001"
+//
+//	[[stream]]
+//	source = "binance-ethusd"
+//	value = "3000.5"
+type Fixtures struct {
+	// Vars are the vars the pipeline is run with, such as jobRun.meta
+	Vars     map[string]interface{} `toml:"vars"`
+	HTTP     []HTTPFixture          `toml:"http"`
+	Bridges  []BridgeFixture        `toml:"bridge"`
+	ETHCalls []ETHCallFixture       `toml:"ethcall"`
+	Streams  []StreamFixture        `toml:"stream"`
+}
+
+// HTTPFixture answers http tasks requesting URL. An empty Method matches any
+// method.
+type HTTPFixture struct {
+	Method   string `toml:"method"`
+	URL      string `toml:"url"`
+	Response string `toml:"response"`
+	Error    string `toml:"error"`
+}
+
+// BridgeFixture answers the bridge tasks of the bridge called Name.
+type BridgeFixture struct {
+	Name     string `toml:"name"`
+	Response string `toml:"response"`
+	Error    string `toml:"error"`
+}
+
+// ETHCallFixture answers ethcall tasks calling Contract. An empty Data matches
+// any call data. Result is hex encoded.
+type ETHCallFixture struct {
+	Contract string `toml:"contract"`
+	Data     string `toml:"data"`
+	Result   string `toml:"result"`
+	Error    string `toml:"error"`
+}
+
+// StreamFixture answers the stream tasks reading from Source.
+type StreamFixture struct {
+	Source string      `toml:"source"`
+	Value  interface{} `toml:"value"`
+	Error  string      `toml:"error"`
+}
+
+// ParseFixtures parses fixtures from TOML.
+func ParseFixtures(s string) (f Fixtures, err error) {
+	if err = toml.Unmarshal([]byte(s), &f); err != nil {
+		return f, errors.Wrap(err, "invalid fixtures")
+	}
+	for i, c := range f.ETHCalls {
+		if !common.IsHexAddress(c.Contract) {
+			err = multierr.Append(err, errors.Errorf("ethcall fixture %d: invalid contract address %q", i, c.Contract))
+		}
+		if c.Error == "" {
+			if _, herr := hexutil.Decode(c.Result); herr != nil {
+				err = multierr.Append(err, errors.Wrapf(herr, "ethcall fixture %d: invalid result", i))
+			}
+		}
+	}
+	return f, errors.Wrap(err, "invalid fixtures")
+}
+
+// Stub returns a TaskStub which answers http, bridge, ethcall and stream
+// tasks from the fixtures, and stubs ethtx tasks, which return the
+// transaction they would have sent. Tasks with no matching fixture error
+// rather than reaching out of the process, as do tasks which need keys or a
+// chain.
+func (f Fixtures) Stub() TaskStub {
+	return func(ctx context.Context, task Task, vars Vars, inputs []Result) (Result, bool) {
+		switch t := task.(type) {
+		case *HTTPTask:
+			return f.http(t, vars), true
+		case *BridgeTask:
+			return f.bridge(t), true
+		case *ETHCallTask:
+			return f.ethCall(t, vars), true
+		case *ETHTxTask:
+			return stubETHTx(t, vars), true
+		case *StreamTask:
+			return f.stream(t), true
+		case *VRFTask, *VRFTaskV2, *EstimateGasLimitTask:
+			return Result{Error: errors.Errorf("%s tasks cannot be run offline", task.Type())}, true
+		default:
+			return Result{}, false
+		}
+	}
+}
+
+func (f Fixtures) http(t *HTTPTask, vars Vars) Result {
+	var (
+		method StringParam
+		url    URLParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
+		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+	for _, fixture := range f.HTTP {
+		if fixture.URL != url.String() || (fixture.Method != "" && !strings.EqualFold(fixture.Method, string(method))) {
+			continue
+		}
+		return fixtureResult(fixture.Response, fixture.Error)
+	}
+	return Result{Error: errors.Errorf("no http fixture for %s %s", method, url.String())}
+}
+
+func (f Fixtures) bridge(t *BridgeTask) Result {
+	for _, fixture := range f.Bridges {
+		if fixture.Name == t.Name {
+			return fixtureResult(fixture.Response, fixture.Error)
+		}
+	}
+	return Result{Error: errors.Errorf("no bridge fixture for %s", t.Name)}
+}
+
+func (f Fixtures) stream(t *StreamTask) Result {
+	for _, fixture := range f.Streams {
+		if fixture.Source != t.Source {
+			continue
+		}
+		if fixture.Error != "" {
+			return Result{Error: errors.New(fixture.Error)}
+		}
+		return Result{Value: fixture.Value}
+	}
+	return Result{Error: errors.Errorf("no stream fixture for %s", t.Source)}
+}
+
+func (f Fixtures) ethCall(t *ETHCallTask, vars Vars) Result {
+	var (
+		contractAddr AddressParam
+		data         BytesParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&contractAddr, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false))), "data"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+	encoded := hexutil.Encode(data)
+	for _, fixture := range f.ETHCalls {
+		if common.HexToAddress(fixture.Contract) != common.Address(contractAddr) {
+			continue
+		}
+		if fixture.Data != "" && !strings.EqualFold(fixture.Data, encoded) {
+			continue
+		}
+		if fixture.Error != "" {
+			return Result{Error: errors.New(fixture.Error)}
+		}
+		return Result{Value: hexutil.MustDecode(fixture.Result)}
+	}
+	return Result{Error: errors.Errorf("no ethcall fixture for contract %s with data %s", common.Address(contractAddr).Hex(), encoded)}
+}
+
+func stubETHTx(t *ETHTxTask, vars Vars) Result {
+	var (
+		toAddr AddressParam
+		data   BytesParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&toAddr, From(VarExpr(t.To, vars), NonemptyString(t.To))), "to"),
+		errors.Wrap(ResolveParam(&data, From(VarExpr(t.Data, vars), NonemptyString(t.Data))), "data"),
+	)
+	if err != nil {
+		return Result{Error: err}
+	}
+	return Result{Value: map[string]interface{}{
+		"to":   common.Address(toAddr).Hex(),
+		"data": hexutil.Encode(data),
+	}}
+}
+
+func fixtureResult(response, err string) Result {
+	if err != "" {
+		return Result{Error: errors.New(err)}
+	}
+	return Result{Value: response}
+}
@@ -0,0 +1,113 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func Test_ParseFixtures(t *testing.T) {
+	t.Parallel()
+
+	t.Run("parses vars and responses", func(t *testing.T) {
+		f, err := pipeline.ParseFixtures(`
+[vars.jobRun.meta]
+foo = "bar"
+
+[[http]]
+method = "POST"
+url = "https://example.com/price"
+response = '{"USD": 1}'
+
+[[bridge]]
+name = "coinmarketcap"
+error = "timeout"
+
+[[ethcall]]
+contract = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+result = "0x01"
+`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "bar", f.Vars["jobRun"].(map[string]interface{})["meta"].(map[string]interface{})["foo"])
+		assert.Equal(t, []pipeline.HTTPFixture{{Method: "POST", URL: "https://example.com/price", Response: `{"USD": 1}`}}, f.HTTP)
+		assert.Equal(t, []pipeline.BridgeFixture{{Name: "coinmarketcap", Error: "timeout"}}, f.Bridges)
+		assert.Equal(t, []pipeline.ETHCallFixture{{Contract: "0x5FbDB2315678afecb367f032d93F642f64180aa3", Result: "0x01"}}, f.ETHCalls)
+	})
+
+	t.Run("errors on invalid ethcall fixtures", func(t *testing.T) {
+		_, err := pipeline.ParseFixtures(`
+[[ethcall]]
+contract = "foo"
+result = "bar"
+`)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid contract address")
+		assert.Contains(t, err.Error(), "invalid result")
+	})
+}
+
+func Test_OfflineRunner(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	lggr := logger.TestLogger(t)
+	fixtures, err := pipeline.ParseFixtures(`
+[vars.jobRun.meta]
+url = "https://example.com/price"
+data = "0xfeaf968c"
+
+[[http]]
+url = "https://example.com/price"
+response = '{"USD": 1.5}'
+
+[[bridge]]
+name = "coinmarketcap"
+error = "timeout"
+
+[[ethcall]]
+contract = "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+data = "0xfeaf968c"
+result = "0x0000000000000000000000000000000000000000000000000000000000000007"
+`)
+	require.NoError(t, err)
+	r := pipeline.NewOfflineRunner(cfg, fixtures.Stub(), lggr)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds1          [type=http url="$(jobRun.meta.url)"];
+ds1_parse    [type=jsonparse path="USD"];
+ds2          [type=bridge name="coinmarketcap"];
+ds3          [type=http url="https://example.com/unknown"];
+call         [type=ethcall contract="0x5FbDB2315678afecb367f032d93F642f64180aa3" data="$(jobRun.meta.data)"];
+submit       [type=ethtx to="0x5FbDB2315678afecb367f032d93F642f64180aa3" data="0x01"];
+ds1 -> ds1_parse -> submit;
+`}
+	_, trrs, err := r.ExecuteRun(context.Background(), spec, pipeline.NewVarsFrom(fixtures.Vars), lggr)
+	require.NoError(t, err)
+
+	results := make(map[string]pipeline.Result)
+	for _, trr := range trrs {
+		results[trr.Task.DotID()] = trr.Result
+	}
+	require.Len(t, results, 6)
+
+	assert.NoError(t, results["ds1"].Error)
+	assert.Equal(t, `{"USD": 1.5}`, results["ds1"].Value)
+	assert.NoError(t, results["ds1_parse"].Error)
+	assert.EqualError(t, results["ds2"].Error, "timeout")
+	assert.EqualError(t, results["ds3"].Error, "no http fixture for GET https://example.com/unknown")
+	assert.NoError(t, results["call"].Error)
+	assert.Equal(t, hexutil.MustDecode("0x0000000000000000000000000000000000000000000000000000000000000007"), results["call"].Value)
+	assert.NoError(t, results["submit"].Error)
+	assert.Equal(t, map[string]interface{}{
+		"to":   "0x5FbDB2315678afecb367f032d93F642f64180aa3",
+		"data": "0x01",
+	}, results["submit"].Value)
+}
@@ -50,3 +50,7 @@ func (t *ETHTxTask) HelperSetDependencies(cc evm.ChainSet, keyStore ETHKeyStore)
 	t.chainSet = cc
 	t.keyStore = keyStore
 }
+
+func (t *StreamTask) HelperSetDependencies(streams StreamCache) {
+	t.streams = streams
+}
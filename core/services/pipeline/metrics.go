@@ -0,0 +1,304 @@
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	promJobTaskDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_job_task_duration_seconds",
+		Help:    "How long pipeline tasks took to execute, by job and task type",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+		[]string{"job_name", "external_job_id", "task_type"},
+	)
+	promJobTasksFinished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_job_tasks_finished_total",
+		Help: "The number of pipeline tasks which have finished, by job, task type and status",
+	},
+		[]string{"job_name", "external_job_id", "task_type", "status"},
+	)
+	promJobRunDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_job_run_duration_seconds",
+		Help:    "How long pipeline runs took to finish (from the moment they were created), by job",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+	},
+		[]string{"job_name", "external_job_id"},
+	)
+	promJobRuns = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_job_runs_total",
+		Help: "The number of pipeline runs which have finished, by job and state",
+	},
+		[]string{"job_name", "external_job_id", "state"},
+	)
+	promJobRunErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_job_run_errors_total",
+		Help: "The number of pipeline runs which errored, by job and the class of the error which caused them to",
+	},
+		[]string{"job_name", "external_job_id", "error_class"},
+	)
+	promBridgeLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "bridge_latency_seconds",
+		Help:    "How long requests to bridges took, by bridge and status",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	},
+		[]string{"bridge_name", "status"},
+	)
+	promBridgeResponseBodySize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "bridge_response_body_size_bytes",
+		Help: "Size (in bytes) of the latest response body of each bridge",
+	},
+		[]string{"bridge_name"},
+	)
+)
+
+// Metric label values
+const (
+	// MetricsOtherLabel replaces the job or bridge labels of metrics once
+	// their cardinality limit has been reached
+	MetricsOtherLabel = "other"
+
+	metricsStatusCompleted = "completed"
+	metricsStatusError     = "error"
+)
+
+// Error classes of errored runs, as labelled in pipeline_job_run_errors_total
+const (
+	ErrorClassTimeout   = "timeout"
+	ErrorClassCancelled = "cancelled"
+	ErrorClassPanic     = "panic"
+	ErrorClassInput     = "input"
+	ErrorClassFaults    = "too_many_errors"
+	ErrorClassHTTP      = "http"
+	ErrorClassChain     = "chain"
+	ErrorClassOther     = "other"
+)
+
+var (
+	errorClasses = []string{ErrorClassTimeout, ErrorClassCancelled, ErrorClassPanic, ErrorClassInput, ErrorClassFaults, ErrorClassHTTP, ErrorClassChain, ErrorClassOther}
+	runStates    = []RunStatus{RunStatusCompleted, RunStatusErrored}
+	taskStatuses = []string{metricsStatusCompleted, metricsStatusError}
+)
+
+type jobMetricsKey struct {
+	jobID int32
+	name  string
+}
+
+type jobMetricsTask struct {
+	dotID    string
+	taskType TaskType
+}
+
+type jobMetricsLabels struct {
+	name          string
+	externalJobID string
+	tasks         map[jobMetricsTask]struct{}
+}
+
+// metrics records the per job and per bridge metrics of pipeline runs. It
+// bounds their cardinality by labelling at most JOB_PIPELINE_METRICS_MAX_JOBS
+// jobs and JOB_PIPELINE_METRICS_MAX_BRIDGES bridges individually, and
+// recording the rest under MetricsOtherLabel.
+type metrics struct {
+	maxJobs    int
+	maxBridges int
+
+	mu      sync.Mutex
+	jobs    map[jobMetricsKey]*jobMetricsLabels
+	bridges map[string]struct{}
+}
+
+func newMetrics(config Config) *metrics {
+	return &metrics{
+		maxJobs:    int(config.JobPipelineMetricsMaxJobs()),
+		maxBridges: int(config.JobPipelineMetricsMaxBridges()),
+		jobs:       make(map[jobMetricsKey]*jobMetricsLabels),
+		bridges:    make(map[string]struct{}),
+	}
+}
+
+// jobLabels returns the job labels of spec, registering it if the limit has
+// not been reached yet. The task is recorded so that its series can be deleted
+// along with the job.
+func (m *metrics) jobLabels(spec Spec, task Task) (name, externalJobID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := jobMetricsKey{spec.JobID, spec.JobName}
+	labels, ok := m.jobs[key]
+	if !ok {
+		if len(m.jobs) >= m.maxJobs {
+			return MetricsOtherLabel, MetricsOtherLabel
+		}
+		labels = &jobMetricsLabels{
+			name:          spec.JobName,
+			externalJobID: spec.ExternalJobID.String(),
+			tasks:         make(map[jobMetricsTask]struct{}),
+		}
+		m.jobs[key] = labels
+	}
+	if task != nil {
+		labels.tasks[jobMetricsTask{task.DotID(), task.Type()}] = struct{}{}
+	}
+	return labels.name, labels.externalJobID
+}
+
+// TaskFinished records the duration and status of a finished task run
+func (m *metrics) TaskFinished(spec Spec, trr TaskRunResult) {
+	if m == nil {
+		return
+	}
+	taskType := trr.Task.Type()
+	name, externalJobID := m.jobLabels(spec, trr.Task)
+	status := metricsStatusCompleted
+	if trr.Result.Error != nil {
+		status = metricsStatusError
+	}
+	promJobTaskDuration.WithLabelValues(name, externalJobID, string(taskType)).Observe(trr.FinishedAt.Time.Sub(trr.CreatedAt).Seconds())
+	promJobTasksFinished.WithLabelValues(name, externalJobID, string(taskType), status).Inc()
+}
+
+// RunFinished records the duration and state of a finished run, and the class
+// of the error which caused it to error, if any.
+func (m *metrics) RunFinished(run *Run, results []TaskRunResult) {
+	if m == nil || !run.FinishedAt.Valid {
+		return
+	}
+	name, externalJobID := m.jobLabels(run.PipelineSpec, nil)
+	promJobRunDuration.WithLabelValues(name, externalJobID).Observe(run.FinishedAt.Time.Sub(run.CreatedAt).Seconds())
+	promJobRuns.WithLabelValues(name, externalJobID, string(run.State)).Inc()
+	if run.State == RunStatusErrored {
+		promJobRunErrors.WithLabelValues(name, externalJobID, runErrorClass(results)).Inc()
+	}
+}
+
+// BridgeCalled records the latency of a request to a bridge and the size of
+// its response.
+func (m *metrics) BridgeCalled(bridgeName string, elapsed time.Duration, responseSize int, err error) {
+	if m == nil {
+		return
+	}
+	name := m.bridgeLabel(bridgeName)
+	status := metricsStatusCompleted
+	if err != nil {
+		status = metricsStatusError
+	}
+	promBridgeLatency.WithLabelValues(name, status).Observe(elapsed.Seconds())
+	if err == nil {
+		promBridgeResponseBodySize.WithLabelValues(name).Set(float64(responseSize))
+	}
+}
+
+func (m *metrics) bridgeLabel(bridgeName string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.bridges[bridgeName]; !ok {
+		if len(m.bridges) >= m.maxBridges {
+			return MetricsOtherLabel
+		}
+		m.bridges[bridgeName] = struct{}{}
+	}
+	return bridgeName
+}
+
+// ForgetJob deletes the series of a job, including those of the metrics
+// labelled by job ID, freeing its place under the cardinality limit.
+func (m *metrics) ForgetJob(jobID int32) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, labels := range m.jobs {
+		if key.jobID != jobID {
+			continue
+		}
+		delete(m.jobs, key)
+		id := fmt.Sprintf("%d", key.jobID)
+		for task := range labels.tasks {
+			promJobTaskDuration.DeleteLabelValues(labels.name, labels.externalJobID, string(task.taskType))
+			PromPipelineTaskExecutionTime.DeleteLabelValues(id, key.name, task.dotID, string(task.taskType))
+			for _, status := range taskStatuses {
+				promJobTasksFinished.DeleteLabelValues(labels.name, labels.externalJobID, string(task.taskType), status)
+				PromPipelineTasksTotalFinished.DeleteLabelValues(id, key.name, task.dotID, string(task.taskType), status)
+			}
+		}
+		PromPipelineRunErrors.DeleteLabelValues(id, key.name)
+		PromPipelineRunTotalTimeToCompletion.DeleteLabelValues(id, key.name)
+		promJobRunDuration.DeleteLabelValues(labels.name, labels.externalJobID)
+		for _, state := range runStates {
+			promJobRuns.DeleteLabelValues(labels.name, labels.externalJobID, string(state))
+		}
+		for _, class := range errorClasses {
+			promJobRunErrors.DeleteLabelValues(labels.name, labels.externalJobID, class)
+		}
+	}
+}
+
+// ForgetBridge deletes the series of a bridge, freeing its place under the
+// cardinality limit.
+func (m *metrics) ForgetBridge(bridgeName string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.bridges[bridgeName]; !ok {
+		return
+	}
+	delete(m.bridges, bridgeName)
+	for _, status := range taskStatuses {
+		promBridgeLatency.DeleteLabelValues(bridgeName, status)
+	}
+	promBridgeResponseBodySize.DeleteLabelValues(bridgeName)
+}
+
+// runErrorClass classifies the error which caused a run to error. Errors of
+// tasks whose inputs errored are skipped in favour of the error they were
+// caused by.
+func runErrorClass(results []TaskRunResult) string {
+	var first *TaskRunResult
+	for i := range results {
+		trr := &results[i]
+		if trr.Result.Error == nil || errors.Is(trr.Result.Error, ErrInputTaskErrored) {
+			continue
+		}
+		if first == nil || trr.FinishedAt.Time.Before(first.FinishedAt.Time) {
+			first = trr
+		}
+	}
+	if first == nil {
+		return ErrorClassOther
+	}
+	return errorClass(first.Task.Type(), first.Result.Error)
+}
+
+func errorClass(taskType TaskType, err error) string {
+	var panicked ErrRunPanicked
+	switch {
+	case errors.Is(err, ErrTimeout), errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.Is(err, ErrCancelled), errors.Is(err, context.Canceled):
+		return ErrorClassCancelled
+	case errors.As(err, &panicked):
+		return ErrorClassPanic
+	case errors.Is(err, ErrTooManyErrors):
+		return ErrorClassFaults
+	case errors.Is(err, ErrBadInput), errors.Is(err, ErrParameterEmpty), errors.Is(err, ErrWrongInputCardinality):
+		return ErrorClassInput
+	}
+	switch taskType {
+	case TaskTypeHTTP, TaskTypeBridge:
+		return ErrorClassHTTP
+	case TaskTypeETHCall, TaskTypeETHTx, TaskTypeEstimateGasLimit:
+		return ErrorClassChain
+	}
+	return ErrorClassOther
+}
@@ -0,0 +1,205 @@
+package pipeline
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func newTestMetrics(maxJobs, maxBridges int) *metrics {
+	return &metrics{
+		maxJobs:    maxJobs,
+		maxBridges: maxBridges,
+		jobs:       make(map[jobMetricsKey]*jobMetricsLabels),
+		bridges:    make(map[string]struct{}),
+	}
+}
+
+func newTestTaskRunResult(task Task, err error) TaskRunResult {
+	now := time.Now()
+	return TaskRunResult{
+		Task:       task,
+		Result:     Result{Value: "foo", Error: err},
+		CreatedAt:  now.Add(-time.Second),
+		FinishedAt: null.TimeFrom(now),
+	}
+}
+
+func TestMetrics_JobLimit(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMetrics(1, 1)
+	spec1 := Spec{JobID: 1, JobName: "TestMetrics_JobLimit 1", ExternalJobID: uuid.NewV4()}
+	spec2 := Spec{JobID: 2, JobName: "TestMetrics_JobLimit 2", ExternalJobID: uuid.NewV4()}
+
+	name, externalJobID := m.jobLabels(spec1, nil)
+	assert.Equal(t, spec1.JobName, name)
+	assert.Equal(t, spec1.ExternalJobID.String(), externalJobID)
+
+	name, externalJobID = m.jobLabels(spec2, nil)
+	assert.Equal(t, MetricsOtherLabel, name)
+	assert.Equal(t, MetricsOtherLabel, externalJobID)
+
+	// Deleting a job frees its place
+	m.ForgetJob(spec1.JobID)
+	name, externalJobID = m.jobLabels(spec2, nil)
+	assert.Equal(t, spec2.JobName, name)
+	assert.Equal(t, spec2.ExternalJobID.String(), externalJobID)
+}
+
+func TestMetrics_BridgeLimit(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMetrics(1, 1)
+	m.BridgeCalled("TestMetrics_BridgeLimit-1", time.Second, 42, nil)
+	m.BridgeCalled("TestMetrics_BridgeLimit-2", time.Second, 42, errors.New("bridge errored"))
+
+	assert.Equal(t, float64(42), testutil.ToFloat64(promBridgeResponseBodySize.WithLabelValues("TestMetrics_BridgeLimit-1")))
+	assert.Equal(t, "TestMetrics_BridgeLimit-1", m.bridgeLabel("TestMetrics_BridgeLimit-1"))
+	assert.Equal(t, MetricsOtherLabel, m.bridgeLabel("TestMetrics_BridgeLimit-2"))
+}
+
+func TestMetrics_ForgetJob(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMetrics(10, 10)
+	spec := Spec{JobID: 3, JobName: "TestMetrics_ForgetJob", ExternalJobID: uuid.NewV4()}
+	externalJobID := spec.ExternalJobID.String()
+	task := &HTTPTask{BaseTask: NewBaseTask(0, "ds1", nil, nil, 0)}
+
+	trr := newTestTaskRunResult(task, errors.Wrap(ErrTimeout, "ds1"))
+	logTaskRunToPrometheus(trr, spec)
+	m.TaskFinished(spec, trr)
+	m.RunFinished(&Run{
+		PipelineSpec: spec,
+		State:        RunStatusErrored,
+		CreatedAt:    trr.CreatedAt,
+		FinishedAt:   trr.FinishedAt,
+	}, []TaskRunResult{trr})
+
+	require.Equal(t, float64(1), testutil.ToFloat64(promJobTasksFinished.WithLabelValues(spec.JobName, externalJobID, string(TaskTypeHTTP), metricsStatusError)))
+	require.Equal(t, float64(1), testutil.ToFloat64(promJobRuns.WithLabelValues(spec.JobName, externalJobID, string(RunStatusErrored))))
+	require.Equal(t, float64(1), testutil.ToFloat64(promJobRunErrors.WithLabelValues(spec.JobName, externalJobID, ErrorClassTimeout)))
+	require.Equal(t, float64(1), testutil.ToFloat64(PromPipelineTasksTotalFinished.WithLabelValues("3", spec.JobName, "ds1", string(TaskTypeHTTP), metricsStatusError)))
+
+	m.ForgetJob(spec.JobID)
+
+	// Series are recreated from zero once deleted
+	assert.Equal(t, float64(0), testutil.ToFloat64(promJobTasksFinished.WithLabelValues(spec.JobName, externalJobID, string(TaskTypeHTTP), metricsStatusError)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(promJobRuns.WithLabelValues(spec.JobName, externalJobID, string(RunStatusErrored))))
+	assert.Equal(t, float64(0), testutil.ToFloat64(promJobRunErrors.WithLabelValues(spec.JobName, externalJobID, ErrorClassTimeout)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(PromPipelineTasksTotalFinished.WithLabelValues("3", spec.JobName, "ds1", string(TaskTypeHTTP), metricsStatusError)))
+	assert.Empty(t, m.jobs)
+}
+
+func TestMetrics_ForgetBridge(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMetrics(10, 1)
+	m.BridgeCalled("TestMetrics_ForgetBridge", time.Second, 42, nil)
+	require.Equal(t, float64(42), testutil.ToFloat64(promBridgeResponseBodySize.WithLabelValues("TestMetrics_ForgetBridge")))
+
+	m.ForgetBridge("TestMetrics_ForgetBridge")
+
+	// Series are recreated from zero once deleted
+	assert.Equal(t, float64(0), testutil.ToFloat64(promBridgeResponseBodySize.WithLabelValues("TestMetrics_ForgetBridge")))
+	assert.Empty(t, m.bridges)
+	// Deleting a bridge frees its place
+	assert.Equal(t, "TestMetrics_ForgetBridge-2", m.bridgeLabel("TestMetrics_ForgetBridge-2"))
+}
+
+// metricsTestConfig is enough of a Config to run pipelines without a time limit
+type metricsTestConfig struct{ Config }
+
+func (metricsTestConfig) JobPipelineMaxRunDuration() time.Duration { return 0 }
+
+func TestMetrics_ReplaysAreNotRecorded(t *testing.T) {
+	t.Parallel()
+
+	m := newTestMetrics(10, 10)
+	lggr := logger.TestLogger(t)
+	r := &runner{config: metricsTestConfig{}, metrics: m, lggr: lggr}
+	spec := Spec{JobID: 5, JobName: "TestMetrics_ReplaysAreNotRecorded", ExternalJobID: uuid.NewV4(), DotDagSource: `memo [type=memo value=1];`}
+
+	_, _, err := r.ExecuteReplay(context.Background(), spec, NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	assert.Empty(t, m.jobs)
+
+	_, _, err = r.ExecuteRun(context.Background(), spec, NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	assert.Len(t, m.jobs, 1)
+}
+
+func TestMetrics_NilIsNoop(t *testing.T) {
+	t.Parallel()
+
+	var m *metrics
+	task := &HTTPTask{BaseTask: NewBaseTask(0, "ds1", nil, nil, 0)}
+	m.TaskFinished(Spec{}, newTestTaskRunResult(task, nil))
+	m.RunFinished(&Run{}, nil)
+	m.BridgeCalled("bridge", time.Second, 0, nil)
+	m.ForgetJob(1)
+	m.ForgetBridge("bridge")
+}
+
+func Test_runErrorClass(t *testing.T) {
+	t.Parallel()
+
+	httpTask := &HTTPTask{BaseTask: NewBaseTask(0, "ds1", nil, nil, 0)}
+	bridgeTask := &BridgeTask{BaseTask: NewBaseTask(1, "ds2", nil, nil, 0)}
+	ethCallTask := &ETHCallTask{BaseTask: NewBaseTask(2, "call", nil, nil, 0)}
+	medianTask := &MedianTask{BaseTask: NewBaseTask(3, "median", nil, nil, 0)}
+	jsonParseTask := &JSONParseTask{BaseTask: NewBaseTask(4, "parse", nil, nil, 0)}
+
+	tests := []struct {
+		name     string
+		task     Task
+		err      error
+		expected string
+	}{
+		{"timeout", httpTask, errors.Wrap(ErrTimeout, "ds1"), ErrorClassTimeout},
+		{"deadline exceeded", bridgeTask, errors.Wrap(context.DeadlineExceeded, "ds2"), ErrorClassTimeout},
+		{"cancelled", httpTask, ErrCancelled, ErrorClassCancelled},
+		{"panic", jsonParseTask, ErrRunPanicked{"oops"}, ErrorClassPanic},
+		{"too many errors", medianTask, errors.Wrap(ErrTooManyErrors, "median"), ErrorClassFaults},
+		{"bad input", jsonParseTask, errors.Wrap(ErrBadInput, "parse"), ErrorClassInput},
+		{"empty parameter", httpTask, errors.Wrap(ErrParameterEmpty, "url"), ErrorClassInput},
+		{"http", bridgeTask, errors.New("got error from external adapter"), ErrorClassHTTP},
+		{"chain", ethCallTask, errors.New("execution reverted"), ErrorClassChain},
+		{"other", jsonParseTask, errors.New("could not resolve path"), ErrorClassOther},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, errorClass(test.task.Type(), test.err))
+		})
+	}
+
+	t.Run("skips propagated errors", func(t *testing.T) {
+		cause := newTestTaskRunResult(bridgeTask, errors.New("got error from external adapter"))
+		propagated := newTestTaskRunResult(medianTask, ErrInputTaskErrored)
+		propagated.FinishedAt = null.TimeFrom(cause.FinishedAt.Time.Add(-time.Second))
+		assert.Equal(t, ErrorClassHTTP, runErrorClass([]TaskRunResult{propagated, cause}))
+	})
+
+	t.Run("earliest error", func(t *testing.T) {
+		first := newTestTaskRunResult(ethCallTask, errors.New("execution reverted"))
+		second := newTestTaskRunResult(jsonParseTask, errors.Wrap(ErrBadInput, "parse"))
+		second.FinishedAt = null.TimeFrom(first.FinishedAt.Time.Add(time.Second))
+		assert.Equal(t, ErrorClassChain, runErrorClass([]TaskRunResult{second, first}))
+	})
+
+	t.Run("no errors", func(t *testing.T) {
+		assert.Equal(t, ErrorClassOther, runErrorClass([]TaskRunResult{newTestTaskRunResult(httpTask, nil)}))
+	})
+}
@@ -6,6 +6,8 @@ import (
 	models "github.com/smartcontractkit/chainlink/core/store/models"
 	mock "github.com/stretchr/testify/mock"
 
+	secrets "github.com/smartcontractkit/chainlink/core/secrets"
+
 	time "time"
 
 	url "net/url"
@@ -88,6 +90,20 @@ func (_m *Config) DefaultHTTPTimeout() models.Duration {
 	return r0
 }
 
+// JobPipelineArchiveDir provides a mock function with given fields:
+func (_m *Config) JobPipelineArchiveDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // JobPipelineMaxRunDuration provides a mock function with given fields:
 func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	ret := _m.Called()
@@ -102,6 +118,48 @@ func (_m *Config) JobPipelineMaxRunDuration() time.Duration {
 	return r0
 }
 
+// JobPipelineMetricsMaxBridges provides a mock function with given fields:
+func (_m *Config) JobPipelineMetricsMaxBridges() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineMetricsMaxJobs provides a mock function with given fields:
+func (_m *Config) JobPipelineMetricsMaxJobs() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineReaperErroredThreshold provides a mock function with given fields:
+func (_m *Config) JobPipelineReaperErroredThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // JobPipelineReaperInterval provides a mock function with given fields:
 func (_m *Config) JobPipelineReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -130,6 +188,36 @@ func (_m *Config) JobPipelineReaperThreshold() time.Duration {
 	return r0
 }
 
+// JobPipelineRetentionPolicies provides a mock function with given fields:
+func (_m *Config) JobPipelineRetentionPolicies() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// SecretsResolver provides a mock function with given fields:
+func (_m *Config) SecretsResolver() secrets.Resolver {
+	ret := _m.Called()
+
+	var r0 secrets.Resolver
+	if rf, ok := ret.Get(0).(func() secrets.Resolver); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(secrets.Resolver)
+		}
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *Config) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -85,20 +85,6 @@ func (_m *ORM) DeleteRun(id int64) error {
 	return r0
 }
 
-// DeleteRunsOlderThan provides a mock function with given fields: _a0, _a1
-func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, time.Duration) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
 // FindRun provides a mock function with given fields: id
 func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
@@ -171,6 +157,27 @@ func (_m *ORM) GetUnfinishedRuns(_a0 context.Context, _a1 time.Time, _a2 func(pi
 	return r0
 }
 
+// ImportArchivedRuns provides a mock function with given fields: runs
+func (_m *ORM) ImportArchivedRuns(runs []pipeline.ArchivedRun) (int64, error) {
+	ret := _m.Called(runs)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func([]pipeline.ArchivedRun) int64); ok {
+		r0 = rf(runs)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]pipeline.ArchivedRun) error); ok {
+		r1 = rf(runs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertFinishedRun provides a mock function with given fields: run, saveSuccessfulTaskRuns, qopts
 func (_m *ORM) InsertFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error {
 	_va := make([]interface{}, len(qopts))
@@ -213,6 +220,27 @@ func (_m *ORM) InsertRun(run *pipeline.Run, qopts ...pg.QOpt) error {
 	return r0
 }
 
+// ReapRuns provides a mock function with given fields: ctx, policies, archive
+func (_m *ORM) ReapRuns(ctx context.Context, policies pipeline.RetentionPolicies, archive func([]pipeline.ArchivedRun) error) (int64, error) {
+	ret := _m.Called(ctx, policies, archive)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.RetentionPolicies, func([]pipeline.ArchivedRun) error) int64); ok {
+		r0 = rf(ctx, policies, archive)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.RetentionPolicies, func([]pipeline.ArchivedRun) error) error); ok {
+		r1 = rf(ctx, policies, archive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StoreRun provides a mock function with given fields: run, qopts
 func (_m *ORM) StoreRun(run *pipeline.Run, qopts ...pg.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
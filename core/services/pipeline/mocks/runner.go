@@ -62,6 +62,36 @@ func (_m *Runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec pipeline
 	return r0, r1, r2
 }
 
+// ExecuteReplay provides a mock function with given fields: ctx, spec, vars, l
+func (_m *Runner) ExecuteReplay(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger) (pipeline.Run, pipeline.TaskRunResults, error) {
+	ret := _m.Called(ctx, spec, vars, l)
+
+	var r0 pipeline.Run
+	if rf, ok := ret.Get(0).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.Run); ok {
+		r0 = rf(ctx, spec, vars, l)
+	} else {
+		r0 = ret.Get(0).(pipeline.Run)
+	}
+
+	var r1 pipeline.TaskRunResults
+	if rf, ok := ret.Get(1).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) pipeline.TaskRunResults); ok {
+		r1 = rf(ctx, spec, vars, l)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(pipeline.TaskRunResults)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, pipeline.Spec, pipeline.Vars, logger.Logger) error); ok {
+		r2 = rf(ctx, spec, vars, l)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // ExecuteRun provides a mock function with given fields: ctx, spec, vars, l
 func (_m *Runner) ExecuteRun(ctx context.Context, spec pipeline.Spec, vars pipeline.Vars, l logger.Logger) (pipeline.Run, pipeline.TaskRunResults, error) {
 	ret := _m.Called(ctx, spec, vars, l)
@@ -92,6 +122,16 @@ func (_m *Runner) ExecuteRun(ctx context.Context, spec pipeline.Spec, vars pipel
 	return r0, r1, r2
 }
 
+// ForgetBridge provides a mock function with given fields: name
+func (_m *Runner) ForgetBridge(name string) {
+	_m.Called(name)
+}
+
+// ForgetJob provides a mock function with given fields: jobID
+func (_m *Runner) ForgetJob(jobID int32) {
+	_m.Called(jobID)
+}
+
 // Healthy provides a mock function with given fields:
 func (_m *Runner) Healthy() error {
 	ret := _m.Called()
@@ -128,8 +168,19 @@ func (_m *Runner) InsertFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bo
 }
 
 // OnRunFinished provides a mock function with given fields: _a0
-func (_m *Runner) OnRunFinished(_a0 func(*pipeline.Run)) {
-	_m.Called(_a0)
+func (_m *Runner) OnRunFinished(_a0 func(*pipeline.Run)) func() {
+	ret := _m.Called(_a0)
+
+	var r0 func()
+	if rf, ok := ret.Get(0).(func(func(*pipeline.Run)) func()); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(func())
+		}
+	}
+
+	return r0
 }
 
 // Ready provides a mock function with given fields:
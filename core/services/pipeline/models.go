@@ -24,8 +24,9 @@ type Spec struct {
 	CreatedAt       time.Time       `json:"-"`
 	MaxTaskDuration models.Interval `json:"-"`
 
-	JobID   int32  `json:"-"`
-	JobName string `json:"-"`
+	JobID         int32     `json:"-"`
+	JobName       string    `json:"-"`
+	ExternalJobID uuid.UUID `json:"-"`
 }
 
 func (s Spec) Pipeline() (*Pipeline, error) {
@@ -3,6 +3,7 @@ package pipeline
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
@@ -28,7 +29,8 @@ type ORM interface {
 	StoreRun(run *Run, qopts ...pg.QOpt) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) (err error)
-	DeleteRunsOlderThan(context.Context, time.Duration) error
+	ReapRuns(ctx context.Context, policies RetentionPolicies, archive func([]ArchivedRun) error) (deleted int64, err error)
+	ImportArchivedRuns(runs []ArchivedRun) (imported int64, err error)
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
@@ -275,10 +277,136 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
-func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
+// reapBatchSize is the number of runs which are archived and deleted at a time
+const reapBatchSize = 1000
+
+// ReapRuns deletes the finished runs which the retention policies no longer
+// keep, in batches. If archive is not nil each batch is passed to it first, and
+// is only deleted once it has been archived.
+func (o *orm) ReapRuns(ctx context.Context, policies RetentionPolicies, archive func([]ArchivedRun) error) (deleted int64, err error) {
 	q := o.q.WithOpts(pg.WithParentCtx(ctx))
-	err := q.ExecQ(`DELETE FROM pipeline_runs WHERE finished_at < $1`, time.Now().Add(-threshold))
-	return errors.Wrap(err, "DeleteRunsOlderThan failed")
+	cond, args := policies.reapableRunsCondition(time.Now())
+	args = append(args, reapBatchSize)
+	stmt := fmt.Sprintf(`SELECT pipeline_runs.id FROM pipeline_runs
+LEFT JOIN jobs ON jobs.pipeline_spec_id = pipeline_runs.pipeline_spec_id
+WHERE %s ORDER BY pipeline_runs.id LIMIT $%d`, cond, len(args))
+
+	for {
+		var ids []int64
+		if err = q.Select(&ids, stmt, args...); err != nil {
+			return deleted, errors.Wrap(err, "ReapRuns failed to select runs")
+		}
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		if archive != nil {
+			var runs []ArchivedRun
+			if runs, err = loadArchivedRuns(q, ids); err != nil {
+				return deleted, err
+			}
+			if err = archive(runs); err != nil {
+				return deleted, errors.Wrap(err, "ReapRuns failed to archive runs")
+			}
+		}
+
+		var n int64
+		if n, err = deleteRuns(q, ids); err != nil {
+			return deleted, err
+		}
+		deleted += n
+
+		if len(ids) < reapBatchSize {
+			return deleted, nil
+		}
+	}
+}
+
+func deleteRuns(q pg.Q, ids []int64) (int64, error) {
+	res, cancel, err := q.ExecQIter(`DELETE FROM pipeline_runs WHERE id = ANY($1)`, ids)
+	defer cancel()
+	if err != nil {
+		return 0, errors.Wrap(err, "ReapRuns failed to delete runs")
+	}
+	return res.RowsAffected()
+}
+
+func loadArchivedRuns(q pg.Queryer, ids []int64) ([]ArchivedRun, error) {
+	var runs []Run
+	if err := q.Select(&runs, `SELECT * FROM pipeline_runs WHERE id = ANY($1) ORDER BY id`, ids); err != nil {
+		return nil, errors.Wrap(err, "failed to load runs to archive")
+	}
+	if err := loadAssociations(q, runs); err != nil {
+		return nil, err
+	}
+
+	specIDs := make([]int32, len(runs))
+	for i, run := range runs {
+		specIDs[i] = run.PipelineSpecID
+	}
+	var jobs []archivedJob
+	if err := q.Select(&jobs, `SELECT pipeline_spec_id, id, name, type FROM jobs WHERE pipeline_spec_id = ANY($1)`, specIDs); err != nil {
+		return nil, errors.Wrap(err, "failed to load jobs of runs to archive")
+	}
+	jobsBySpecID := make(map[int32]*archivedJob, len(jobs))
+	for i := range jobs {
+		jobsBySpecID[jobs[i].PipelineSpecID] = &jobs[i]
+	}
+
+	archived := make([]ArchivedRun, len(runs))
+	for i, run := range runs {
+		archived[i] = newArchivedRun(run, jobsBySpecID[run.PipelineSpecID])
+	}
+	return archived, nil
+}
+
+// ImportArchivedRuns inserts archived runs with their original IDs, along with
+// their pipeline specs if those no longer exist. Runs which already exist are
+// skipped.
+func (o *orm) ImportArchivedRuns(runs []ArchivedRun) (imported int64, err error) {
+	err = o.q.Transaction(func(tx pg.Queryer) error {
+		for _, run := range runs {
+			if _, err := tx.Exec(`INSERT INTO pipeline_specs (id, dot_dag_source, max_task_duration, created_at) VALUES ($1, $2, $3, NOW())
+ON CONFLICT (id) DO NOTHING`, run.PipelineSpecID, run.DotDagSource, run.MaxTaskDuration); err != nil {
+				return errors.Wrapf(err, "failed to insert pipeline spec of run %d", run.ID)
+			}
+
+			res, err := tx.Exec(`INSERT INTO pipeline_runs (id, pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (id) DO NOTHING`,
+				run.ID, run.PipelineSpecID, run.Meta, run.AllErrors, run.FatalErrors, run.Inputs, run.Outputs, run.CreatedAt, run.FinishedAt, run.State)
+			if err != nil {
+				return errors.Wrapf(err, "failed to insert run %d", run.ID)
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				continue
+			}
+			imported++
+
+			if len(run.TaskRuns) == 0 {
+				continue
+			}
+			for i := range run.TaskRuns {
+				run.TaskRuns[i].PipelineRunID = run.ID
+			}
+			if _, err := tx.NamedExec(`INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
+VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`, run.TaskRuns); err != nil {
+				return errors.Wrapf(err, "failed to insert task runs of run %d", run.ID)
+			}
+		}
+
+		// Keep the sequences ahead of the imported IDs, in case runs were
+		// imported into a fresh database
+		for _, table := range []string{"pipeline_specs", "pipeline_runs"} {
+			if _, err := tx.Exec(fmt.Sprintf(`SELECT setval('%[1]s_id_seq', (SELECT MAX(id) FROM %[1]s))
+WHERE (SELECT MAX(id) FROM %[1]s) > (SELECT last_value FROM %[1]s_id_seq)`, table)); err != nil {
+				return errors.Wrapf(err, "failed to advance %s sequence", table)
+			}
+		}
+		return nil
+	})
+	return imported, errors.Wrap(err, "ImportArchivedRuns failed")
 }
 
 func (o *orm) FindRun(id int64) (r Run, err error) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
 	"github.com/stretchr/testify/assert"
@@ -11,6 +12,7 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
@@ -349,3 +351,175 @@ func Test_PipelineORM_DeleteRun(t *testing.T) {
 	_, err = orm.FindRun(run.ID)
 	require.Error(t, err, "not found")
 }
+
+func mustInsertFinishedRun(t *testing.T, orm pipeline.ORM, specID int32, state pipeline.RunStatus, finishedAt time.Time) pipeline.Run {
+	t.Helper()
+
+	createdAt := finishedAt.Add(-time.Second)
+	run := pipeline.Run{
+		PipelineSpecID: specID,
+		State:          state,
+		Outputs:        pipeline.JSONSerializable{Val: []interface{}{1}, Valid: true},
+		AllErrors:      pipeline.RunErrors{null.String{}},
+		FatalErrors:    pipeline.RunErrors{null.String{}},
+		CreatedAt:      createdAt,
+		FinishedAt:     null.TimeFrom(finishedAt),
+		PipelineTaskRuns: []pipeline.TaskRun{{
+			ID:         uuid.NewV4(),
+			Type:       pipeline.TaskTypeHTTP,
+			DotID:      "ds1",
+			Output:     pipeline.JSONSerializable{Val: 1, Valid: true},
+			CreatedAt:  createdAt,
+			FinishedAt: null.TimeFrom(finishedAt),
+		}},
+	}
+	if state == pipeline.RunStatusErrored {
+		run.AllErrors = pipeline.RunErrors{null.StringFrom("boom")}
+		run.FatalErrors = pipeline.RunErrors{null.StringFrom("boom")}
+		run.PipelineTaskRuns[0].Output = pipeline.JSONSerializable{}
+		run.PipelineTaskRuns[0].Error = null.StringFrom("boom")
+	}
+	require.NoError(t, orm.InsertFinishedRun(&run, true))
+	return run
+}
+
+func mustCreateSpec(t *testing.T, orm pipeline.ORM) int32 {
+	t.Helper()
+
+	p, err := pipeline.Parse(`ds1 [type=http method=GET url="https://chain.link/voter_turnout/USA-2020"];`)
+	require.NoError(t, err)
+	specID, err := orm.CreateSpec(*p, models.Interval(time.Minute))
+	require.NoError(t, err)
+	return specID
+}
+
+func remainingRunIDs(t *testing.T, db *sqlx.DB) []int64 {
+	t.Helper()
+
+	var ids []int64
+	require.NoError(t, db.Select(&ids, `SELECT id FROM pipeline_runs ORDER BY id`))
+	return ids
+}
+
+func Test_PipelineORM_ReapRuns(t *testing.T) {
+	now := time.Now()
+
+	t.Run("deletes completed and errored runs older than their thresholds", func(t *testing.T) {
+		db, orm := setupORM(t)
+		specID := mustCreateSpec(t, orm)
+
+		recent := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-time.Minute))
+		mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+		erroredRecent := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusErrored, now.Add(-2*time.Hour))
+		mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusErrored, now.Add(-48*time.Hour))
+		unfinished := mustInsertAsyncRun(t, orm)
+
+		policies := pipeline.RetentionPolicies{Default: pipeline.RetentionPolicy{Completed: time.Hour, Errored: 24 * time.Hour}}
+		deleted, err := orm.ReapRuns(testutils.Context(t), policies, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), deleted)
+		assert.Equal(t, []int64{recent.ID, erroredRecent.ID, unfinished.ID}, remainingRunIDs(t, db))
+	})
+
+	t.Run("keeps a sample of older completed runs", func(t *testing.T) {
+		db, orm := setupORM(t)
+		specID := mustCreateSpec(t, orm)
+
+		var sampled []int64
+		for i := 0; i < 4; i++ {
+			run := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+			if run.ID%2 == 0 {
+				sampled = append(sampled, run.ID)
+			}
+		}
+		for i := 0; i < 2; i++ {
+			mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-48*time.Hour))
+		}
+
+		policies := pipeline.RetentionPolicies{Default: pipeline.RetentionPolicy{Completed: time.Hour, Errored: time.Hour, SampleEvery: 2, Sampled: 24 * time.Hour}}
+		deleted, err := orm.ReapRuns(testutils.Context(t), policies, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(4), deleted)
+		assert.Equal(t, sampled, remainingRunIDs(t, db))
+	})
+
+	t.Run("applies the policy of the job type", func(t *testing.T) {
+		db, orm := setupORM(t)
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+		specID := mustCreateSpec(t, orm)
+
+		webhookRun := mustInsertFinishedRun(t, orm, jb.PipelineSpecID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+		mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+
+		policies := pipeline.RetentionPolicies{
+			Default:  pipeline.RetentionPolicy{Completed: time.Hour, Errored: time.Hour},
+			JobTypes: map[string]pipeline.RetentionPolicy{"webhook": {Completed: 24 * time.Hour, Errored: 24 * time.Hour}},
+		}
+		deleted, err := orm.ReapRuns(testutils.Context(t), policies, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(1), deleted)
+		assert.Equal(t, []int64{webhookRun.ID}, remainingRunIDs(t, db))
+	})
+
+	t.Run("archives runs before deleting them, and imports them back", func(t *testing.T) {
+		db, orm := setupORM(t)
+		jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+		completed := mustInsertFinishedRun(t, orm, jb.PipelineSpecID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+		errored := mustInsertFinishedRun(t, orm, jb.PipelineSpecID, pipeline.RunStatusErrored, now.Add(-2*time.Hour))
+
+		var archived []pipeline.ArchivedRun
+		archive := func(runs []pipeline.ArchivedRun) error {
+			archived = append(archived, runs...)
+			return nil
+		}
+		policies := pipeline.RetentionPolicies{Default: pipeline.RetentionPolicy{Completed: time.Hour, Errored: time.Hour}}
+		deleted, err := orm.ReapRuns(testutils.Context(t), policies, archive)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+		assert.Empty(t, remainingRunIDs(t, db))
+
+		require.Len(t, archived, 2)
+		assert.Equal(t, completed.ID, archived[0].ID)
+		assert.Equal(t, errored.ID, archived[1].ID)
+		for _, run := range archived {
+			assert.Equal(t, null.IntFrom(int64(jb.ID)), run.JobID)
+			assert.Equal(t, null.StringFrom("webhook"), run.JobType)
+			assert.Equal(t, jb.PipelineSpecID, run.PipelineSpecID)
+			assert.Len(t, run.TaskRuns, 1)
+		}
+
+		imported, err := orm.ImportArchivedRuns(archived)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), imported)
+
+		run, err := orm.FindRun(errored.ID)
+		require.NoError(t, err)
+		assert.Equal(t, pipeline.RunStatusErrored, run.State)
+		assert.Equal(t, errored.FatalErrors, run.FatalErrors)
+		require.Len(t, run.PipelineTaskRuns, 1)
+		assert.Equal(t, null.StringFrom("boom"), run.PipelineTaskRuns[0].Error)
+
+		// Importing the same runs twice is a no-op
+		imported, err = orm.ImportArchivedRuns(archived)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), imported)
+	})
+
+	t.Run("does not delete runs if archiving fails", func(t *testing.T) {
+		db, orm := setupORM(t)
+		specID := mustCreateSpec(t, orm)
+		run := mustInsertFinishedRun(t, orm, specID, pipeline.RunStatusCompleted, now.Add(-2*time.Hour))
+
+		policies := pipeline.RetentionPolicies{Default: pipeline.RetentionPolicy{Completed: time.Hour, Errored: time.Hour}}
+		_, err := orm.ReapRuns(testutils.Context(t), policies, func([]pipeline.ArchivedRun) error {
+			return errors.New("disk full")
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "disk full")
+		assert.Equal(t, []int64{run.ID}, remainingRunIDs(t, db))
+	})
+}
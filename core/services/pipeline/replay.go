@@ -0,0 +1,151 @@
+package pipeline
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// replayedBytesVars are the vars which are byte slices when a run is
+// executed, and so are stored base64 encoded in the inputs of the run.
+var replayedBytesVars = map[string][]string{
+	"jobSpec": {"publicKey"},
+	"jobRun":  {"logBlockHash", "logData"},
+}
+
+// ReplayVars rebuilds the vars which run was executed with from its stored
+// inputs, such as $(jobRun.meta), $(jobRun.logData) or
+// $(jobRun.requestBody). The stored inputs also hold the results of the tasks
+// of the run, which are left out. Runs stored without inputs only get their
+// meta.
+func ReplayVars(run Run) Vars {
+	inputs, ok := run.Inputs.Val.(map[string]interface{})
+	if !run.Inputs.Valid || !ok {
+		return NewVarsFrom(map[string]interface{}{
+			"jobRun": map[string]interface{}{
+				"meta": run.Meta.Val,
+			},
+		})
+	}
+
+	vars := make(map[string]interface{}, len(inputs))
+	for k, v := range inputs {
+		vars[k] = v
+	}
+	if p, err := run.PipelineSpec.Pipeline(); err == nil {
+		for _, task := range p.Tasks {
+			delete(vars, task.DotID())
+		}
+	}
+	for key, fields := range replayedBytesVars {
+		m, ok := vars[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		copied := make(map[string]interface{}, len(m))
+		for k, v := range m {
+			copied[k] = v
+		}
+		for _, field := range fields {
+			s, ok := copied[field].(string)
+			if !ok {
+				continue
+			}
+			if b, err := base64.StdEncoding.DecodeString(s); err == nil {
+				copied[field] = b
+			}
+		}
+		vars[key] = copied
+	}
+	return NewVarsFrom(vars)
+}
+
+// Replay is the outcome of re-executing a run in a sandbox.
+type Replay struct {
+	Original Run
+	Replayed Run
+	// CurrentSpec is true if the run was replayed with the current pipeline
+	// spec of its job, rather than the spec it was originally run with
+	CurrentSpec bool
+	// OutputsChanged is true if the final outputs or errors differ
+	OutputsChanged bool
+	TaskRuns       []TaskRunDiff
+}
+
+// TaskRunDiff compares the result of a task in the original run with its
+// result in the replay.
+type TaskRunDiff struct {
+	DotID string
+	Type  TaskType
+	// Original is nil if the task run was not stored, or if the task is not
+	// in the spec the run was originally run with
+	Original *TaskRun
+	// Replayed is nil if the task is not in the replayed spec
+	Replayed *TaskRun
+	// Changed is true if the output or error of the task differs. A task
+	// missing from one of the runs only counts as changed if the task runs
+	// of the original run were stored.
+	Changed bool
+}
+
+// NewReplay compares the results of a replayed run with the original run.
+func NewReplay(original, replayed Run, currentSpec bool) Replay {
+	replay := Replay{
+		Original:    original,
+		Replayed:    replayed,
+		CurrentSpec: currentSpec,
+		OutputsChanged: normalizedJSON(original.Outputs) != normalizedJSON(replayed.Outputs) ||
+			!equalRunErrors(original.FatalErrors, replayed.FatalErrors),
+	}
+
+	stored := len(original.PipelineTaskRuns) > 0
+	seen := make(map[string]bool, len(replayed.PipelineTaskRuns))
+	for i := range replayed.PipelineTaskRuns {
+		tr := &replayed.PipelineTaskRuns[i]
+		seen[tr.DotID] = true
+		diff := TaskRunDiff{DotID: tr.DotID, Type: tr.Type, Original: original.ByDotID(tr.DotID), Replayed: tr}
+		if diff.Original == nil {
+			diff.Changed = stored
+		} else {
+			diff.Changed = diff.Original.Error != tr.Error || normalizedJSON(diff.Original.Output) != normalizedJSON(tr.Output)
+		}
+		replay.TaskRuns = append(replay.TaskRuns, diff)
+	}
+	for i := range original.PipelineTaskRuns {
+		tr := &original.PipelineTaskRuns[i]
+		if seen[tr.DotID] {
+			continue
+		}
+		replay.TaskRuns = append(replay.TaskRuns, TaskRunDiff{DotID: tr.DotID, Type: tr.Type, Original: tr, Changed: true})
+	}
+	return replay
+}
+
+// normalizedJSON returns the JSON of v as it would be once stored and loaded
+// from the database, so that in-memory and stored results can be compared.
+func normalizedJSON(v JSONSerializable) string {
+	b, err := v.MarshalJSON()
+	if err != nil {
+		return ""
+	}
+	var decoded interface{}
+	if err = json.Unmarshal(b, &decoded); err != nil {
+		return string(b)
+	}
+	b, err = json.Marshal(decoded)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func equalRunErrors(a, b RunErrors) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,147 @@
+package pipeline_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func Test_ReplayVars(t *testing.T) {
+	t.Parallel()
+
+	t.Run("rebuilds the vars from the inputs of the run", func(t *testing.T) {
+		logData := []byte{0x01, 0x02, 0x03}
+		run := pipeline.Run{
+			PipelineSpec: pipeline.Spec{DotDagSource: `ds1 [type=memo value="1"];`},
+			Inputs: pipeline.JSONSerializable{Valid: true, Val: map[string]interface{}{
+				"ds1": "1",
+				"jobSpec": map[string]interface{}{
+					"databaseID": float64(1),
+				},
+				"jobRun": map[string]interface{}{
+					"meta":           map[string]interface{}{"foo": "bar"},
+					"logData":        base64.StdEncoding.EncodeToString(logData),
+					"logTxHash":      "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8",
+					"logBlockNumber": float64(10),
+				},
+			}},
+		}
+
+		vars := pipeline.ReplayVars(run)
+
+		v, err := vars.Get("jobRun.logData")
+		require.NoError(t, err)
+		assert.Equal(t, logData, v)
+		v, err = vars.Get("jobRun.logTxHash")
+		require.NoError(t, err)
+		assert.Equal(t, "0xc524fafafcaec40652b1f84fca09c231185437d008d195fccf2f51e64b7062f8", v)
+		v, err = vars.Get("jobRun.meta.foo")
+		require.NoError(t, err)
+		assert.Equal(t, "bar", v)
+		v, err = vars.Get("jobSpec.databaseID")
+		require.NoError(t, err)
+		assert.Equal(t, float64(1), v)
+
+		// Task results are not vars
+		_, err = vars.Get("ds1")
+		require.Error(t, err)
+
+		// The inputs of the run are left untouched
+		stored := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})["logData"]
+		assert.IsType(t, "", stored)
+	})
+
+	t.Run("falls back to the meta of runs without inputs", func(t *testing.T) {
+		run := pipeline.Run{
+			Meta: pipeline.JSONSerializable{Valid: true, Val: map[string]interface{}{"foo": "bar"}},
+		}
+
+		vars := pipeline.ReplayVars(run)
+
+		v, err := vars.Get("jobRun.meta.foo")
+		require.NoError(t, err)
+		assert.Equal(t, "bar", v)
+	})
+}
+
+func Test_NewReplay(t *testing.T) {
+	t.Parallel()
+
+	output := func(v interface{}) pipeline.JSONSerializable {
+		return pipeline.JSONSerializable{Val: v, Valid: true}
+	}
+
+	original := pipeline.Run{
+		ID:          1,
+		Outputs:     output([]interface{}{float64(3)}),
+		FatalErrors: pipeline.RunErrors{null.String{}},
+		PipelineTaskRuns: []pipeline.TaskRun{
+			{DotID: "ds1", Type: pipeline.TaskTypeHTTP, Output: output(map[string]interface{}{"price": float64(1)})},
+			{DotID: "ds2", Type: pipeline.TaskTypeHTTP, Error: null.StringFrom("timeout")},
+			{DotID: "answer", Type: pipeline.TaskTypeMedian, Output: output(float64(3))},
+			{DotID: "removed", Type: pipeline.TaskTypeHTTP, Output: output("gone")},
+		},
+	}
+
+	t.Run("compares the results of each task", func(t *testing.T) {
+		replayed := pipeline.Run{
+			Outputs:     output([]interface{}{int64(3)}),
+			FatalErrors: pipeline.RunErrors{null.String{}},
+			PipelineTaskRuns: []pipeline.TaskRun{
+				{DotID: "ds1", Type: pipeline.TaskTypeHTTP, Output: output(map[string]interface{}{"price": 1})},
+				{DotID: "ds2", Type: pipeline.TaskTypeHTTP, Output: output(map[string]interface{}{"price": 5})},
+				{DotID: "answer", Type: pipeline.TaskTypeMedian, Output: output(int64(3))},
+				{DotID: "added", Type: pipeline.TaskTypeHTTP, Output: output("new")},
+			},
+		}
+
+		replay := pipeline.NewReplay(original, replayed, true)
+
+		assert.True(t, replay.CurrentSpec)
+		assert.False(t, replay.OutputsChanged)
+		require.Len(t, replay.TaskRuns, 5)
+
+		changed := make(map[string]bool)
+		for _, diff := range replay.TaskRuns {
+			changed[diff.DotID] = diff.Changed
+		}
+		assert.Equal(t, map[string]bool{"ds1": false, "ds2": true, "answer": false, "added": true, "removed": true}, changed)
+
+		assert.Equal(t, "added", replay.TaskRuns[3].DotID)
+		assert.Nil(t, replay.TaskRuns[3].Original)
+		assert.Equal(t, "removed", replay.TaskRuns[4].DotID)
+		assert.Nil(t, replay.TaskRuns[4].Replayed)
+	})
+
+	t.Run("detects changed outputs", func(t *testing.T) {
+		replayed := pipeline.Run{
+			Outputs:     output([]interface{}{nil}),
+			FatalErrors: pipeline.RunErrors{null.StringFrom("boom")},
+		}
+
+		replay := pipeline.NewReplay(original, replayed, false)
+
+		assert.True(t, replay.OutputsChanged)
+	})
+
+	t.Run("does not count tasks missing from runs without stored task runs as changed", func(t *testing.T) {
+		replayed := pipeline.Run{
+			Outputs:          output([]interface{}{float64(3)}),
+			FatalErrors:      pipeline.RunErrors{null.String{}},
+			PipelineTaskRuns: []pipeline.TaskRun{{DotID: "ds1", Type: pipeline.TaskTypeHTTP, Output: output(float64(1))}},
+		}
+		withoutTaskRuns := original
+		withoutTaskRuns.PipelineTaskRuns = nil
+
+		replay := pipeline.NewReplay(withoutTaskRuns, replayed, false)
+
+		require.Len(t, replay.TaskRuns, 1)
+		assert.False(t, replay.TaskRuns[0].Changed)
+		assert.Nil(t, replay.TaskRuns[0].Original)
+	})
+}
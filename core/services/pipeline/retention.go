@@ -0,0 +1,137 @@
+package pipeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// RetentionPolicy decides how long finished runs are kept before the reaper
+// deletes them.
+type RetentionPolicy struct {
+	// Completed is how long all completed runs are kept for
+	Completed time.Duration
+	// Errored is how long errored runs are kept for
+	Errored time.Duration
+	// SampleEvery downsamples completed runs once they are older than
+	// Completed: one in SampleEvery of them is kept until it is older than
+	// Sampled. Zero or one disables downsampling.
+	SampleEvery uint32
+	// Sampled is how long the downsampled completed runs are kept for
+	Sampled time.Duration
+}
+
+// Validate returns an error if the policy is inconsistent.
+func (p RetentionPolicy) Validate() error {
+	if p.SampleEvery > 1 && p.Sampled < p.Completed {
+		return errors.Errorf("sampled (%s) must not be less than completed (%s)", p.Sampled, p.Completed)
+	}
+	return nil
+}
+
+// sampling returns true if the policy keeps a sample of older completed runs.
+func (p RetentionPolicy) sampling() bool {
+	return p.SampleEvery > 1
+}
+
+// RetentionPolicies are the retention policy of each job type, along with
+// the default policy for all other runs.
+type RetentionPolicies struct {
+	Default  RetentionPolicy
+	JobTypes map[string]RetentionPolicy
+}
+
+// retentionPolicyJSON is a retention policy as configured by
+// JOB_PIPELINE_RETENTION_POLICIES. Omitted fields inherit the default policy.
+type retentionPolicyJSON struct {
+	Completed   *models.Duration `json:"completed"`
+	Errored     *models.Duration `json:"errored"`
+	SampleEvery *uint32          `json:"sampleEvery"`
+	Sampled     *models.Duration `json:"sampled"`
+}
+
+// NewRetentionPolicies returns the retention policies configured by
+// JOB_PIPELINE_REAPER_THRESHOLD, JOB_PIPELINE_REAPER_ERRORED_THRESHOLD and
+// JOB_PIPELINE_RETENTION_POLICIES.
+func NewRetentionPolicies(cfg Config) (RetentionPolicies, error) {
+	def := RetentionPolicy{
+		Completed: cfg.JobPipelineReaperThreshold(),
+		Errored:   cfg.JobPipelineReaperErroredThreshold(),
+	}
+	if def.Errored == 0 {
+		def.Errored = def.Completed
+	}
+	policies := RetentionPolicies{Default: def, JobTypes: make(map[string]RetentionPolicy)}
+
+	s := strings.TrimSpace(cfg.JobPipelineRetentionPolicies())
+	if s == "" {
+		return policies, nil
+	}
+	var raw map[string]retentionPolicyJSON
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return policies, errors.Wrap(err, "invalid JOB_PIPELINE_RETENTION_POLICIES")
+	}
+	for jobType, r := range raw {
+		p := def
+		if r.Completed != nil {
+			p.Completed = r.Completed.Duration()
+		}
+		if r.Errored != nil {
+			p.Errored = r.Errored.Duration()
+		}
+		if r.SampleEvery != nil {
+			p.SampleEvery = *r.SampleEvery
+		}
+		if r.Sampled != nil {
+			p.Sampled = r.Sampled.Duration()
+		}
+		if err := p.Validate(); err != nil {
+			return policies, errors.Wrapf(err, "invalid JOB_PIPELINE_RETENTION_POLICIES for %s jobs", jobType)
+		}
+		policies.JobTypes[jobType] = p
+	}
+	return policies, nil
+}
+
+// reapableRunsCondition returns an SQL condition, along with its arguments,
+// selecting the finished runs which the policies no longer keep. It expects
+// pipeline_runs to be left joined with jobs.
+func (ps RetentionPolicies) reapableRunsCondition(now time.Time) (string, []interface{}) {
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	policyCondition := func(p RetentionPolicy) string {
+		completed := fmt.Sprintf("pipeline_runs.state <> 'errored' AND pipeline_runs.finished_at < %s", arg(now.Add(-p.Completed)))
+		if p.sampling() {
+			completed += fmt.Sprintf(" AND (pipeline_runs.finished_at < %s OR pipeline_runs.id %% %s <> 0)", arg(now.Add(-p.Sampled)), arg(int64(p.SampleEvery)))
+		}
+		errored := fmt.Sprintf("pipeline_runs.state = 'errored' AND pipeline_runs.finished_at < %s", arg(now.Add(-p.Errored)))
+		return fmt.Sprintf("((%s) OR (%s))", completed, errored)
+	}
+
+	// Sorted for a stable query
+	jobTypes := make([]string, 0, len(ps.JobTypes))
+	for jobType := range ps.JobTypes {
+		jobTypes = append(jobTypes, jobType)
+	}
+	sort.Strings(jobTypes)
+
+	conditions := make([]string, 0, len(jobTypes)+1)
+	for _, jobType := range jobTypes {
+		conditions = append(conditions, fmt.Sprintf("(jobs.type = %s AND %s)", arg(jobType), policyCondition(ps.JobTypes[jobType])))
+	}
+	if len(jobTypes) == 0 {
+		conditions = append(conditions, policyCondition(ps.Default))
+	} else {
+		conditions = append(conditions, fmt.Sprintf("((jobs.type IS NULL OR jobs.type <> ALL(%s)) AND %s)", arg(jobTypes), policyCondition(ps.Default)))
+	}
+	return "pipeline_runs.finished_at IS NOT NULL AND (" + strings.Join(conditions, " OR ") + ")", args
+}
@@ -0,0 +1,65 @@
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func newRetentionConfig(t *testing.T, threshold, erroredThreshold time.Duration, policies string) *pipelinemocks.Config {
+	cfg := new(pipelinemocks.Config)
+	cfg.Test(t)
+	cfg.On("JobPipelineReaperThreshold").Return(threshold)
+	cfg.On("JobPipelineReaperErroredThreshold").Return(erroredThreshold)
+	cfg.On("JobPipelineRetentionPolicies").Return(policies)
+	return cfg
+}
+
+func Test_NewRetentionPolicies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults to the reaper threshold", func(t *testing.T) {
+		policies, err := pipeline.NewRetentionPolicies(newRetentionConfig(t, 24*time.Hour, 0, ""))
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RetentionPolicy{Completed: 24 * time.Hour, Errored: 24 * time.Hour}, policies.Default)
+		assert.Empty(t, policies.JobTypes)
+	})
+
+	t.Run("keeps errored runs for the errored threshold", func(t *testing.T) {
+		policies, err := pipeline.NewRetentionPolicies(newRetentionConfig(t, 24*time.Hour, 7*24*time.Hour, ""))
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RetentionPolicy{Completed: 24 * time.Hour, Errored: 7 * 24 * time.Hour}, policies.Default)
+	})
+
+	t.Run("job type policies inherit omitted fields from the default", func(t *testing.T) {
+		policies, err := pipeline.NewRetentionPolicies(newRetentionConfig(t, 24*time.Hour, 48*time.Hour, `{
+			"offchainreporting": {"completed": "1h", "sampleEvery": 10, "sampled": "720h"},
+			"webhook": {"errored": "168h"}
+		}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, pipeline.RetentionPolicy{Completed: 24 * time.Hour, Errored: 48 * time.Hour}, policies.Default)
+		require.Len(t, policies.JobTypes, 2)
+		assert.Equal(t, pipeline.RetentionPolicy{Completed: time.Hour, Errored: 48 * time.Hour, SampleEvery: 10, Sampled: 720 * time.Hour}, policies.JobTypes["offchainreporting"])
+		assert.Equal(t, pipeline.RetentionPolicy{Completed: 24 * time.Hour, Errored: 168 * time.Hour}, policies.JobTypes["webhook"])
+	})
+
+	t.Run("errors on invalid JSON", func(t *testing.T) {
+		_, err := pipeline.NewRetentionPolicies(newRetentionConfig(t, 24*time.Hour, 0, `{"webhook": {"completed": 3600}}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid JOB_PIPELINE_RETENTION_POLICIES")
+	})
+
+	t.Run("errors if samples are kept for less time than completed runs", func(t *testing.T) {
+		_, err := pipeline.NewRetentionPolicies(newRetentionConfig(t, 24*time.Hour, 0, `{"webhook": {"sampleEvery": 10, "sampled": "1h"}}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "for webhook jobs")
+	})
+}
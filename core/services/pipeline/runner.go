@@ -11,6 +11,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
@@ -18,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/recovery"
 	"github.com/smartcontractkit/chainlink/core/services"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -36,6 +40,9 @@ type Runner interface {
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
+	// ExecuteReplay executes a new run in-memory like ExecuteRun, but in a sandbox which never submits transactions.
+	// It is used to replay historical runs, and errors without executing any task if the spec has an async bridge task.
+	ExecuteReplay(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...pg.QOpt) error
 
@@ -44,7 +51,14 @@ type Runner interface {
 	// Note that the spec MUST have a DOT graph for this to work.
 	ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, saveSuccessfulTaskRuns bool) (runID int64, finalResult FinalResult, err error)
 
-	OnRunFinished(func(*Run))
+	// OnRunFinished registers fn to be called each time a run has been
+	// persisted. The returned function unregisters it. Callbacks are invoked
+	// synchronously and must not block.
+	OnRunFinished(fn func(*Run)) (unregister func())
+	// ForgetJob deletes the metrics of a deleted job.
+	ForgetJob(jobID int32)
+	// ForgetBridge deletes the metrics of a deleted bridge.
+	ForgetBridge(name string)
 }
 
 type runner struct {
@@ -53,11 +67,16 @@ type runner struct {
 	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	streams         StreamCache
+	metrics         *metrics
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
+	// stub, if set, is called in place of running each task
+	stub TaskStub
 
-	// test helper
-	runFinished func(*Run)
+	runFinishedMu     sync.RWMutex
+	runFinishedHooks  map[int]func(*Run)
+	runFinishedNextID int
 
 	utils.StartStopOnce
 	chStop chan struct{}
@@ -92,19 +111,24 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "status"},
 	)
+
+	tracer = tracing.Tracer("pipeline")
 )
 
-func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) *runner {
+func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, streams StreamCache, lggr logger.Logger) *runner {
 	r := &runner{
 		orm:         orm,
 		config:      config,
 		chainSet:    chainSet,
 		ethKeyStore: ethks,
 		vrfKeyStore: vrfks,
+		streams:     streams,
+		metrics:     newMetrics(config),
 		chStop:      make(chan struct{}),
 		wgDone:      sync.WaitGroup{},
-		runFinished: func(*Run) {},
 		lggr:        lggr.Named("PipelineRunner"),
+
+		runFinishedHooks: make(map[int]func(*Run)),
 	}
 	r.runReaperWorker = utils.NewSleeperTask(
 		utils.SleeperFuncTask(r.runReaper, "PipelineRunnerReaper"),
@@ -114,6 +138,9 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 
 func (r *runner) Start() error {
 	return r.StartOnce("PipelineRunner", func() error {
+		if _, err := NewRetentionPolicies(r.config); err != nil {
+			return err
+		}
 		r.wgDone.Add(2)
 		go r.scheduleUnfinishedRuns()
 		go r.runReaperLoop()
@@ -182,8 +209,33 @@ func NewRun(spec Spec, vars Vars) Run {
 	}
 }
 
-func (r *runner) OnRunFinished(fn func(*Run)) {
-	r.runFinished = fn
+func (r *runner) OnRunFinished(fn func(*Run)) (unregister func()) {
+	r.runFinishedMu.Lock()
+	defer r.runFinishedMu.Unlock()
+	id := r.runFinishedNextID
+	r.runFinishedNextID++
+	r.runFinishedHooks[id] = fn
+	return func() {
+		r.runFinishedMu.Lock()
+		defer r.runFinishedMu.Unlock()
+		delete(r.runFinishedHooks, id)
+	}
+}
+
+func (r *runner) ForgetJob(jobID int32) {
+	r.metrics.ForgetJob(jobID)
+}
+
+func (r *runner) ForgetBridge(name string) {
+	r.metrics.ForgetBridge(name)
+}
+
+func (r *runner) runFinished(run *Run) {
+	r.runFinishedMu.RLock()
+	defer r.runFinishedMu.RUnlock()
+	for _, fn := range r.runFinishedHooks {
+		fn(run)
+	}
 }
 
 // Be careful with the ctx passed in here: it applies to requests in individual
@@ -194,6 +246,14 @@ func (r *runner) ExecuteRun(
 	vars Vars,
 	l logger.Logger,
 ) (Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, l, false)
+}
+
+func (r *runner) ExecuteReplay(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (Run, TaskRunResults, error) {
+	return r.executeRun(ctx, spec, vars, l, true)
+}
+
+func (r *runner) executeRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger, sandboxed bool) (Run, TaskRunResults, error) {
 	run := NewRun(spec, vars)
 
 	pipeline, err := r.initializePipeline(&run)
@@ -201,8 +261,21 @@ func (r *runner) ExecuteRun(
 	if err != nil {
 		return run, nil, err
 	}
+	m := r.metrics
+	if sandboxed {
+		// Async bridges would be called before the run is suspended, so they
+		// are rejected before anything is executed
+		for _, task := range pipeline.Tasks {
+			if bt, ok := task.(*BridgeTask); ok && bt.Async == "true" {
+				return run, nil, errors.Errorf("cannot replay run for spec ID %v with async bridge task %s", spec.ID, task.DotID())
+			}
+		}
+		sandbox(pipeline)
+		// Replays are not runs of the job, so they are left out of its metrics
+		m = nil
+	}
 
-	taskRunResults, err := r.run(ctx, pipeline, &run, vars, l)
+	taskRunResults, err := r.run(ctx, pipeline, &run, vars, l, m)
 	if err != nil {
 		return run, nil, err
 	}
@@ -229,7 +302,10 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 			task.(*HTTPTask).config = r.config
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
-			task.(*BridgeTask).queryer = r.orm.GetQ()
+			task.(*BridgeTask).metrics = r.metrics
+			if r.orm != nil {
+				task.(*BridgeTask).queryer = r.orm.GetQ()
+			}
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -242,6 +318,8 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		case TaskTypeETHTx:
 			task.(*ETHTxTask).keyStore = r.ethKeyStore
 			task.(*ETHTxTask).chainSet = r.chainSet
+		case TaskTypeStream:
+			task.(*StreamTask).streams = r.streams
 		default:
 		}
 	}
@@ -255,15 +333,36 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 	return pipeline, nil
 }
 
+// sandbox prevents the tasks of pipeline from having any effect on chain
+func sandbox(pipeline *Pipeline) {
+	for _, task := range pipeline.Tasks {
+		switch t := task.(type) {
+		case *ETHTxTask:
+			t.sandboxed = true
+		case *BridgeTask:
+			t.metrics = nil
+		}
+	}
+}
+
+// run executes pipeline, recording the run in m unless it is nil.
 func (r *runner) run(
 	ctx context.Context,
 	pipeline *Pipeline,
 	run *Run,
 	vars Vars,
 	l logger.Logger,
+	m *metrics,
 ) (TaskRunResults, error) {
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
+	ctx, span := tracer.Start(ctx, "pipeline.Run", trace.WithAttributes(
+		attribute.Int64("job.id", int64(run.PipelineSpec.JobID)),
+		attribute.String("job.name", run.PipelineSpec.JobName),
+		attribute.Int64("pipeline_run.id", run.ID),
+	))
+	defer span.End()
+
 	scheduler := newScheduler(pipeline, run, vars, l)
 	go scheduler.Run()
 
@@ -283,7 +382,10 @@ func (r *runner) run(
 		go recovery.WrapRecoverHandle(l, func() {
 			result := r.executeTaskRun(ctx, run.PipelineSpec, taskRun, l)
 
-			logTaskRunToPrometheus(result, run.PipelineSpec)
+			if m != nil {
+				logTaskRunToPrometheus(result, run.PipelineSpec)
+			}
+			m.TaskFinished(run.PipelineSpec, result)
 
 			scheduler.report(reportCtx, result)
 		}, func(err interface{}) {
@@ -309,7 +411,9 @@ func (r *runner) run(
 		// NOTE: runTime can be very long now because it'll include suspend
 		runTime := run.FinishedAt.Time.Sub(run.CreatedAt)
 		l.Debugw("Finished all tasks for pipeline run", "specID", run.PipelineSpecID, "runTime", runTime)
-		PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		if m != nil {
+			PromPipelineRunTotalTimeToCompletion.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Set(float64(runTime))
+		}
 	}
 
 	// Update run results
@@ -356,17 +460,23 @@ func (r *runner) run(
 
 		if run.HasFatalErrors() {
 			run.State = RunStatusErrored
-			PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			span.SetStatus(codes.Error, "pipeline run errored")
+			if m != nil {
+				PromPipelineRunErrors.WithLabelValues(fmt.Sprintf("%d", run.PipelineSpec.JobID), run.PipelineSpec.JobName).Inc()
+			}
 		} else {
 			run.State = RunStatusCompleted
 		}
 	}
 
+	span.SetAttributes(attribute.String("pipeline_run.state", string(run.State)))
+
 	// TODO: drop this once we stop using TaskRunResults
 	var taskRunResults TaskRunResults
 	for _, result := range scheduler.results {
 		taskRunResults = append(taskRunResults, result)
 	}
+	m.RunFinished(run, taskRunResults)
 
 	return taskRunResults, nil
 }
@@ -398,7 +508,24 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 		defer cancel()
 	}
 
-	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	ctx, span := tracer.Start(ctx, "pipeline.Task", trace.WithAttributes(
+		attribute.String("task.type", string(taskRun.task.Type())),
+		attribute.String("task.dot_id", taskRun.task.DotID()),
+		attribute.Int("task.attempt", int(taskRun.attempts)),
+	))
+	defer span.End()
+
+	var result Result
+	var runInfo RunInfo
+	stubbed := false
+	if r.stub != nil {
+		result, stubbed = r.stub(ctx, taskRun.task, taskRun.vars, taskRun.inputs)
+	}
+	if !stubbed {
+		result, runInfo = taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	}
+	tracing.RecordError(span, result.Error)
+	span.SetAttributes(attribute.Bool("task.pending", runInfo.IsPending))
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -457,6 +584,7 @@ func (r *runner) ExecuteAndInsertFinishedRun(ctx context.Context, spec Spec, var
 	if err = r.orm.InsertFinishedRun(&run, saveSuccessfulTaskRuns); err != nil {
 		return 0, finalResult, errors.Wrapf(err, "error inserting finished results for spec ID %v", spec.ID)
 	}
+	r.runFinished(&run)
 	return run.ID, finalResult, nil
 
 }
@@ -504,7 +632,7 @@ func (r *runner) Run(ctx context.Context, run *Run, l logger.Logger, saveSuccess
 	}
 
 	for {
-		if _, err = r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l); err != nil {
+		if _, err = r.run(ctx, pipeline, run, NewVarsFrom(run.Inputs.Val.(map[string]interface{})), l, r.metrics); err != nil {
 			return false, errors.Wrapf(err, "failed to run for spec ID %v", run.PipelineSpec.ID)
 		}
 
@@ -579,12 +707,47 @@ func (r *runner) runReaper() {
 	ctx, cancel := utils.CombinedContext(context.Background(), r.chStop)
 	defer cancel()
 
-	err := r.orm.DeleteRunsOlderThan(ctx, r.config.JobPipelineReaperThreshold())
+	policies, err := NewRetentionPolicies(r.config)
+	if err != nil {
+		r.lggr.Errorw("Pipeline run reaper failed", "error", err)
+		return
+	}
+
+	// Runs are archived to a new file on each pass, created once there are
+	// runs to archive
+	var archive func([]ArchivedRun) error
+	var archiveWriter *ArchiveWriter
+	if dir := r.config.JobPipelineArchiveDir(); dir != "" {
+		archive = func(runs []ArchivedRun) error {
+			if archiveWriter == nil {
+				w, cerr := CreateArchive(dir, time.Now())
+				if cerr != nil {
+					return cerr
+				}
+				archiveWriter = w
+			}
+			return archiveWriter.Write(runs)
+		}
+		defer func() {
+			if archiveWriter == nil {
+				return
+			}
+			if cerr := archiveWriter.Close(); cerr != nil {
+				r.lggr.Errorw("Failed to close pipeline run archive", "path", archiveWriter.Path(), "error", cerr)
+			} else {
+				r.lggr.Infow("Archived pipeline runs", "path", archiveWriter.Path(), "runs", archiveWriter.Count())
+			}
+		}()
+	}
+
+	deleted, err := r.orm.ReapRuns(ctx, policies, archive)
 	if ctx.Err() != nil {
 		return
 	} else if err != nil {
-		r.lggr.Errorw("Pipeline run reaper failed", "error", err)
+		r.lggr.Errorw("Pipeline run reaper failed", "error", err, "deleted", deleted)
+		return
 	}
+	r.lggr.Debugw("Pipeline run reaper finished", "deleted", deleted)
 }
 
 // init task: Searches the database for runs stuck in the 'running' state while the node was previously killed.
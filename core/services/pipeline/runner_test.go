@@ -16,14 +16,17 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/shopspring/decimal"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	keystoremocks "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
@@ -39,7 +42,7 @@ func newRunner(t testing.TB, db *sqlx.DB, cfg *configtest.TestGeneralConfig) (pi
 
 	orm.On("GetQ").Return(q)
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, logger.TestLogger(t))
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, nil, logger.TestLogger(t))
 	return r, orm
 }
 
@@ -438,7 +441,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg})
 	ethKeyStore := cltest.NewKeyStore(t, db, cfg).Eth()
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, lggr)
+	r := pipeline.NewRunner(orm, cfg, cc, ethKeyStore, nil, nil, lggr)
 
 	spec := pipeline.Spec{DotDagSource: `
 fail_but_i_dont_care [type=fail]
@@ -790,3 +793,63 @@ a [type=uppercase input="$(first)"]
 	require.NoError(t, err)
 	assert.Equal(t, "SOMERANDOMTEST", result.Value.(string))
 }
+
+func Test_PipelineRunner_ExecuteReplay_NeverSubmitsTransactions(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewTestGeneralConfig(t)
+	keyStore := new(keystoremocks.Eth)
+	keyStore.Test(t)
+	txManager := new(bptxmmocks.TxManager)
+	txManager.Test(t)
+	cc := evmtest.NewChainSet(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, TxManager: txManager, KeyStore: keyStore})
+	lggr := logger.TestLogger(t)
+	r := pipeline.NewRunner(new(mocks.ORM), cfg, cc, keyStore, nil, nil, lggr)
+
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	keyStore.On("GetRoundRobinAddress", from).Return(from, nil)
+
+	spec := pipeline.Spec{
+		DotDagSource: `
+tx [type=ethtx from=<["0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c"]> to="0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF" data="foobar" gasLimit=12345 minConfirmations=1];
+`,
+	}
+	run, trrs, err := r.ExecuteReplay(context.Background(), spec, pipeline.NewVarsFrom(nil), lggr)
+	require.NoError(t, err)
+	require.False(t, run.Pending)
+	require.Len(t, trrs, 1)
+	require.NoError(t, trrs[0].Result.Error)
+	assert.Equal(t, map[string]interface{}{
+		"from":     from.Hex(),
+		"to":       "0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF",
+		"data":     hexutil.Encode([]byte("foobar")),
+		"gasLimit": uint64(12345),
+	}, trrs[0].Result.Value)
+
+	txManager.AssertNotCalled(t, "CreateEthTransaction", mock.Anything)
+	keyStore.AssertExpectations(t)
+}
+
+func Test_PipelineRunner_ExecuteReplay_RejectsAsyncBridges(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	var called atomic.Bool
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called.Store(true)
+		fakeStringResponder(t, `{"data":{"result":1}}`).ServeHTTP(w, r)
+	}))
+	defer s.Close()
+	_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL}, cfg)
+
+	r, _ := newRunner(t, db, cfg)
+	spec := pipeline.Spec{
+		DotDagSource: fmt.Sprintf(`
+ds1 [type=http method="GET" url="%s"];
+ds2 [type=bridge async=true name="%s"];
+`, s.URL, bt.Name.String()),
+	}
+	_, _, err := r.ExecuteReplay(context.Background(), spec, pipeline.NewVarsFrom(nil), logger.TestLogger(t))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "async bridge task ds2")
+	assert.False(t, called.Load())
+}
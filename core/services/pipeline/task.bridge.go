@@ -3,15 +3,19 @@ package pipeline
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"net/url"
 	"path"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 )
 
 //
@@ -28,6 +32,7 @@ type BridgeTask struct {
 
 	queryer pg.Queryer
 	config  Config
+	metrics *metrics
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -58,10 +63,11 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		return Result{Error: err}, runInfo
 	}
 
-	url, err := t.getBridgeURLFromName(name)
+	bt, err := t.getBridgeFromName(name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
 
 	var metaMap MapParam
 
@@ -108,7 +114,25 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	requestCtx, cancel := httpRequestCtx(ctx, t, t.config)
 	defer cancel()
 
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), requestData, allowUnrestrictedNetworkAccess, t.config.DefaultHTTPLimit())
+	// Propagate the trace context so that external adapters can continue the trace
+	requestHeaders := make(http.Header)
+	tracing.InjectHTTPHeaders(ctx, requestHeaders)
+
+	// Only bridges which opted in are sent their outgoing token, so that
+	// existing bridges do not suddenly receive it
+	if bt.SendOutgoingToken {
+		token, err := t.outgoingToken(requestCtx, bt)
+		if err != nil {
+			return Result{Error: err}, RunInfo{IsRetryable: true}
+		}
+		if token != "" {
+			requestHeaders.Set("Authorization", "Bearer "+token)
+		}
+	}
+
+	start := time.Now()
+	responseBytes, statusCode, headers, _, err := makeHTTPRequest(requestCtx, lggr, "POST", URLParam(url), requestData, requestHeaders, allowUnrestrictedNetworkAccess, t.config.DefaultHTTPLimit())
+	t.metrics.BridgeCalled(string(name), time.Since(start), len(responseBytes), err)
 	if err != nil {
 		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
 	}
@@ -133,9 +157,6 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	// value instead.
 	result = Result{Value: string(responseBytes)}
 
-	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
-	promHTTPResponseBodySize.WithLabelValues(t.DotID()).Set(float64(len(responseBytes)))
-
 	lggr.Debugw("Bridge task: fetched answer",
 		"answer", result.Value,
 		"url", url.String(),
@@ -144,13 +165,31 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	return result, runInfo
 }
 
-func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
+func (t BridgeTask) getBridgeFromName(name StringParam) (bridges.BridgeType, error) {
 	var bt bridges.BridgeType
 	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bt, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+	}
+	return bt, nil
+}
+
+// outgoingToken returns the token which authenticates the node to the bridge,
+// resolving it if it is a secret reference so that rotated secrets are picked
+// up without restarting the node.
+func (t BridgeTask) outgoingToken(ctx context.Context, bt bridges.BridgeType) (string, error) {
+	if !secrets.IsRef(bt.OutgoingToken) {
+		return bt.OutgoingToken, nil
+	}
+	// Bridges created before references were restricted may hold any
+	if err := secrets.CheckBridgeRef(bt.OutgoingToken); err != nil {
+		return "", errors.Wrapf(err, "could not resolve outgoing token of bridge '%s'", bt.Name)
+	}
+	token, err := t.config.SecretsResolver().Resolve(ctx, bt.OutgoingToken)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not resolve outgoing token of bridge '%s'", bt.Name)
 	}
-	return URLParam(bt.URL), nil
+	return token, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -9,6 +9,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -584,6 +586,63 @@ func TestBridgeTask_ErrorIfBridgeMissing(t *testing.T) {
 	assert.Contains(t, result.Error.Error(), "could not find bridge with name 'foo'")
 }
 
+func TestBridgeTask_OutgoingToken(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "bridges"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bridges", "token"), []byte("token-from-secret\n"), 0600))
+	t.Setenv("SECRETS_FILE_DIR", dir)
+	t.Setenv("CL_TEST_BRIDGE_TOKEN", "token-from-env")
+
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+
+	tests := []struct {
+		name          string
+		outgoingToken string
+		send          bool
+		authorization string
+		wantError     bool
+	}{
+		{"plain", "plain-token", true, "Bearer plain-token", false},
+		{"secret", "secret://file/bridges/token", true, "Bearer token-from-secret", false},
+		{"missing secret", "secret://file/bridges/missing", true, "", true},
+		{"secret outside of bridges", "secret://env/CL_TEST_BRIDGE_TOKEN", true, "", true},
+		{"not opted in", "plain-token", false, "", false},
+		{"not opted in with missing secret", "secret://file/bridges/missing", false, "", false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var authorization atomic.String
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				authorization.Store(r.Header.Get("Authorization"))
+				fakeStringResponder(t, `{"data":{"result":1}}`).ServeHTTP(w, r)
+			}))
+			defer s.Close()
+
+			_, bridge := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: s.URL, OutgoingToken: test.outgoingToken, SendOutgoingToken: test.send}, cfg)
+
+			task := pipeline.BridgeTask{
+				BaseTask:    pipeline.NewBaseTask(0, "bridge", nil, nil, 0),
+				Name:        bridge.Name.String(),
+				RequestData: btcUSDPairing,
+			}
+			task.HelperSetDependencies(cfg, db, uuid.UUID{})
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			if test.wantError {
+				require.Error(t, result.Error)
+				assert.Contains(t, result.Error.Error(), "could not resolve outgoing token")
+				assert.True(t, runInfo.IsRetryable)
+				return
+			}
+			require.NoError(t, result.Error)
+			assert.Equal(t, test.authorization, authorization.Load())
+		})
+	}
+}
+
 // Sample input taken from
 // https://github.com/smartcontractkit/price-adapters#chainlink-price-request-adapters
 func TestAdapterResponse_UnmarshalJSON_Happy(t *testing.T) {
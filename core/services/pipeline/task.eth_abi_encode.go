@@ -39,7 +39,7 @@ func (t *ETHABIEncodeTask) Run(_ context.Context, _ logger.Logger, vars Vars, in
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&inputValues, From(VarExpr(t.Data, vars), JSONWithVarExprs(t.Data, vars, false), nil)), "data"),
-		errors.Wrap(ResolveParam(&theABI, From(NonemptyString(t.ABI))), "abi"),
+		errors.Wrap(ResolveParam(&theABI, From(VarExpr(t.ABI, vars), NonemptyString(t.ABI))), "abi"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
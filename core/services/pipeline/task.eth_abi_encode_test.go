@@ -45,6 +45,21 @@ func TestETHABIEncodeTask(t *testing.T) {
 			nil,
 			"",
 		},
+		{
+			"abi from a variable",
+			"$(theABI)",
+			`{ "b": $(foo), "bs": $(bar), "a": $(baz) }`,
+			pipeline.NewVarsFrom(map[string]interface{}{
+				"theABI": "asdf(bytes32 b, bytes bs, address a)",
+				"foo":    bytes32,
+				"bar":    []byte("stevetoshi sergeymoto"),
+				"baz":    common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"),
+			}),
+			nil,
+			"0x4f5e7a89636861696e6c696e6b20636861696e6c696e6b20636861696e6c696e6b0000000000000000000000000000000000000000000000000000000000000000000060000000000000000000000000deadbeefdeadbeefdeadbeefdeadbeefdeadbeef00000000000000000000000000000000000000000000000000000000000000157374657665746f736869207365726765796d6f746f0000000000000000000000",
+			nil,
+			"",
+		},
 		{
 			"bytes32, bytes, address",
 			"asdf(bytes32 b, bytes bs, address a)",
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
@@ -14,11 +15,13 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
 )
 
 //
 // Return types:
 //     nil
+//     map[string]interface{} (describing the transaction, when sandboxed)
 //
 type ETHTxTask struct {
 	BaseTask         `mapstructure:",squash"`
@@ -33,6 +36,8 @@ type ETHTxTask struct {
 
 	keyStore ETHKeyStore
 	chainSet evm.ChainSet
+	// sandboxed tasks describe the transaction instead of submitting it
+	sandboxed bool
 }
 
 //go:generate mockery --name ETHKeyStore --output ./mocks/ --case=underscore
@@ -47,7 +52,7 @@ func (t *ETHTxTask) Type() TaskType {
 	return TaskTypeETHTx
 }
 
-func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
 	var chainID StringParam
 	err := errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.EVMChainID, vars), NonemptyString(t.EVMChainID), "")), "evmChainID")
 	if err != nil {
@@ -98,6 +103,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	txMeta.TraceContext = tracing.Carrier(ctx)
 
 	transmitChecker, err := decodeTransmitChecker(transmitCheckerMap)
 	if err != nil {
@@ -130,6 +136,16 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		newTx.MinConfirmations = null.Uint32From(uint32(minOutgoingConfirmations))
 	}
 
+	if t.sandboxed {
+		lggr.Infow("Not submitting transaction of sandboxed run", "from", fromAddr, "to", newTx.ToAddress, "gasLimit", newTx.GasLimit)
+		return Result{Value: map[string]interface{}{
+			"from":     fromAddr.Hex(),
+			"to":       newTx.ToAddress.Hex(),
+			"data":     hexutil.Encode(newTx.EncodedPayload),
+			"gasLimit": newTx.GasLimit,
+		}}, runInfo
+	}
+
 	_, err = txManager.CreateEthTransaction(newTx)
 	if err != nil {
 		return Result{Error: errors.Wrapf(ErrTaskRunFailed, "while creating transaction: %v", err)}, retryableRunInfo()
@@ -0,0 +1,106 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//    map[string]interface{}{
+//        "values": []interface{}, // the values which were kept, in their original order
+//        "dropped": []interface{},
+//        "median": decimal.Decimal,
+//        "mad": decimal.Decimal,
+//    }
+//
+// Values are dropped as outliers if their modified z-score, 0.6745 * |value -
+// median| / MAD, is greater than threshold, where MAD is the median absolute
+// deviation of the values from their median. If more than half of the values
+// are equal, the MAD is zero and every value which differs from the median is
+// dropped.
+//
+type OutlierFilterTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Threshold     string `json:"threshold"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*OutlierFilterTask)(nil)
+
+var (
+	defaultOutlierThreshold = decimal.RequireFromString("3.5")
+	// madScale makes the MAD consistent with the standard deviation of
+	// normally distributed values
+	madScale = decimal.RequireFromString("0.6745")
+)
+
+func (t *OutlierFilterTask) Type() TaskType {
+	return TaskTypeOutlierFilter
+}
+
+func (t *OutlierFilterTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		threshold          DecimalParam
+		valuesAndErrs      SliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&threshold, From(VarExpr(t.Threshold, vars), NonemptyString(t.Threshold), defaultOutlierThreshold)), "threshold"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if !threshold.Decimal().IsPositive() {
+		return Result{Error: errors.Wrapf(ErrBadInput, "threshold: must be positive, got %v", threshold.Decimal())}, runInfo
+	}
+
+	values, dropped, err := aggregationValues(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	decimals := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		decimals[i] = v.value
+	}
+	m := median(decimals)
+	deviations := make([]decimal.Decimal, len(values))
+	for i, v := range values {
+		deviations[i] = v.value.Sub(m).Abs()
+	}
+	mad := median(deviations)
+
+	kept := []interface{}{}
+	for i, v := range values {
+		var outlier bool
+		if mad.IsZero() {
+			outlier = !deviations[i].IsZero()
+		} else {
+			outlier = madScale.Mul(deviations[i]).Div(mad).GreaterThan(threshold.Decimal())
+		}
+		if outlier {
+			dropped = append(dropped, droppedValue(v.index, v.value, DropReasonOutlier))
+		} else {
+			kept = append(kept, v.value)
+		}
+	}
+
+	if dropped == nil {
+		dropped = []interface{}{}
+	}
+	return Result{Value: map[string]interface{}{
+		"values":  kept,
+		"dropped": dropped,
+		"median":  m,
+		"mad":     mad,
+	}}, runInfo
+}
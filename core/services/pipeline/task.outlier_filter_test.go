@@ -0,0 +1,179 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestOutlierFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		threshold     string
+		allowedFaults string
+		wantValues    []string
+		wantDropped   []int
+		wantMedian    string
+		wantMAD       string
+		wantErr       error
+	}{
+		{
+			"keeps values without outliers",
+			[]pipeline.Result{{Value: mustDecimal(t, "10")}, {Value: mustDecimal(t, "11")}, {Value: mustDecimal(t, "12")}},
+			"",
+			"",
+			[]string{"10", "11", "12"},
+			nil,
+			"11",
+			"1",
+			nil,
+		},
+		{
+			"drops outliers",
+			[]pipeline.Result{{Value: mustDecimal(t, "10")}, {Value: mustDecimal(t, "11")}, {Value: mustDecimal(t, "1000")}, {Value: mustDecimal(t, "12")}, {Value: mustDecimal(t, "9")}},
+			"",
+			"",
+			[]string{"10", "11", "12", "9"},
+			[]int{2},
+			"11",
+			"1",
+			nil,
+		},
+		{
+			"lower threshold drops more values",
+			[]pipeline.Result{{Value: mustDecimal(t, "10")}, {Value: mustDecimal(t, "11")}, {Value: mustDecimal(t, "14")}, {Value: mustDecimal(t, "12")}, {Value: mustDecimal(t, "9")}},
+			"1.5",
+			"",
+			[]string{"10", "11", "12", "9"},
+			[]int{2},
+			"11",
+			"1",
+			nil,
+		},
+		{
+			"zero MAD drops any value differing from the median",
+			[]pipeline.Result{{Value: mustDecimal(t, "5")}, {Value: mustDecimal(t, "5")}, {Value: mustDecimal(t, "5")}, {Value: mustDecimal(t, "6")}},
+			"",
+			"",
+			[]string{"5", "5", "5"},
+			[]int{3},
+			"5",
+			"0",
+			nil,
+		},
+		{
+			"errored values are dropped",
+			[]pipeline.Result{{Value: mustDecimal(t, "10")}, {Error: errors.New("timeout")}, {Value: mustDecimal(t, "12")}},
+			"",
+			"1",
+			[]string{"10", "12"},
+			[]int{1},
+			"11",
+			"1",
+			nil,
+		},
+		{
+			"more errors than allowed",
+			[]pipeline.Result{{Error: errors.New("timeout")}, {Error: errors.New("timeout")}, {Value: mustDecimal(t, "3")}},
+			"",
+			"1",
+			nil,
+			nil,
+			"",
+			"",
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"zero inputs",
+			[]pipeline.Result{},
+			"",
+			"0",
+			nil,
+			nil,
+			"",
+			"",
+			pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"invalid threshold",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}},
+			"0",
+			"",
+			nil,
+			nil,
+			"",
+			"",
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.OutlierFilterTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Threshold:     test.threshold,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			value := output.Value.(map[string]interface{})
+
+			var values []string
+			for _, v := range value["values"].([]interface{}) {
+				values = append(values, v.(decimal.Decimal).String())
+			}
+			assert.Equal(t, test.wantValues, values)
+
+			var dropped []int
+			for _, d := range value["dropped"].([]interface{}) {
+				dropped = append(dropped, d.(map[string]interface{})["index"].(int))
+			}
+			assert.Equal(t, test.wantDropped, dropped)
+			assert.Equal(t, test.wantMedian, value["median"].(decimal.Decimal).String())
+			assert.Equal(t, test.wantMAD, value["mad"].(decimal.Decimal).String())
+		})
+	}
+}
+
+func TestOutlierFilter_FeedsAggregationTasks(t *testing.T) {
+	t.Parallel()
+
+	p, err := pipeline.Parse(`
+	filter [type=outlierfilter values=<[ $(a), $(b), $(c), $(d) ]> threshold=3];
+	answer [type=median values="$(filter.values)"];
+	filter -> answer;
+	`)
+	require.NoError(t, err)
+	filter, ok := p.ByDotID("filter").(*pipeline.OutlierFilterTask)
+	require.True(t, ok)
+	assert.Equal(t, "3", filter.Threshold)
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"a": "10", "b": "11", "c": "1000", "d": "12"})
+	filtered, _ := filter.Run(context.Background(), logger.TestLogger(t), vars, nil)
+	require.NoError(t, filtered.Error)
+	vars.Set("filter", filtered.Value)
+
+	answer, _ := p.ByDotID("answer").Run(context.Background(), logger.TestLogger(t), vars, []pipeline.Result{filtered})
+	require.NoError(t, answer.Error)
+	assert.Equal(t, "11", answer.Value.(decimal.Decimal).String())
+}
@@ -0,0 +1,62 @@
+package pipeline
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//    the latest value received from the stream, as extracted by the path
+//    configured for its source
+//
+// MaxAge bounds how old the value may be, defaulting to the max staleness of
+// the source.
+//
+type StreamTask struct {
+	BaseTask `mapstructure:",squash"`
+	Source   string        `json:"source"`
+	MaxAge   time.Duration `json:"maxAge"`
+
+	streams StreamCache
+}
+
+//go:generate mockery --name StreamCache --output ./mocks/ --case=underscore
+
+// StreamCache holds the latest values received from the WebSocket and gRPC
+// streams the node subscribes to.
+type StreamCache interface {
+	// Latest returns the latest value received from source, erroring if it is
+	// older than maxAge. A zero maxAge uses the max staleness of the source.
+	Latest(source string, maxAge time.Duration) (interface{}, error)
+}
+
+var _ Task = (*StreamTask)(nil)
+
+func (t *StreamTask) Type() TaskType {
+	return TaskTypeStream
+}
+
+func (t *StreamTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var source StringParam
+	err := errors.Wrap(ResolveParam(&source, From(NonemptyString(t.Source))), "source")
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if t.MaxAge < 0 {
+		return Result{Error: errors.Wrapf(ErrBadInput, "maxAge: must not be negative, got %v", t.MaxAge)}, runInfo
+	}
+	if t.streams == nil {
+		return Result{Error: errors.New("streams are not available")}, runInfo
+	}
+
+	value, err := t.streams.Latest(string(source), t.MaxAge)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
@@ -0,0 +1,79 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+func TestStreamTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		source        string
+		maxAge        time.Duration
+		latest        interface{}
+		latestErr     error
+		expected      interface{}
+		expectedErr   error
+		expectedCause string
+	}{
+		{"latest value", "binance-ethusd", 0, decimal.RequireFromString("3021.55"), nil, decimal.RequireFromString("3021.55"), nil, ""},
+		{"with max age", "binance-ethusd", 5 * time.Second, "foo", nil, "foo", nil, ""},
+		{"stale value", "binance-ethusd", 0, nil, errors.New("latest value of stream source binance-ethusd is stale"), nil, nil, "is stale"},
+		{"missing source", "", 0, nil, nil, nil, pipeline.ErrParameterEmpty, "source"},
+		{"negative max age", "binance-ethusd", -time.Second, nil, nil, nil, pipeline.ErrBadInput, "maxAge"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			streams := new(pipelinemocks.StreamCache)
+			if test.latest != nil || test.latestErr != nil {
+				streams.On("Latest", "binance-ethusd", test.maxAge).Return(test.latest, test.latestErr).Once()
+			}
+
+			task := pipeline.StreamTask{
+				BaseTask: pipeline.NewBaseTask(0, "stream", nil, nil, 0),
+				Source:   test.source,
+				MaxAge:   test.maxAge,
+			}
+			task.HelperSetDependencies(streams)
+
+			result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.expectedCause != "" {
+				require.Error(t, result.Error)
+				if test.expectedErr != nil {
+					require.Equal(t, test.expectedErr, errors.Cause(result.Error))
+				}
+				require.Contains(t, result.Error.Error(), test.expectedCause)
+				require.Nil(t, result.Value)
+			} else {
+				require.NoError(t, result.Error)
+				require.Equal(t, test.expected, result.Value)
+			}
+			streams.AssertExpectations(t)
+		})
+	}
+
+	t.Run("without streams", func(t *testing.T) {
+		task := pipeline.StreamTask{
+			BaseTask: pipeline.NewBaseTask(0, "stream", nil, nil, 0),
+			Source:   "binance-ethusd",
+		}
+		result, _ := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.EqualError(t, result.Error, "streams are not available")
+	})
+}
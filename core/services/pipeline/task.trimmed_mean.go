@@ -0,0 +1,94 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//    map[string]interface{}{
+//        "result": decimal.Decimal,
+//        "dropped": []interface{},
+//    }
+//
+// Trim is the percentage of the values dropped from each end before taking
+// the mean, rounded down to a whole number of values.
+//
+type TrimmedMeanTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Trim          string `json:"trim"`
+	AllowedFaults string `json:"allowedFaults"`
+	Precision     string `json:"precision"`
+}
+
+var _ Task = (*TrimmedMeanTask)(nil)
+
+var maxTrim = decimal.NewFromInt(50)
+
+func (t *TrimmedMeanTask) Type() TaskType {
+	return TaskTypeTrimmedMean
+}
+
+func (t *TrimmedMeanTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		maybePrecision     MaybeInt32Param
+		trim               DecimalParam
+		valuesAndErrs      SliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&maybePrecision, From(VarExpr(t.Precision, vars), t.Precision)), "precision"),
+		errors.Wrap(ResolveParam(&trim, From(VarExpr(t.Trim, vars), NonemptyString(t.Trim))), "trim"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if trim.Decimal().IsNegative() || !trim.Decimal().LessThan(maxTrim) {
+		return Result{Error: errors.Wrapf(ErrBadInput, "trim: must be at least 0 and less than 50, got %v", trim.Decimal())}, runInfo
+	}
+
+	values, dropped, err := aggregationValues(t.Type(), valuesAndErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	sortIndexedDecimals(values)
+	k := int(decimal.NewFromInt(int64(len(values))).Mul(trim.Decimal()).Div(decimal.NewFromInt(100)).IntPart())
+	kept := values[k : len(values)-k]
+	for _, v := range values[:k] {
+		dropped = append(dropped, droppedValue(v.index, v.value, DropReasonTrimmed))
+	}
+	for _, v := range values[len(values)-k:] {
+		dropped = append(dropped, droppedValue(v.index, v.value, DropReasonTrimmed))
+	}
+
+	total := decimal.Zero
+	for _, v := range kept {
+		total = total.Add(v.value)
+	}
+	numValues := decimal.NewFromInt(int64(len(kept)))
+
+	var mean decimal.Decimal
+	if precision, isSet := maybePrecision.Int32(); isSet {
+		mean = total.DivRound(numValues, precision)
+	} else {
+		mean = total.Div(numValues)
+	}
+
+	if dropped == nil {
+		dropped = []interface{}{}
+	}
+	return Result{Value: map[string]interface{}{
+		"result":  mean,
+		"dropped": dropped,
+	}}, runInfo
+}
@@ -0,0 +1,139 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestTrimmedMean(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		trim          string
+		allowedFaults string
+		precision     string
+		want          string
+		wantDropped   []interface{}
+		wantErr       error
+	}{
+		{
+			"no trim",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "6")}},
+			"0",
+			"",
+			"",
+			"3",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"trims the same number of values from each end",
+			[]pipeline.Result{{Value: mustDecimal(t, "100")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "-50")}, {Value: mustDecimal(t, "4")}},
+			"20",
+			"",
+			"",
+			"3",
+			[]interface{}{
+				map[string]interface{}{"index": 3, "value": decimal.NewFromInt(-50), "reason": pipeline.DropReasonTrimmed},
+				map[string]interface{}{"index": 0, "value": decimal.NewFromInt(100), "reason": pipeline.DropReasonTrimmed},
+			},
+			nil,
+		},
+		{
+			"rounds the number of trimmed values down",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"24.9",
+			"",
+			"",
+			"2.5",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"with precision",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"10",
+			"",
+			"2",
+			"1.33",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"errored values are dropped",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Error: errors.New("timeout")}, {Value: mustDecimal(t, "3")}},
+			"0",
+			"1",
+			"",
+			"2",
+			[]interface{}{map[string]interface{}{"index": 1, "value": "timeout", "reason": pipeline.DropReasonErrored}},
+			nil,
+		},
+		{
+			"more errors than allowed",
+			[]pipeline.Result{{Error: errors.New("timeout")}, {Error: errors.New("timeout")}, {Value: mustDecimal(t, "3")}},
+			"0",
+			"1",
+			"",
+			"",
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"trim too large",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"50",
+			"",
+			"",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"negative trim",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"-1",
+			"",
+			"",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.TrimmedMeanTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Trim:          test.trim,
+				AllowedFaults: test.allowedFaults,
+				Precision:     test.precision,
+			}
+			output, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			value := output.Value.(map[string]interface{})
+			assert.Equal(t, test.want, value["result"].(decimal.Decimal).String())
+			assert.Equal(t, test.wantDropped, value["dropped"])
+		})
+	}
+}
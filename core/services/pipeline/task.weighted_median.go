@@ -0,0 +1,117 @@
+package pipeline
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+//
+// Return types:
+//    map[string]interface{}{
+//        "result": decimal.Decimal,
+//        "dropped": []interface{},
+//    }
+//
+// Weights are given in the same order as the values, e.g. the trading volume
+// of each price source. A value whose weight errored counts as faulty.
+//
+type WeightedMedianTask struct {
+	BaseTask      `mapstructure:",squash"`
+	Values        string `json:"values"`
+	Weights       string `json:"weights"`
+	AllowedFaults string `json:"allowedFaults"`
+}
+
+var _ Task = (*WeightedMedianTask)(nil)
+
+func (t *WeightedMedianTask) Type() TaskType {
+	return TaskTypeWeightedMedian
+}
+
+func (t *WeightedMedianTask) Run(_ context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		maybeAllowedFaults MaybeUint64Param
+		valuesAndErrs      SliceParam
+		weightsAndErrs     SliceParam
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+		errors.Wrap(ResolveParam(&valuesAndErrs, From(VarExpr(t.Values, vars), JSONWithVarExprs(t.Values, vars, true), Inputs(inputs))), "values"),
+		errors.Wrap(ResolveParam(&weightsAndErrs, From(VarExpr(t.Weights, vars), JSONWithVarExprs(t.Weights, vars, true))), "weights"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if len(weightsAndErrs) != len(valuesAndErrs) {
+		return Result{Error: errors.Wrapf(ErrWrongInputCardinality, "got %v weights for %v values", len(weightsAndErrs), len(valuesAndErrs))}, runInfo
+	}
+
+	withWeightErrs := make(SliceParam, len(valuesAndErrs))
+	copy(withWeightErrs, valuesAndErrs)
+	for i, w := range weightsAndErrs {
+		if _, is := withWeightErrs[i].(error); is {
+			continue
+		}
+		if werr, is := w.(error); is {
+			withWeightErrs[i] = errors.Wrap(werr, "weight")
+		}
+	}
+
+	values, dropped, err := aggregationValues(t.Type(), withWeightErrs, maybeAllowedFaults)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	weights := make(map[int]decimal.Decimal, len(values))
+	total := decimal.Zero
+	for _, v := range values {
+		var w DecimalParam
+		if err = w.UnmarshalPipelineParam(weightsAndErrs[v.index]); err != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weights: %v", err)}, runInfo
+		}
+		if w.Decimal().IsNegative() {
+			return Result{Error: errors.Wrapf(ErrBadInput, "weights: weight %v is negative", w.Decimal())}, runInfo
+		}
+		weights[v.index] = w.Decimal()
+		total = total.Add(w.Decimal())
+	}
+	if !total.IsPositive() {
+		return Result{Error: errors.Wrap(ErrBadInput, "weights: total weight is zero")}, runInfo
+	}
+
+	sortIndexedDecimals(values)
+	half := total.Div(decimal.NewFromInt(2))
+	cumulative := decimal.Zero
+	var weightedMedian decimal.Decimal
+	for i, v := range values {
+		cumulative = cumulative.Add(weights[v.index])
+		if cumulative.LessThan(half) {
+			continue
+		}
+		weightedMedian = v.value
+		if cumulative.Equal(half) {
+			// Like the median of an even number of values, take the midpoint
+			// between this value and the next one carrying any weight
+			for _, next := range values[i+1:] {
+				if weights[next.index].IsPositive() {
+					weightedMedian = v.value.Add(next.value).Div(decimal.NewFromInt(2))
+					break
+				}
+			}
+		}
+		break
+	}
+
+	if dropped == nil {
+		dropped = []interface{}{}
+	}
+	return Result{Value: map[string]interface{}{
+		"result":  weightedMedian,
+		"dropped": dropped,
+	}}, runInfo
+}
@@ -0,0 +1,154 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestWeightedMedian(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputs        []pipeline.Result
+		weights       string
+		allowedFaults string
+		want          string
+		wantDropped   []interface{}
+		wantErr       error
+	}{
+		{
+			"equal weights, odd number of inputs",
+			[]pipeline.Result{{Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1, 1, 1]",
+			"",
+			"2",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"equal weights, even number of inputs",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"[1, 1, 1, 1]",
+			"",
+			"2.5",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"heaviest value dominates",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "100")}},
+			`["1", "1", "10"]`,
+			"",
+			"100",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"zero weights are ignored",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}, {Value: mustDecimal(t, "4")}},
+			"[1, 0, 1, 0]",
+			"",
+			"2",
+			[]interface{}{},
+			nil,
+		},
+		{
+			"errored values are dropped",
+			[]pipeline.Result{{Error: errors.New("timeout")}, {Value: mustDecimal(t, "2")}, {Value: mustDecimal(t, "3")}},
+			"[100, 1, 2]",
+			"1",
+			"3",
+			[]interface{}{map[string]interface{}{"index": 0, "value": "timeout", "reason": pipeline.DropReasonErrored}},
+			nil,
+		},
+		{
+			"more errors than allowed",
+			[]pipeline.Result{{Error: errors.New("timeout")}, {Error: errors.New("timeout")}, {Value: mustDecimal(t, "3")}},
+			"[1, 1, 1]",
+			"1",
+			"",
+			nil,
+			pipeline.ErrTooManyErrors,
+		},
+		{
+			"mismatched weights",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1]",
+			"",
+			"",
+			nil,
+			pipeline.ErrWrongInputCardinality,
+		},
+		{
+			"negative weight",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[1, -1]",
+			"",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+		{
+			"zero total weight",
+			[]pipeline.Result{{Value: mustDecimal(t, "1")}, {Value: mustDecimal(t, "2")}},
+			"[0, 0]",
+			"",
+			"",
+			nil,
+			pipeline.ErrBadInput,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.WeightedMedianTask{
+				BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+				Weights:       test.weights,
+				AllowedFaults: test.allowedFaults,
+			}
+			output, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			if test.wantErr != nil {
+				require.Equal(t, test.wantErr, errors.Cause(output.Error))
+				require.Nil(t, output.Value)
+				return
+			}
+			require.NoError(t, output.Error)
+			value := output.Value.(map[string]interface{})
+			assert.Equal(t, test.want, value["result"].(decimal.Decimal).String())
+			assert.Equal(t, test.wantDropped, value["dropped"])
+		})
+	}
+
+	t.Run("errored weights count as faults", func(t *testing.T) {
+		vars := pipeline.NewVarsFrom(map[string]interface{}{
+			"prices":  []interface{}{"1", "2", "3"},
+			"volumes": []interface{}{errors.New("no volume"), "1", "1"},
+		})
+		task := pipeline.WeightedMedianTask{
+			BaseTask:      pipeline.NewBaseTask(0, "task", nil, nil, 0),
+			Values:        "$(prices)",
+			Weights:       "$(volumes)",
+			AllowedFaults: "1",
+		}
+		output, _ := task.Run(context.Background(), logger.TestLogger(t), vars, nil)
+		require.NoError(t, output.Error)
+		value := output.Value.(map[string]interface{})
+		assert.Equal(t, "2.5", value["result"].(decimal.Decimal).String())
+		require.Len(t, value["dropped"], 1)
+		assert.Equal(t, "weight: no volume", value["dropped"].([]interface{})[0].(map[string]interface{})["value"])
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
 	"github.com/smartcontractkit/chainlink/core/services/relay/types"
 )
 
@@ -24,6 +25,7 @@ var (
 		types.Terra:  {},
 	}
 	_ types.Relayer = &evm.Relayer{}
+	_ types.Relayer = &plugin.Relayer{}
 	_ types.Relayer = &solana.Relayer{}
 	_ types.Relayer = &terra.Relayer{}
 )
@@ -98,6 +100,16 @@ type OCR2ProviderArgs struct {
 func (d delegate) NewOCR2Provider(externalJobID uuid.UUID, s interface{}) (types.OCR2Provider, error) {
 	// We expect trusted input
 	spec := s.(*OCR2ProviderArgs)
+	// Relayers running as plugins decode their own relay config
+	if r, ok := d.relayers[spec.Relay].(*plugin.Relayer); ok {
+		return r.NewOCR2Provider(externalJobID, plugin.OCR2Spec{
+			ID:            spec.ID,
+			ContractID:    spec.ContractID,
+			TransmitterID: spec.TransmitterID,
+			IsBootstrap:   spec.IsBootstrapPeer,
+			RelayConfig:   spec.RelayConfig.Bytes(),
+		})
+	}
 	choice := spec.Relay
 	switch choice {
 	case types.EVM:
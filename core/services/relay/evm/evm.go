@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"encoding/json"
 	"math/big"
 	"strings"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
@@ -159,7 +161,24 @@ type OCR2Spec struct {
 	ChainID       *big.Int
 }
 
+// OCR2SpecFromPlugin decodes the spec of a provider requested of the EVM
+// relayer running as a plugin.
+func OCR2SpecFromPlugin(spec plugin.OCR2Spec) (interface{}, error) {
+	var config RelayConfig
+	if err := json.Unmarshal(spec.RelayConfig, &config); err != nil {
+		return nil, errors.Wrap(err, "invalid relayConfig")
+	}
+	return OCR2Spec{
+		ID:            spec.ID,
+		ContractID:    spec.ContractID,
+		TransmitterID: spec.TransmitterID,
+		IsBootstrap:   spec.IsBootstrap,
+		ChainID:       config.ChainID.ToInt(),
+	}, nil
+}
+
 var _ services.Service = (*ocr2Provider)(nil)
+var _ types2.MedianProvider = (*ocr2Provider)(nil)
 
 type ocr2Provider struct {
 	tracker                *ContractTracker
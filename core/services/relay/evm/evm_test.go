@@ -0,0 +1,39 @@
+package evm_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
+)
+
+func TestOCR2SpecFromPlugin(t *testing.T) {
+	t.Parallel()
+
+	spec, err := evm.OCR2SpecFromPlugin(plugin.OCR2Spec{
+		ID:            1,
+		ContractID:    "0x0000000000000000000000000000000000000001",
+		TransmitterID: null.StringFrom("0x0000000000000000000000000000000000000002"),
+		RelayConfig:   json.RawMessage(`{"chainID": 4}`),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, evm.OCR2Spec{
+		ID:            1,
+		ContractID:    "0x0000000000000000000000000000000000000001",
+		TransmitterID: null.StringFrom("0x0000000000000000000000000000000000000002"),
+		ChainID:       big.NewInt(4),
+	}, spec)
+
+	spec, err = evm.OCR2SpecFromPlugin(plugin.OCR2Spec{IsBootstrap: true, RelayConfig: json.RawMessage(`{}`)})
+	require.NoError(t, err)
+	assert.Equal(t, evm.OCR2Spec{IsBootstrap: true}, spec)
+
+	_, err = evm.OCR2SpecFromPlugin(plugin.OCR2Spec{RelayConfig: json.RawMessage(`{"chainID": "foo"}`)})
+	assert.Error(t, err)
+}
@@ -0,0 +1,393 @@
+package plugin
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	pb "github.com/smartcontractkit/chainlink/core/services/relay/plugin/proto"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	// rpcTimeout bounds the calls to a plugin which take no context
+	rpcTimeout = 10 * time.Second
+	// stopTimeout is how long a plugin has to exit once closed, before it is
+	// killed
+	stopTimeout = 10 * time.Second
+)
+
+var _ types.Relayer = (*Relayer)(nil)
+
+// Relayer is a types.Relayer which runs in a plugin process, and relays to
+// its network over gRPC.
+//
+// Its NewOCR2Provider expects an OCR2Spec, which the plugin decodes into
+// the spec of the relayer it serves.
+type Relayer struct {
+	utils.StartStopOnce
+	network types.Network
+	cfg     ProcessConfig
+	lggr    logger.Logger
+	token   string
+
+	process *process
+	conn    *grpc.ClientConn
+	client  pb.RelayerClient
+}
+
+// NewRelayer returns a Relayer for network, which starts the plugin process
+// configured by cfg when started.
+func NewRelayer(network types.Network, cfg ProcessConfig, lggr logger.Logger) *Relayer {
+	return &Relayer{
+		network: network,
+		cfg:     cfg,
+		lggr:    lggr.Named("RelayPlugin").Named(string(network)),
+		token:   utils.NewSecret(32),
+	}
+}
+
+// Start starts the plugin process, and the relayer it serves.
+func (r *Relayer) Start() error {
+	return r.StartOnce("RelayPlugin", func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), r.cfg.startTimeout())
+		defer cancel()
+
+		p, addr, err := startProcess(ctx, r.network, r.cfg, r.token, r.lggr)
+		if err != nil {
+			return errors.Wrapf(err, "failed to start %s relay plugin", r.network)
+		}
+		conn, err := grpc.DialContext(ctx, addr,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithBlock(),
+			grpc.WithUnaryInterceptor(r.intercept),
+		)
+		if err != nil {
+			p.kill()
+			return errors.Wrapf(err, "failed to connect to %s relay plugin at %s", r.network, addr)
+		}
+		r.process, r.conn, r.client = p, conn, pb.NewRelayerClient(conn)
+
+		if _, err = r.client.Start(ctx, &emptypb.Empty{}); err != nil {
+			return errors.Wrapf(err, "failed to start %s relay plugin", r.network)
+		}
+		return nil
+	})
+}
+
+// Close closes the relayer the plugin serves, and stops the plugin process.
+func (r *Relayer) Close() error {
+	return r.StopOnce("RelayPlugin", func() error {
+		if r.process == nil {
+			return nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		defer cancel()
+
+		_, err := r.client.Close(ctx, &emptypb.Empty{})
+		return multierr.Combine(err, r.conn.Close(), r.process.stop(stopTimeout))
+	})
+}
+
+// Ready returns an error if the plugin process has exited, or the relayer it
+// serves is not ready.
+func (r *Relayer) Ready() error {
+	if err := r.StartStopOnce.Ready(); err != nil {
+		return err
+	}
+	if err := r.connected(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	_, err := r.client.Ready(ctx, &emptypb.Empty{})
+	return err
+}
+
+// Healthy returns an error if the plugin process has exited, or the relayer
+// it serves is unhealthy.
+func (r *Relayer) Healthy() error {
+	if err := r.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	if err := r.connected(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	_, err := r.client.Healthy(ctx, &emptypb.Empty{})
+	return err
+}
+
+// NewOCR2Provider creates a provider in the plugin process, for spec, which
+// must be an OCR2Spec.
+func (r *Relayer) NewOCR2Provider(externalJobID uuid.UUID, spec interface{}) (types.OCR2Provider, error) {
+	s, ok := spec.(OCR2Spec)
+	if !ok {
+		return nil, errors.Errorf("expected plugin.OCR2Spec, got %T", spec)
+	}
+	if err := r.connected(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	resp, err := r.client.NewOCR2Provider(ctx, newOCR2ProviderRequestToPB(externalJobID, s))
+	if err != nil {
+		return nil, err
+	}
+	configDigestPrefix, err := configDigestPrefixFromPB(resp.ConfigDigestPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &provider{
+		client:             r.client,
+		id:                 resp.ProviderId,
+		isBootstrap:        s.IsBootstrap,
+		configDigestPrefix: configDigestPrefix,
+		fromAccount:        ocrtypes.Account(resp.FromAccount),
+	}
+	if resp.Median {
+		return &medianProvider{p}, nil
+	}
+	return p, nil
+}
+
+// connected returns an error unless the relayer is connected to its plugin,
+// which it is not if starting the plugin failed.
+func (r *Relayer) connected() error {
+	if r.client == nil {
+		return errors.Errorf("%s relay plugin is not started", r.network)
+	}
+	return nil
+}
+
+// intercept authenticates the calls to the plugin, and unwraps the errors of
+// the relayer it serves from their gRPC status.
+func (r *Relayer) intercept(ctx context.Context, method string, req, resp interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := r.process.exitErr(); err != nil {
+		return err
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, tokenKey, r.token)
+	if err := invoker(ctx, method, req, resp, cc, opts...); err != nil {
+		if s, ok := status.FromError(err); ok {
+			return errors.New(s.Message())
+		}
+		return err
+	}
+	return nil
+}
+
+var (
+	_ types.OCR2Provider              = (*provider)(nil)
+	_ ocrtypes.ContractTransmitter    = (*provider)(nil)
+	_ ocrtypes.ContractConfigTracker  = (*provider)(nil)
+	_ ocrtypes.OffchainConfigDigester = (*provider)(nil)
+	_ types.MedianProvider            = (*medianProvider)(nil)
+	_ median.ReportCodec              = (*medianProvider)(nil)
+	_ median.MedianContract           = (*medianProvider)(nil)
+)
+
+// provider is an OCR2Provider in a plugin process. It is its own
+// ContractTransmitter, ContractConfigTracker and OffchainConfigDigester.
+type provider struct {
+	client      pb.RelayerClient
+	id          string
+	isBootstrap bool
+	// configDigestPrefix and fromAccount are fixed for the life of the
+	// provider, so they are not called for
+	configDigestPrefix ocrtypes.ConfigDigestPrefix
+	fromAccount        ocrtypes.Account
+}
+
+func (p *provider) request() *pb.ProviderRequest {
+	return &pb.ProviderRequest{ProviderId: p.id}
+}
+
+func (p *provider) Start() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	_, err := p.client.ProviderStart(ctx, p.request())
+	return err
+}
+
+func (p *provider) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	_, err := p.client.ProviderClose(ctx, p.request())
+	return err
+}
+
+func (p *provider) Ready() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	_, err := p.client.ProviderReady(ctx, p.request())
+	return err
+}
+
+func (p *provider) Healthy() error {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	_, err := p.client.ProviderHealthy(ctx, p.request())
+	return err
+}
+
+func (p *provider) ContractTransmitter() ocrtypes.ContractTransmitter {
+	// bootstrap nodes do not transmit
+	if p.isBootstrap {
+		return nil
+	}
+	return p
+}
+
+func (p *provider) ContractConfigTracker() ocrtypes.ContractConfigTracker {
+	return p
+}
+
+func (p *provider) OffchainConfigDigester() ocrtypes.OffchainConfigDigester {
+	return p
+}
+
+func (p *provider) Transmit(ctx context.Context, reportContext ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	_, err := p.client.Transmit(ctx, &pb.TransmitRequest{
+		ProviderId:    p.id,
+		ReportContext: reportContextToPB(reportContext),
+		Report:        report,
+		Signatures:    signaturesToPB(signatures),
+	})
+	return err
+}
+
+func (p *provider) LatestConfigDigestAndEpoch(ctx context.Context) (configDigest ocrtypes.ConfigDigest, epoch uint32, err error) {
+	resp, err := p.client.LatestConfigDigestAndEpoch(ctx, p.request())
+	if err != nil {
+		return
+	}
+	configDigest, err = ocrtypes.BytesToConfigDigest(resp.ConfigDigest)
+	return configDigest, resp.Epoch, err
+}
+
+func (p *provider) FromAccount() ocrtypes.Account {
+	return p.fromAccount
+}
+
+// Notify returns nil, as config changes are not pushed across the process
+// boundary; libocr polls LatestConfigDetails instead.
+func (p *provider) Notify() <-chan struct{} {
+	return nil
+}
+
+func (p *provider) LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest ocrtypes.ConfigDigest, err error) {
+	resp, err := p.client.LatestConfigDetails(ctx, p.request())
+	if err != nil {
+		return
+	}
+	configDigest, err = ocrtypes.BytesToConfigDigest(resp.ConfigDigest)
+	return resp.ChangedInBlock, configDigest, err
+}
+
+func (p *provider) LatestConfig(ctx context.Context, changedInBlock uint64) (ocrtypes.ContractConfig, error) {
+	resp, err := p.client.LatestConfig(ctx, &pb.LatestConfigRequest{ProviderId: p.id, ChangedInBlock: changedInBlock})
+	if err != nil {
+		return ocrtypes.ContractConfig{}, err
+	}
+	return contractConfigFromPB(resp.Config)
+}
+
+func (p *provider) LatestBlockHeight(ctx context.Context) (uint64, error) {
+	resp, err := p.client.LatestBlockHeight(ctx, p.request())
+	if err != nil {
+		return 0, err
+	}
+	return resp.BlockHeight, nil
+}
+
+func (p *provider) ConfigDigest(config ocrtypes.ContractConfig) (ocrtypes.ConfigDigest, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	resp, err := p.client.ConfigDigest(ctx, &pb.ConfigDigestRequest{ProviderId: p.id, Config: contractConfigToPB(config)})
+	if err != nil {
+		return ocrtypes.ConfigDigest{}, err
+	}
+	return ocrtypes.BytesToConfigDigest(resp.ConfigDigest)
+}
+
+func (p *provider) ConfigDigestPrefix() ocrtypes.ConfigDigestPrefix {
+	return p.configDigestPrefix
+}
+
+// medianProvider is a MedianProvider in a plugin process. It is its own
+// ReportCodec and MedianContract.
+type medianProvider struct {
+	*provider
+}
+
+func (p *medianProvider) ReportCodec() median.ReportCodec {
+	return p
+}
+
+func (p *medianProvider) MedianContract() median.MedianContract {
+	return p
+}
+
+func (p *medianProvider) BuildReport(observations []median.ParsedAttributedObservation) (ocrtypes.Report, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	resp, err := p.client.BuildReport(ctx, &pb.BuildReportRequest{ProviderId: p.id, Observations: observationsToPB(observations)})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Report, nil
+}
+
+func (p *medianProvider) MedianFromReport(report ocrtypes.Report) (*big.Int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	defer cancel()
+	resp, err := p.client.MedianFromReport(ctx, &pb.MedianFromReportRequest{ProviderId: p.id, Report: report})
+	if err != nil {
+		return nil, err
+	}
+	return bigIntFromPB(resp.Median), nil
+}
+
+func (p *medianProvider) LatestTransmissionDetails(ctx context.Context) (configDigest ocrtypes.ConfigDigest, epoch uint32, round uint8, latestAnswer *big.Int, latestTimestamp time.Time, err error) {
+	resp, err := p.client.LatestTransmissionDetails(ctx, p.request())
+	if err != nil {
+		return
+	}
+	if configDigest, err = ocrtypes.BytesToConfigDigest(resp.ConfigDigest); err != nil {
+		return
+	}
+	if round, err = uint8FromPB(resp.Round, "round"); err != nil {
+		return
+	}
+	return configDigest, resp.Epoch, round, bigIntFromPB(resp.LatestAnswer), resp.LatestTimestamp.AsTime(), nil
+}
+
+func (p *medianProvider) LatestRoundRequested(ctx context.Context, lookback time.Duration) (configDigest ocrtypes.ConfigDigest, epoch uint32, round uint8, err error) {
+	resp, err := p.client.LatestRoundRequested(ctx, &pb.LatestRoundRequestedRequest{ProviderId: p.id, Lookback: durationpb.New(lookback)})
+	if err != nil {
+		return
+	}
+	if configDigest, err = ocrtypes.BytesToConfigDigest(resp.ConfigDigest); err != nil {
+		return
+	}
+	if round, err = uint8FromPB(resp.Round, "round"); err != nil {
+		return
+	}
+	return configDigest, resp.Epoch, round, nil
+}
@@ -0,0 +1,85 @@
+package plugin
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// defaultStartTimeout bounds how long a plugin may take to start serving,
+// unless overridden by its startTimeout
+const defaultStartTimeout = 30 * time.Second
+
+// Config is the node configuration of relayer plugins
+type Config interface {
+	RelayPlugins() string
+}
+
+// ProcessConfig configures the process of a relayer plugin, as given by
+// RELAY_PLUGINS. The plugin runs the chains of its network, so the node must
+// have them disabled, e.g. with EVM_ENABLED=false:
+//
+//	{
+//	  "evm": {"cmd": "/usr/local/bin/chainlink", "args": ["node", "relay-plugin", "--password", "/run/secrets/keystore"]}
+//	}
+type ProcessConfig struct {
+	// Cmd is the path of the plugin executable
+	Cmd string `json:"cmd"`
+	// Args are passed to the plugin executable
+	Args []string `json:"args"`
+	// Env is added to the environment the node passes on to the plugin, as
+	// KEY=value pairs
+	Env []string `json:"env"`
+	// StartTimeout bounds how long the plugin may take to start serving
+	StartTimeout models.Duration `json:"startTimeout"`
+}
+
+func (c ProcessConfig) startTimeout() time.Duration {
+	if c.StartTimeout.Duration() > 0 {
+		return c.StartTimeout.Duration()
+	}
+	return defaultStartTimeout
+}
+
+// ParsePlugins parses the relayer plugins configured by RELAY_PLUGINS, keyed
+// by the network they relay to, which must be one of supported.
+func ParsePlugins(cfg Config, supported map[types.Network]struct{}) (map[types.Network]ProcessConfig, error) {
+	s := strings.TrimSpace(cfg.RelayPlugins())
+	if s == "" {
+		return nil, nil
+	}
+	var plugins map[types.Network]ProcessConfig
+	if err := json.Unmarshal([]byte(s), &plugins); err != nil {
+		return nil, errors.Wrap(err, "invalid RELAY_PLUGINS")
+	}
+
+	networks := make([]string, 0, len(plugins))
+	for network := range plugins {
+		networks = append(networks, string(network))
+	}
+	sort.Strings(networks)
+
+	var err error
+	for _, network := range networks {
+		plugin := plugins[types.Network(network)]
+		if _, ok := supported[types.Network(network)]; !ok {
+			err = multierr.Append(err, errors.Errorf("unsupported network %q", network))
+		}
+		if plugin.Cmd == "" {
+			err = multierr.Append(err, errors.Errorf("%s: cmd is required", network))
+		}
+		for _, kv := range plugin.Env {
+			if !strings.Contains(kv, "=") {
+				err = multierr.Append(err, errors.Errorf("%s: env must be KEY=value pairs, got %q", network, kv))
+			}
+		}
+	}
+	return plugins, errors.Wrap(err, "invalid RELAY_PLUGINS")
+}
@@ -0,0 +1,62 @@
+package plugin_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+type config string
+
+func (c config) RelayPlugins() string {
+	return string(c)
+}
+
+func TestParsePlugins(t *testing.T) {
+	t.Parallel()
+
+	plugins, err := plugin.ParsePlugins(config(""), relay.SupportedRelayers)
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+
+	plugins, err = plugin.ParsePlugins(config(`{
+		"evm": {"cmd": "/usr/local/bin/chainlink", "args": ["node", "relay-plugin"], "env": ["LOG_LEVEL=debug"], "startTimeout": "1m"}
+	}`), relay.SupportedRelayers)
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	evm := plugins[types.EVM]
+	assert.Equal(t, "/usr/local/bin/chainlink", evm.Cmd)
+	assert.Equal(t, []string{"node", "relay-plugin"}, evm.Args)
+	assert.Equal(t, []string{"LOG_LEVEL=debug"}, evm.Env)
+	assert.Equal(t, time.Minute, evm.StartTimeout.Duration())
+}
+
+func TestParsePlugins_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		config string
+		err    string
+	}{
+		{"invalid JSON", `{"evm": `, "invalid RELAY_PLUGINS"},
+		{"unsupported network", `{"foo": {"cmd": "foo"}}`, `unsupported network "foo"`},
+		{"missing cmd", `{"evm": {"args": ["node"]}}`, "evm: cmd is required"},
+		{"invalid env", `{"evm": {"cmd": "chainlink", "env": ["LOG_LEVEL"]}}`, `evm: env must be KEY=value pairs, got "LOG_LEVEL"`},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := plugin.ParsePlugins(config(test.config), relay.SupportedRelayers)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
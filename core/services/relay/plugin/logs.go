@@ -0,0 +1,75 @@
+package plugin
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// The keys of the JSON console logs which are not passed on as fields
+var reservedLogKeys = map[string]struct{}{
+	"level":  {},
+	"ts":     {},
+	"logger": {},
+	"caller": {},
+	"msg":    {},
+}
+
+// bridgeLogs logs each line of r, the stderr of a plugin, with lggr. Plugins
+// are started with JSON_CONSOLE=true, so that their lines are logged at their
+// own level and with their own fields, other lines are logged as info.
+// It returns once r is closed.
+func bridgeLogs(r io.Reader, lggr logger.Logger) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		bridgeLogLine(scanner.Bytes(), lggr)
+	}
+	if err := scanner.Err(); err != nil {
+		lggr.Errorw("Failed to read plugin logs", "err", err)
+	}
+}
+
+func bridgeLogLine(line []byte, lggr logger.Logger) {
+	var entry map[string]interface{}
+	if err := json.Unmarshal(line, &entry); err != nil || entry["msg"] == nil {
+		if len(line) > 0 {
+			lggr.Info(string(line))
+		}
+		return
+	}
+
+	msg, _ := entry["msg"].(string)
+	if name, ok := entry["logger"].(string); ok && name != "" {
+		lggr = lggr.Named(name)
+	}
+	keys := make([]string, 0, len(entry))
+	for k := range entry {
+		if _, ok := reservedLogKeys[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	kvs := make([]interface{}, 0, 2*len(keys))
+	for _, k := range keys {
+		kvs = append(kvs, k, entry[k])
+	}
+
+	level, _ := entry["level"].(string)
+	switch level {
+	case "debug":
+		lggr.Debugw(msg, kvs...)
+	case "warn":
+		lggr.Warnw(msg, kvs...)
+	case "error":
+		lggr.Errorw(msg, kvs...)
+	case "crit", "dpanic", "panic", "fatal":
+		// the node must not panic or exit on behalf of its plugins
+		lggr.Criticalw(msg, kvs...)
+	default:
+		lggr.Infow(msg, kvs...)
+	}
+}
@@ -0,0 +1,47 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// findLog returns the line logged to the memory sink with msg.
+func findLog(t *testing.T, msg string) string {
+	t.Helper()
+	for _, line := range strings.Split(logger.MemoryLogTestingOnly().String(), "\n") {
+		if strings.Contains(line, msg) {
+			return line
+		}
+	}
+	t.Fatalf("no log with msg %q", msg)
+	return ""
+}
+
+func TestBridgeLogs(t *testing.T) {
+	t.Parallel()
+
+	lines := strings.Join([]string{
+		`{"level":"warn","ts":"2022-03-01T12:00:00.000Z","logger":"EVM.Relayer","caller":"evm/evm.go:1","msg":"TestBridgeLogs warn","jobID":1}`,
+		`{"level":"crit","ts":"2022-03-01T12:00:00.000Z","caller":"evm/evm.go:2","msg":"TestBridgeLogs crit"}`,
+		`{"level":"error","msg":"TestBridgeLogs error"}`,
+		`TestBridgeLogs plain`,
+		``,
+	}, "\n")
+	bridgeLogs(strings.NewReader(lines), logger.TestLogger(t).Named("RelayPlugin"))
+
+	warn := findLog(t, "TestBridgeLogs warn")
+	assert.Contains(t, warn, "[WARN]")
+	assert.Contains(t, warn, "RelayPlugin.EVM.Relayer")
+	assert.Contains(t, warn, "jobID=1")
+
+	crit := findLog(t, "TestBridgeLogs crit")
+	assert.Contains(t, crit, "[CRIT]")
+	assert.NotContains(t, crit, "RelayPlugin.")
+
+	assert.Contains(t, findLog(t, "TestBridgeLogs error"), "[ERROR]")
+	assert.Contains(t, findLog(t, "TestBridgeLogs plain"), "[INFO]")
+}
@@ -0,0 +1,223 @@
+package plugin
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"gopkg.in/guregu/null.v4"
+
+	pb "github.com/smartcontractkit/chainlink/core/services/relay/plugin/proto"
+)
+
+// OCR2Spec is the chain agnostic spec of an OCR2 provider. Plugins decode
+// RelayConfig themselves, into the spec their relayer expects.
+type OCR2Spec struct {
+	ID            int32           `json:"id"`
+	ContractID    string          `json:"contractID"`
+	TransmitterID null.String     `json:"transmitterID"`
+	IsBootstrap   bool            `json:"isBootstrap"`
+	RelayConfig   json.RawMessage `json:"relayConfig"`
+}
+
+// The conversions of the types of the relayer and provider interfaces to and
+// from the messages of the relayer service.
+
+func newOCR2ProviderRequestToPB(externalJobID uuid.UUID, spec OCR2Spec) *pb.NewOCR2ProviderRequest {
+	req := &pb.NewOCR2ProviderRequest{
+		ExternalJobId: externalJobID.Bytes(),
+		Spec: &pb.OCR2Spec{
+			Id:          spec.ID,
+			ContractId:  spec.ContractID,
+			IsBootstrap: spec.IsBootstrap,
+			RelayConfig: spec.RelayConfig,
+		},
+	}
+	if spec.TransmitterID.Valid {
+		req.Spec.TransmitterId = &spec.TransmitterID.String
+	}
+	return req
+}
+
+func newOCR2ProviderRequestFromPB(req *pb.NewOCR2ProviderRequest) (uuid.UUID, OCR2Spec, error) {
+	externalJobID, err := uuid.FromBytes(req.ExternalJobId)
+	if err != nil {
+		return uuid.UUID{}, OCR2Spec{}, errors.Wrap(err, "invalid external job ID")
+	}
+	if req.Spec == nil {
+		return uuid.UUID{}, OCR2Spec{}, errors.New("spec is required")
+	}
+	spec := OCR2Spec{
+		ID:            req.Spec.Id,
+		ContractID:    req.Spec.ContractId,
+		TransmitterID: null.StringFromPtr(req.Spec.TransmitterId),
+		IsBootstrap:   req.Spec.IsBootstrap,
+		RelayConfig:   req.Spec.RelayConfig,
+	}
+	return externalJobID, spec, nil
+}
+
+func uint8FromPB(v uint32, name string) (uint8, error) {
+	if v > math.MaxUint8 {
+		return 0, errors.Errorf("%s %d overflows uint8", name, v)
+	}
+	return uint8(v), nil
+}
+
+func configDigestPrefixFromPB(v uint32) (ocrtypes.ConfigDigestPrefix, error) {
+	if v > math.MaxUint16 {
+		return 0, errors.Errorf("config digest prefix %d overflows uint16", v)
+	}
+	return ocrtypes.ConfigDigestPrefix(v), nil
+}
+
+func bigIntToPB(i *big.Int) *pb.BigInt {
+	if i == nil {
+		return nil
+	}
+	return &pb.BigInt{Abs: i.Bytes(), Negative: i.Sign() < 0}
+}
+
+func bigIntFromPB(i *pb.BigInt) *big.Int {
+	if i == nil {
+		return nil
+	}
+	v := new(big.Int).SetBytes(i.Abs)
+	if i.Negative {
+		v.Neg(v)
+	}
+	return v
+}
+
+func reportContextToPB(c ocrtypes.ReportContext) *pb.ReportContext {
+	return &pb.ReportContext{
+		ReportTimestamp: &pb.ReportTimestamp{
+			ConfigDigest: c.ConfigDigest[:],
+			Epoch:        c.Epoch,
+			Round:        uint32(c.Round),
+		},
+		ExtraHash: c.ExtraHash[:],
+	}
+}
+
+func reportContextFromPB(c *pb.ReportContext) (ocrtypes.ReportContext, error) {
+	var rc ocrtypes.ReportContext
+	if c == nil || c.ReportTimestamp == nil {
+		return rc, errors.New("report context is required")
+	}
+	var err error
+	if rc.ConfigDigest, err = ocrtypes.BytesToConfigDigest(c.ReportTimestamp.ConfigDigest); err != nil {
+		return rc, err
+	}
+	rc.Epoch = c.ReportTimestamp.Epoch
+	if rc.Round, err = uint8FromPB(c.ReportTimestamp.Round, "round"); err != nil {
+		return rc, err
+	}
+	if len(c.ExtraHash) != len(rc.ExtraHash) {
+		return rc, errors.Errorf("extra hash must be %d bytes, got %d", len(rc.ExtraHash), len(c.ExtraHash))
+	}
+	copy(rc.ExtraHash[:], c.ExtraHash)
+	return rc, nil
+}
+
+func signaturesToPB(signatures []ocrtypes.AttributedOnchainSignature) []*pb.AttributedOnchainSignature {
+	sigs := make([]*pb.AttributedOnchainSignature, len(signatures))
+	for i, s := range signatures {
+		sigs[i] = &pb.AttributedOnchainSignature{Signature: s.Signature, Signer: uint32(s.Signer)}
+	}
+	return sigs
+}
+
+func signaturesFromPB(signatures []*pb.AttributedOnchainSignature) ([]ocrtypes.AttributedOnchainSignature, error) {
+	sigs := make([]ocrtypes.AttributedOnchainSignature, len(signatures))
+	for i, s := range signatures {
+		signer, err := uint8FromPB(s.Signer, "signer")
+		if err != nil {
+			return nil, err
+		}
+		sigs[i] = ocrtypes.AttributedOnchainSignature{Signature: s.Signature, Signer: commontypes.OracleID(signer)}
+	}
+	return sigs, nil
+}
+
+func contractConfigToPB(c ocrtypes.ContractConfig) *pb.ContractConfig {
+	config := &pb.ContractConfig{
+		ConfigDigest:          c.ConfigDigest[:],
+		ConfigCount:           c.ConfigCount,
+		Signers:               make([][]byte, len(c.Signers)),
+		Transmitters:          make([]string, len(c.Transmitters)),
+		F:                     uint32(c.F),
+		OnchainConfig:         c.OnchainConfig,
+		OffchainConfigVersion: c.OffchainConfigVersion,
+		OffchainConfig:        c.OffchainConfig,
+	}
+	for i, s := range c.Signers {
+		config.Signers[i] = s
+	}
+	for i, t := range c.Transmitters {
+		config.Transmitters[i] = string(t)
+	}
+	return config
+}
+
+func contractConfigFromPB(c *pb.ContractConfig) (ocrtypes.ContractConfig, error) {
+	var config ocrtypes.ContractConfig
+	if c == nil {
+		return config, errors.New("config is required")
+	}
+	var err error
+	if config.ConfigDigest, err = ocrtypes.BytesToConfigDigest(c.ConfigDigest); err != nil {
+		return config, err
+	}
+	if config.F, err = uint8FromPB(c.F, "f"); err != nil {
+		return config, err
+	}
+	config.ConfigCount = c.ConfigCount
+	config.Signers = make([]ocrtypes.OnchainPublicKey, len(c.Signers))
+	for i, s := range c.Signers {
+		config.Signers[i] = s
+	}
+	config.Transmitters = make([]ocrtypes.Account, len(c.Transmitters))
+	for i, t := range c.Transmitters {
+		config.Transmitters[i] = ocrtypes.Account(t)
+	}
+	config.OnchainConfig = c.OnchainConfig
+	config.OffchainConfigVersion = c.OffchainConfigVersion
+	config.OffchainConfig = c.OffchainConfig
+	return config, nil
+}
+
+func observationsToPB(observations []median.ParsedAttributedObservation) []*pb.ParsedAttributedObservation {
+	obs := make([]*pb.ParsedAttributedObservation, len(observations))
+	for i, o := range observations {
+		obs[i] = &pb.ParsedAttributedObservation{
+			Timestamp:       o.Timestamp,
+			Value:           bigIntToPB(o.Value),
+			JuelsPerFeeCoin: bigIntToPB(o.JuelsPerFeeCoin),
+			Observer:        uint32(o.Observer),
+		}
+	}
+	return obs
+}
+
+func observationsFromPB(observations []*pb.ParsedAttributedObservation) ([]median.ParsedAttributedObservation, error) {
+	obs := make([]median.ParsedAttributedObservation, len(observations))
+	for i, o := range observations {
+		observer, err := uint8FromPB(o.Observer, "observer")
+		if err != nil {
+			return nil, err
+		}
+		obs[i] = median.ParsedAttributedObservation{
+			Timestamp:       o.Timestamp,
+			Value:           bigIntFromPB(o.Value),
+			JuelsPerFeeCoin: bigIntFromPB(o.JuelsPerFeeCoin),
+			Observer:        commontypes.OracleID(observer),
+		}
+	}
+	return obs, nil
+}
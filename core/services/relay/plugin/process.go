@@ -0,0 +1,155 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+// process is a running plugin process.
+type process struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	// exited is closed once the process has exited, with err set to the
+	// error it exited with
+	exited chan struct{}
+	err    error
+}
+
+// startProcess starts the plugin process of network configured by cfg, and
+// returns it with the address it serves on, once it has written its
+// handshake. stderr of the process is bridged to lggr.
+func startProcess(ctx context.Context, network types.Network, cfg ProcessConfig, token string, lggr logger.Logger) (*process, string, error) {
+	cmd := exec.Command(cfg.Cmd, cfg.Args...) //nolint:gosec
+	// plugins log JSON to stderr, to be bridged by the node, and must not
+	// start plugins of their own. They run the chains of their network,
+	// which the node has disabled.
+	cmd.Env = append(os.Environ(),
+		"JSON_CONSOLE=true",
+		"RELAY_PLUGINS=",
+		"LOG_TO_DISK=false",
+		strings.ToUpper(string(network))+"_ENABLED=true",
+		envToken+"="+token,
+	)
+	cmd.Env = append(cmd.Env, cfg.Env...)
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to open stdin")
+	}
+	stdoutR, stdoutW := io.Pipe()
+	stderrR, stderrW := io.Pipe()
+	cmd.Stdout = stdoutW
+	cmd.Stderr = stderrW
+
+	if err = cmd.Start(); err != nil {
+		return nil, "", errors.Wrapf(err, "failed to start %s", cfg.Cmd)
+	}
+	lggr.Infow("Started relay plugin", "cmd", cfg.Cmd, "pid", cmd.Process.Pid)
+
+	p := &process{cmd: cmd, stdin: stdin, exited: make(chan struct{})}
+	go func() {
+		p.err = cmd.Wait()
+		stdoutW.Close()
+		stderrW.Close()
+		close(p.exited)
+	}()
+	go bridgeLogs(stderrR, lggr)
+
+	chHandshake := make(chan string, 1)
+	go readStdout(stdoutR, chHandshake, lggr)
+
+	select {
+	case line := <-chHandshake:
+		addr, err := parseHandshake(line)
+		if err != nil {
+			p.kill()
+			return nil, "", err
+		}
+		return p, addr, nil
+	case <-p.exited:
+		return nil, "", errors.Wrap(p.exitErr(), "relay plugin exited before serving")
+	case <-ctx.Done():
+		p.kill()
+		return nil, "", errors.Errorf("relay plugin did not serve within %s", cfg.startTimeout())
+	}
+}
+
+// readStdout sends the handshake of the plugin to chHandshake, and logs any
+// other output as info.
+func readStdout(r io.Reader, chHandshake chan<- string, lggr logger.Logger) {
+	scanner := bufio.NewScanner(r)
+	handshaken := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !handshaken && strings.HasPrefix(line, handshakePrefix+"|") {
+			handshaken = true
+			chHandshake <- line
+			continue
+		}
+		if line != "" {
+			lggr.Info(line)
+		}
+	}
+}
+
+func parseHandshake(line string) (string, error) {
+	parts := strings.Split(line, "|")
+	if len(parts) != 4 {
+		return "", errors.Errorf("invalid relay plugin handshake %q", line)
+	}
+	version, err := strconv.Atoi(parts[1])
+	if err != nil || version != protocolVersion {
+		return "", errors.Errorf("relay plugin speaks protocol version %s, expected %d", parts[1], protocolVersion)
+	}
+	if parts[2] != "tcp" {
+		return "", errors.Errorf("relay plugin serves on unsupported network %q", parts[2])
+	}
+	return parts[3], nil
+}
+
+// exitErr returns an error if the process has exited.
+func (p *process) exitErr() error {
+	select {
+	case <-p.exited:
+		if p.err != nil {
+			return errors.Wrap(p.err, "relay plugin exited")
+		}
+		return errors.New("relay plugin exited")
+	default:
+		return nil
+	}
+}
+
+// stop closes stdin of the process, which tells it the node has gone away,
+// and kills it unless it exits within timeout.
+func (p *process) stop(timeout time.Duration) error {
+	_ = p.stdin.Close()
+	select {
+	case <-p.exited:
+		return nil
+	case <-time.After(timeout):
+	}
+	if err := p.cmd.Process.Kill(); err != nil {
+		return errors.Wrap(err, "failed to kill relay plugin")
+	}
+	<-p.exited
+	return errors.Errorf("relay plugin did not exit within %s and was killed", timeout)
+}
+
+// kill kills a process which failed to start serving.
+func (p *process) kill() {
+	_ = p.stdin.Close()
+	_ = p.cmd.Process.Kill()
+	<-p.exited
+}
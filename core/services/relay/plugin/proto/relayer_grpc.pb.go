@@ -0,0 +1,764 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: proto/relayer.proto
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RelayerClient is the client API for Relayer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RelayerClient interface {
+	Start(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Close(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Healthy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	NewOCR2Provider(ctx context.Context, in *NewOCR2ProviderRequest, opts ...grpc.CallOption) (*NewOCR2ProviderResponse, error)
+	ProviderStart(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ProviderClose(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ProviderReady(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ProviderHealthy(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ContractTransmitter
+	Transmit(ctx context.Context, in *TransmitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	LatestConfigDigestAndEpoch(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestConfigDigestAndEpochResponse, error)
+	// ContractConfigTracker
+	LatestConfigDetails(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestConfigDetailsResponse, error)
+	LatestConfig(ctx context.Context, in *LatestConfigRequest, opts ...grpc.CallOption) (*LatestConfigResponse, error)
+	LatestBlockHeight(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestBlockHeightResponse, error)
+	// OffchainConfigDigester
+	ConfigDigest(ctx context.Context, in *ConfigDigestRequest, opts ...grpc.CallOption) (*ConfigDigestResponse, error)
+	// ReportCodec of the median reporting plugin
+	BuildReport(ctx context.Context, in *BuildReportRequest, opts ...grpc.CallOption) (*BuildReportResponse, error)
+	MedianFromReport(ctx context.Context, in *MedianFromReportRequest, opts ...grpc.CallOption) (*MedianFromReportResponse, error)
+	// MedianContract of the median reporting plugin
+	LatestTransmissionDetails(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestTransmissionDetailsResponse, error)
+	LatestRoundRequested(ctx context.Context, in *LatestRoundRequestedRequest, opts ...grpc.CallOption) (*LatestRoundRequestedResponse, error)
+}
+
+type relayerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRelayerClient(cc grpc.ClientConnInterface) RelayerClient {
+	return &relayerClient{cc}
+}
+
+func (c *relayerClient) Start(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/Start", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) Close(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/Close", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) Ready(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/Ready", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) Healthy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/Healthy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) NewOCR2Provider(ctx context.Context, in *NewOCR2ProviderRequest, opts ...grpc.CallOption) (*NewOCR2ProviderResponse, error) {
+	out := new(NewOCR2ProviderResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/NewOCR2Provider", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) ProviderStart(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/ProviderStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) ProviderClose(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/ProviderClose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) ProviderReady(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/ProviderReady", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) ProviderHealthy(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/ProviderHealthy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) Transmit(ctx context.Context, in *TransmitRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/Transmit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) LatestConfigDigestAndEpoch(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestConfigDigestAndEpochResponse, error) {
+	out := new(LatestConfigDigestAndEpochResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/LatestConfigDigestAndEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) LatestConfigDetails(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestConfigDetailsResponse, error) {
+	out := new(LatestConfigDetailsResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/LatestConfigDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) LatestConfig(ctx context.Context, in *LatestConfigRequest, opts ...grpc.CallOption) (*LatestConfigResponse, error) {
+	out := new(LatestConfigResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/LatestConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) LatestBlockHeight(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestBlockHeightResponse, error) {
+	out := new(LatestBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/LatestBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) ConfigDigest(ctx context.Context, in *ConfigDigestRequest, opts ...grpc.CallOption) (*ConfigDigestResponse, error) {
+	out := new(ConfigDigestResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/ConfigDigest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) BuildReport(ctx context.Context, in *BuildReportRequest, opts ...grpc.CallOption) (*BuildReportResponse, error) {
+	out := new(BuildReportResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/BuildReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) MedianFromReport(ctx context.Context, in *MedianFromReportRequest, opts ...grpc.CallOption) (*MedianFromReportResponse, error) {
+	out := new(MedianFromReportResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/MedianFromReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) LatestTransmissionDetails(ctx context.Context, in *ProviderRequest, opts ...grpc.CallOption) (*LatestTransmissionDetailsResponse, error) {
+	out := new(LatestTransmissionDetailsResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/LatestTransmissionDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *relayerClient) LatestRoundRequested(ctx context.Context, in *LatestRoundRequestedRequest, opts ...grpc.CallOption) (*LatestRoundRequestedResponse, error) {
+	out := new(LatestRoundRequestedResponse)
+	err := c.cc.Invoke(ctx, "/relay.v1.Relayer/LatestRoundRequested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RelayerServer is the server API for Relayer service.
+// All implementations must embed UnimplementedRelayerServer
+// for forward compatibility
+type RelayerServer interface {
+	Start(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Close(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Ready(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Healthy(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	NewOCR2Provider(context.Context, *NewOCR2ProviderRequest) (*NewOCR2ProviderResponse, error)
+	ProviderStart(context.Context, *ProviderRequest) (*emptypb.Empty, error)
+	ProviderClose(context.Context, *ProviderRequest) (*emptypb.Empty, error)
+	ProviderReady(context.Context, *ProviderRequest) (*emptypb.Empty, error)
+	ProviderHealthy(context.Context, *ProviderRequest) (*emptypb.Empty, error)
+	// ContractTransmitter
+	Transmit(context.Context, *TransmitRequest) (*emptypb.Empty, error)
+	LatestConfigDigestAndEpoch(context.Context, *ProviderRequest) (*LatestConfigDigestAndEpochResponse, error)
+	// ContractConfigTracker
+	LatestConfigDetails(context.Context, *ProviderRequest) (*LatestConfigDetailsResponse, error)
+	LatestConfig(context.Context, *LatestConfigRequest) (*LatestConfigResponse, error)
+	LatestBlockHeight(context.Context, *ProviderRequest) (*LatestBlockHeightResponse, error)
+	// OffchainConfigDigester
+	ConfigDigest(context.Context, *ConfigDigestRequest) (*ConfigDigestResponse, error)
+	// ReportCodec of the median reporting plugin
+	BuildReport(context.Context, *BuildReportRequest) (*BuildReportResponse, error)
+	MedianFromReport(context.Context, *MedianFromReportRequest) (*MedianFromReportResponse, error)
+	// MedianContract of the median reporting plugin
+	LatestTransmissionDetails(context.Context, *ProviderRequest) (*LatestTransmissionDetailsResponse, error)
+	LatestRoundRequested(context.Context, *LatestRoundRequestedRequest) (*LatestRoundRequestedResponse, error)
+	mustEmbedUnimplementedRelayerServer()
+}
+
+// UnimplementedRelayerServer must be embedded to have forward compatible implementations.
+type UnimplementedRelayerServer struct {
+}
+
+func (UnimplementedRelayerServer) Start(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Start not implemented")
+}
+func (UnimplementedRelayerServer) Close(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Close not implemented")
+}
+func (UnimplementedRelayerServer) Ready(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ready not implemented")
+}
+func (UnimplementedRelayerServer) Healthy(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthy not implemented")
+}
+func (UnimplementedRelayerServer) NewOCR2Provider(context.Context, *NewOCR2ProviderRequest) (*NewOCR2ProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewOCR2Provider not implemented")
+}
+func (UnimplementedRelayerServer) ProviderStart(context.Context, *ProviderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderStart not implemented")
+}
+func (UnimplementedRelayerServer) ProviderClose(context.Context, *ProviderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderClose not implemented")
+}
+func (UnimplementedRelayerServer) ProviderReady(context.Context, *ProviderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderReady not implemented")
+}
+func (UnimplementedRelayerServer) ProviderHealthy(context.Context, *ProviderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProviderHealthy not implemented")
+}
+func (UnimplementedRelayerServer) Transmit(context.Context, *TransmitRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transmit not implemented")
+}
+func (UnimplementedRelayerServer) LatestConfigDigestAndEpoch(context.Context, *ProviderRequest) (*LatestConfigDigestAndEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestConfigDigestAndEpoch not implemented")
+}
+func (UnimplementedRelayerServer) LatestConfigDetails(context.Context, *ProviderRequest) (*LatestConfigDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestConfigDetails not implemented")
+}
+func (UnimplementedRelayerServer) LatestConfig(context.Context, *LatestConfigRequest) (*LatestConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestConfig not implemented")
+}
+func (UnimplementedRelayerServer) LatestBlockHeight(context.Context, *ProviderRequest) (*LatestBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestBlockHeight not implemented")
+}
+func (UnimplementedRelayerServer) ConfigDigest(context.Context, *ConfigDigestRequest) (*ConfigDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigDigest not implemented")
+}
+func (UnimplementedRelayerServer) BuildReport(context.Context, *BuildReportRequest) (*BuildReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildReport not implemented")
+}
+func (UnimplementedRelayerServer) MedianFromReport(context.Context, *MedianFromReportRequest) (*MedianFromReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MedianFromReport not implemented")
+}
+func (UnimplementedRelayerServer) LatestTransmissionDetails(context.Context, *ProviderRequest) (*LatestTransmissionDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestTransmissionDetails not implemented")
+}
+func (UnimplementedRelayerServer) LatestRoundRequested(context.Context, *LatestRoundRequestedRequest) (*LatestRoundRequestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LatestRoundRequested not implemented")
+}
+func (UnimplementedRelayerServer) mustEmbedUnimplementedRelayerServer() {}
+
+// UnsafeRelayerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RelayerServer will
+// result in compilation errors.
+type UnsafeRelayerServer interface {
+	mustEmbedUnimplementedRelayerServer()
+}
+
+func RegisterRelayerServer(s grpc.ServiceRegistrar, srv RelayerServer) {
+	s.RegisterService(&Relayer_ServiceDesc, srv)
+}
+
+func _Relayer_Start_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).Start(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/Start",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).Start(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_Close_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).Close(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/Close",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).Close(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_Ready_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).Ready(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/Ready",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).Ready(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_Healthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).Healthy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/Healthy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).Healthy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_NewOCR2Provider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewOCR2ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).NewOCR2Provider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/NewOCR2Provider",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).NewOCR2Provider(ctx, req.(*NewOCR2ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_ProviderStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).ProviderStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/ProviderStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).ProviderStart(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_ProviderClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).ProviderClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/ProviderClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).ProviderClose(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_ProviderReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).ProviderReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/ProviderReady",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).ProviderReady(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_ProviderHealthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).ProviderHealthy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/ProviderHealthy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).ProviderHealthy(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_Transmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).Transmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/Transmit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).Transmit(ctx, req.(*TransmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_LatestConfigDigestAndEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).LatestConfigDigestAndEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/LatestConfigDigestAndEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).LatestConfigDigestAndEpoch(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_LatestConfigDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).LatestConfigDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/LatestConfigDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).LatestConfigDetails(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_LatestConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).LatestConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/LatestConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).LatestConfig(ctx, req.(*LatestConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_LatestBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).LatestBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/LatestBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).LatestBlockHeight(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_ConfigDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).ConfigDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/ConfigDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).ConfigDigest(ctx, req.(*ConfigDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_BuildReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).BuildReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/BuildReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).BuildReport(ctx, req.(*BuildReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_MedianFromReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MedianFromReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).MedianFromReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/MedianFromReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).MedianFromReport(ctx, req.(*MedianFromReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_LatestTransmissionDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).LatestTransmissionDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/LatestTransmissionDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).LatestTransmissionDetails(ctx, req.(*ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Relayer_LatestRoundRequested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LatestRoundRequestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RelayerServer).LatestRoundRequested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/relay.v1.Relayer/LatestRoundRequested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RelayerServer).LatestRoundRequested(ctx, req.(*LatestRoundRequestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Relayer_ServiceDesc is the grpc.ServiceDesc for Relayer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Relayer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "relay.v1.Relayer",
+	HandlerType: (*RelayerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Start",
+			Handler:    _Relayer_Start_Handler,
+		},
+		{
+			MethodName: "Close",
+			Handler:    _Relayer_Close_Handler,
+		},
+		{
+			MethodName: "Ready",
+			Handler:    _Relayer_Ready_Handler,
+		},
+		{
+			MethodName: "Healthy",
+			Handler:    _Relayer_Healthy_Handler,
+		},
+		{
+			MethodName: "NewOCR2Provider",
+			Handler:    _Relayer_NewOCR2Provider_Handler,
+		},
+		{
+			MethodName: "ProviderStart",
+			Handler:    _Relayer_ProviderStart_Handler,
+		},
+		{
+			MethodName: "ProviderClose",
+			Handler:    _Relayer_ProviderClose_Handler,
+		},
+		{
+			MethodName: "ProviderReady",
+			Handler:    _Relayer_ProviderReady_Handler,
+		},
+		{
+			MethodName: "ProviderHealthy",
+			Handler:    _Relayer_ProviderHealthy_Handler,
+		},
+		{
+			MethodName: "Transmit",
+			Handler:    _Relayer_Transmit_Handler,
+		},
+		{
+			MethodName: "LatestConfigDigestAndEpoch",
+			Handler:    _Relayer_LatestConfigDigestAndEpoch_Handler,
+		},
+		{
+			MethodName: "LatestConfigDetails",
+			Handler:    _Relayer_LatestConfigDetails_Handler,
+		},
+		{
+			MethodName: "LatestConfig",
+			Handler:    _Relayer_LatestConfig_Handler,
+		},
+		{
+			MethodName: "LatestBlockHeight",
+			Handler:    _Relayer_LatestBlockHeight_Handler,
+		},
+		{
+			MethodName: "ConfigDigest",
+			Handler:    _Relayer_ConfigDigest_Handler,
+		},
+		{
+			MethodName: "BuildReport",
+			Handler:    _Relayer_BuildReport_Handler,
+		},
+		{
+			MethodName: "MedianFromReport",
+			Handler:    _Relayer_MedianFromReport_Handler,
+		},
+		{
+			MethodName: "LatestTransmissionDetails",
+			Handler:    _Relayer_LatestTransmissionDetails_Handler,
+		},
+		{
+			MethodName: "LatestRoundRequested",
+			Handler:    _Relayer_LatestRoundRequested_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/relayer.proto",
+}
@@ -0,0 +1,509 @@
+package plugin_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/libocr/commontypes"
+	"github.com/smartcontractkit/libocr/offchainreporting2/chains/evmutil"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/smartcontractkit/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	txmMock "github.com/smartcontractkit/chainlink/core/chains/evm/bulletprooftxmanager/mocks"
+	evmConfigMock "github.com/smartcontractkit/chainlink/core/chains/evm/config/mocks"
+	htMock "github.com/smartcontractkit/chainlink/core/chains/evm/headtracker/mocks"
+	logMock "github.com/smartcontractkit/chainlink/core/chains/evm/log/mocks"
+	chainsMock "github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	keystoreMock "github.com/smartcontractkit/chainlink/core/services/keystore/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	"github.com/smartcontractkit/chainlink/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/core/services/relay/plugin"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+// envHelper selects the relayer served by TestHelperProcess
+const envHelper = "RELAY_PLUGIN_TEST_HELPER"
+
+// TestHelperProcess is not a test, but the plugin process started by the
+// tests below, which re-run the test binary.
+func TestHelperProcess(t *testing.T) {
+	var err error
+	switch os.Getenv(envHelper) {
+	case "":
+		t.Skip("only run as a relay plugin")
+	case "fake":
+		err = plugin.Serve(newFakeRelayer(), decodeFakeSpec, logger.NewLogger())
+	case "evm":
+		err = plugin.Serve(evm.NewRelayer(&sqlx.DB{}, newEVMChainSet(), logger.NewLogger()), evm.OCR2SpecFromPlugin, logger.NewLogger())
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func newPluginRelayer(t *testing.T, helper string) *plugin.Relayer {
+	t.Helper()
+	r := plugin.NewRelayer("test", plugin.ProcessConfig{
+		Cmd:  os.Args[0],
+		Args: []string{"-test.run=^TestHelperProcess$"},
+		Env:  []string{envHelper + "=" + helper},
+	}, logger.TestLogger(t))
+	require.NoError(t, r.Start())
+	t.Cleanup(func() { assert.NoError(t, r.Close()) })
+	return r
+}
+
+func TestRelayer(t *testing.T) {
+	t.Parallel()
+
+	r := newPluginRelayer(t, "fake")
+	require.NoError(t, r.Ready())
+	require.NoError(t, r.Healthy())
+
+	_, err := r.NewOCR2Provider(uuid.NewV4(), "not a spec")
+	require.EqualError(t, err, "expected plugin.OCR2Spec, got string")
+
+	_, err = r.NewOCR2Provider(uuid.NewV4(), plugin.OCR2Spec{RelayConfig: json.RawMessage(`{"fail": true}`)})
+	require.EqualError(t, err, "fake relayer cannot create provider")
+
+	_, err = r.NewOCR2Provider(uuid.NewV4(), plugin.OCR2Spec{RelayConfig: json.RawMessage(`{`)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid spec")
+
+	t.Run("median provider", func(t *testing.T) {
+		p, err := r.NewOCR2Provider(uuid.NewV4(), plugin.OCR2Spec{
+			ID:            1,
+			ContractID:    "contract",
+			TransmitterID: null.StringFrom("transmitter"),
+			RelayConfig:   json.RawMessage(`{}`),
+		})
+		require.NoError(t, err)
+		require.Error(t, p.Ready())
+		require.NoError(t, p.Start())
+		require.NoError(t, p.Ready())
+		require.NoError(t, p.Healthy())
+
+		ctx := context.Background()
+		digest := ocrtypes.ConfigDigest{1}
+
+		transmitter := p.ContractTransmitter()
+		require.NotNil(t, transmitter)
+		assert.Equal(t, ocrtypes.Account("transmitter"), transmitter.FromAccount())
+		reportContext := ocrtypes.ReportContext{ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: digest, Epoch: 2, Round: 3}}
+		require.NoError(t, transmitter.Transmit(ctx, reportContext, ocrtypes.Report("report"), []ocrtypes.AttributedOnchainSignature{{Signature: []byte{1}, Signer: 4}}))
+		require.EqualError(t, transmitter.Transmit(ctx, reportContext, ocrtypes.Report("bad"), nil), "fake transmit failed")
+		gotDigest, epoch, err := transmitter.LatestConfigDigestAndEpoch(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, digest, gotDigest)
+		assert.Equal(t, uint32(2), epoch)
+
+		tracker := p.ContractConfigTracker()
+		assert.Nil(t, tracker.Notify())
+		changedInBlock, gotDigest, err := tracker.LatestConfigDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), changedInBlock)
+		assert.Equal(t, digest, gotDigest)
+		config, err := tracker.LatestConfig(ctx, 10)
+		require.NoError(t, err)
+		assert.Equal(t, newFakeContractConfig(10), config)
+		height, err := tracker.LatestBlockHeight(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), height)
+
+		digester := p.OffchainConfigDigester()
+		assert.Equal(t, ocrtypes.ConfigDigestPrefixEVM, digester.ConfigDigestPrefix())
+		gotDigest, err = digester.ConfigDigest(newFakeContractConfig(7))
+		require.NoError(t, err)
+		assert.Equal(t, ocrtypes.ConfigDigest{7}, gotDigest)
+
+		mp, ok := p.(types.MedianProvider)
+		require.True(t, ok)
+		report, err := mp.ReportCodec().BuildReport([]median.ParsedAttributedObservation{{Value: big.NewInt(123), JuelsPerFeeCoin: big.NewInt(1)}})
+		require.NoError(t, err)
+		answer, err := mp.ReportCodec().MedianFromReport(report)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(123), answer)
+		_, err = mp.ReportCodec().BuildReport(nil)
+		require.EqualError(t, err, "no observations")
+
+		gotDigest, epoch, round, answer, timestamp, err := mp.MedianContract().LatestTransmissionDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, digest, gotDigest)
+		assert.Equal(t, uint32(3), epoch)
+		assert.Equal(t, uint8(4), round)
+		assert.Equal(t, big.NewInt(100), answer)
+		assert.True(t, timestamp.Equal(time.Unix(1646136000, 0)))
+		gotDigest, epoch, round, err = mp.MedianContract().LatestRoundRequested(ctx, 5*time.Second)
+		require.NoError(t, err)
+		assert.Equal(t, digest, gotDigest)
+		assert.Equal(t, uint32(5), epoch)
+		assert.Equal(t, uint8(1), round)
+
+		require.NoError(t, p.Close())
+		err = p.Ready()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not found")
+	})
+
+	t.Run("bootstrap provider", func(t *testing.T) {
+		p, err := r.NewOCR2Provider(uuid.NewV4(), plugin.OCR2Spec{
+			ID:          2,
+			ContractID:  "contract",
+			IsBootstrap: true,
+			RelayConfig: json.RawMessage(`{}`),
+		})
+		require.NoError(t, err)
+		assert.Nil(t, p.ContractTransmitter())
+		_, ok := p.(types.MedianProvider)
+		assert.False(t, ok)
+
+		height, err := p.ContractConfigTracker().LatestBlockHeight(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, uint64(42), height)
+		require.NoError(t, p.Close())
+	})
+}
+
+const (
+	evmContractID    = "0x0000000000000000000000000000000000000001"
+	evmTransmitterID = "0x0000000000000000000000000000000000000002"
+)
+
+// newEVMChainSet returns a ChainSet of chain 4 only, which is at block 42.
+// It serves the EVM relayer both in process and as a plugin, where its
+// expectations cannot be asserted.
+func newEVMChainSet() *chainsMock.ChainSet {
+	cfg := new(evmConfigMock.ChainScopedConfig)
+	cfg.On("ChainID").Return(big.NewInt(4))
+	cfg.On("ChainType").Return(chains.ChainType(""))
+	cfg.On("LogSQL").Return(false)
+	cfg.On("OCRDefaultTransactionQueueDepth").Return(uint32(1))
+	cfg.On("EvmGasLimitDefault").Return(uint64(500000))
+
+	client := new(chainsMock.Client)
+	client.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(&evmtypes.Head{Number: 42}, nil)
+
+	chain := new(chainsMock.Chain)
+	chain.On("Config").Return(cfg)
+	chain.On("Client").Return(client)
+	chain.On("LogBroadcaster").Return(new(logMock.Broadcaster))
+	chain.On("HeadBroadcaster").Return(new(htMock.HeadBroadcaster))
+	chain.On("TxManager").Return(new(txmMock.TxManager))
+
+	chainSet := new(chainsMock.ChainSet)
+	chainSet.On("Get", big.NewInt(4)).Return(chain, nil)
+	chainSet.On("Get", mock.Anything).Return(nil, errors.New("chain not found"))
+	return chainSet
+}
+
+// TestRelayer_EVM runs the same OCR2 provider tests against the EVM relayer in
+// process, and served as a plugin.
+func TestRelayer_EVM(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name    string
+		relayer func(t *testing.T) types.Relayer
+	}{
+		{"in process", func(t *testing.T) types.Relayer {
+			return evm.NewRelayer(&sqlx.DB{}, newEVMChainSet(), logger.TestLogger(t))
+		}},
+		{"plugin", func(t *testing.T) types.Relayer {
+			return newPluginRelayer(t, "evm")
+		}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tt.relayer(t)
+			require.NoError(t, r.Ready())
+			d := relay.NewDelegate(new(keystoreMock.Master))
+			d.AddRelayer(types.EVM, r)
+
+			newProvider := func(relayConfig job.RelayConfig, isBootstrap bool) (types.OCR2Provider, error) {
+				return d.NewOCR2Provider(uuid.NewV4(), &relay.OCR2ProviderArgs{
+					ID:              1,
+					ContractID:      evmContractID,
+					TransmitterID:   null.StringFrom(evmTransmitterID),
+					Relay:           types.EVM,
+					RelayConfig:     relayConfig,
+					IsBootstrapPeer: isBootstrap,
+				})
+			}
+
+			_, err := newProvider(job.RelayConfig{"chainID": 5}, false)
+			require.EqualError(t, err, "chain not found")
+
+			_, err = newProvider(job.RelayConfig{"chainID": "foo"}, false)
+			require.Error(t, err)
+
+			digester := evmutil.EVMOffchainConfigDigester{ChainID: 4, ContractAddress: common.HexToAddress(evmContractID)}
+			config := ocrtypes.ContractConfig{
+				ConfigCount: 7,
+				Signers: []ocrtypes.OnchainPublicKey{
+					common.HexToAddress("0x0000000000000000000000000000000000000011").Bytes(),
+					common.HexToAddress("0x0000000000000000000000000000000000000012").Bytes(),
+				},
+				Transmitters: []ocrtypes.Account{
+					ocrtypes.Account(common.HexToAddress("0x0000000000000000000000000000000000000021").String()),
+					ocrtypes.Account(common.HexToAddress("0x0000000000000000000000000000000000000022").String()),
+				},
+				F:                     1,
+				OffchainConfigVersion: 2,
+				OffchainConfig:        []byte{3},
+			}
+			expectedDigest, err := digester.ConfigDigest(config)
+			require.NoError(t, err)
+
+			t.Run("median provider", func(t *testing.T) {
+				p, err := newProvider(job.RelayConfig{"chainID": 4}, false)
+				require.NoError(t, err)
+
+				assert.Equal(t, ocrtypes.ConfigDigestPrefixEVM, p.OffchainConfigDigester().ConfigDigestPrefix())
+				digest, err := p.OffchainConfigDigester().ConfigDigest(config)
+				require.NoError(t, err)
+				assert.Equal(t, expectedDigest, digest)
+
+				height, err := p.ContractConfigTracker().LatestBlockHeight(context.Background())
+				require.NoError(t, err)
+				assert.Equal(t, uint64(42), height)
+
+				transmitter := p.ContractTransmitter()
+				require.NotNil(t, transmitter)
+				assert.Equal(t, ocrtypes.Account(common.HexToAddress(evmTransmitterID).String()), transmitter.FromAccount())
+
+				mp, ok := p.(types.MedianProvider)
+				require.True(t, ok)
+				report, err := mp.ReportCodec().BuildReport([]median.ParsedAttributedObservation{
+					{Value: big.NewInt(123), JuelsPerFeeCoin: big.NewInt(1), Observer: 0},
+					{Value: big.NewInt(456), JuelsPerFeeCoin: big.NewInt(1), Observer: 1},
+					{Value: big.NewInt(789), JuelsPerFeeCoin: big.NewInt(1), Observer: 2},
+				})
+				require.NoError(t, err)
+				answer, err := mp.ReportCodec().MedianFromReport(report)
+				require.NoError(t, err)
+				assert.Equal(t, big.NewInt(456), answer)
+				_, err = mp.ReportCodec().MedianFromReport(ocrtypes.Report("not a report"))
+				require.Error(t, err)
+			})
+
+			t.Run("bootstrap provider", func(t *testing.T) {
+				p, err := newProvider(job.RelayConfig{"chainID": 4}, true)
+				require.NoError(t, err)
+
+				assert.Nil(t, p.ContractTransmitter())
+				digest, err := p.OffchainConfigDigester().ConfigDigest(config)
+				require.NoError(t, err)
+				assert.Equal(t, expectedDigest, digest)
+			})
+		})
+	}
+}
+
+func TestRelayer_StartFailed(t *testing.T) {
+	t.Parallel()
+
+	r := plugin.NewRelayer("test", plugin.ProcessConfig{Cmd: "/does/not/exist"}, logger.TestLogger(t))
+	err := r.Start()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to start test relay plugin")
+
+	r = plugin.NewRelayer("test", plugin.ProcessConfig{
+		Cmd:  os.Args[0],
+		Args: []string{"-test.run=^TestHelperProcess$"},
+	}, logger.TestLogger(t))
+	err = r.Start()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "relay plugin exited before serving")
+	require.Error(t, r.Ready())
+}
+
+type fakeSpec struct {
+	plugin.OCR2Spec
+	Fail bool `json:"fail"`
+}
+
+func decodeFakeSpec(spec plugin.OCR2Spec) (interface{}, error) {
+	s := fakeSpec{OCR2Spec: spec}
+	if err := json.Unmarshal(spec.RelayConfig, &s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+type fakeRelayer struct {
+	started bool
+}
+
+func newFakeRelayer() *fakeRelayer {
+	return &fakeRelayer{}
+}
+
+func (r *fakeRelayer) Start() error {
+	r.started = true
+	return nil
+}
+
+func (r *fakeRelayer) Close() error {
+	return nil
+}
+
+func (r *fakeRelayer) Ready() error {
+	if !r.started {
+		return errors.New("fake relayer not started")
+	}
+	return nil
+}
+
+func (r *fakeRelayer) Healthy() error {
+	return nil
+}
+
+func (r *fakeRelayer) NewOCR2Provider(externalJobID uuid.UUID, s interface{}) (types.OCR2Provider, error) {
+	spec := s.(fakeSpec)
+	if spec.Fail {
+		return nil, errors.New("fake relayer cannot create provider")
+	}
+	return &fakeProvider{spec: spec}, nil
+}
+
+func newFakeContractConfig(configCount uint64) ocrtypes.ContractConfig {
+	return ocrtypes.ContractConfig{
+		ConfigDigest:          ocrtypes.ConfigDigest{1},
+		ConfigCount:           configCount,
+		Signers:               []ocrtypes.OnchainPublicKey{{1}, {2}},
+		Transmitters:          []ocrtypes.Account{"a", "b"},
+		F:                     1,
+		OnchainConfig:         []byte{1},
+		OffchainConfigVersion: 2,
+		OffchainConfig:        []byte{3},
+	}
+}
+
+// fakeProvider is a MedianProvider, and its own transmitter, tracker,
+// digester, codec and contract.
+type fakeProvider struct {
+	spec    fakeSpec
+	started bool
+}
+
+func (p *fakeProvider) Start() error {
+	p.started = true
+	return nil
+}
+
+func (p *fakeProvider) Close() error {
+	return nil
+}
+
+func (p *fakeProvider) Ready() error {
+	if !p.started {
+		return errors.New("fake provider not started")
+	}
+	return nil
+}
+
+func (p *fakeProvider) Healthy() error {
+	return nil
+}
+
+func (p *fakeProvider) ContractTransmitter() ocrtypes.ContractTransmitter {
+	return p
+}
+
+func (p *fakeProvider) ContractConfigTracker() ocrtypes.ContractConfigTracker {
+	return p
+}
+
+func (p *fakeProvider) OffchainConfigDigester() ocrtypes.OffchainConfigDigester {
+	return p
+}
+
+func (p *fakeProvider) ReportCodec() median.ReportCodec {
+	return p
+}
+
+func (p *fakeProvider) MedianContract() median.MedianContract {
+	return p
+}
+
+func (p *fakeProvider) Transmit(ctx context.Context, reportContext ocrtypes.ReportContext, report ocrtypes.Report, signatures []ocrtypes.AttributedOnchainSignature) error {
+	if string(report) == "bad" {
+		return errors.New("fake transmit failed")
+	}
+	if reportContext.Epoch != 2 || len(signatures) != 1 || signatures[0].Signer != commontypes.OracleID(4) {
+		return errors.New("unexpected transmission")
+	}
+	return nil
+}
+
+func (p *fakeProvider) LatestConfigDigestAndEpoch(ctx context.Context) (ocrtypes.ConfigDigest, uint32, error) {
+	return ocrtypes.ConfigDigest{1}, 2, nil
+}
+
+func (p *fakeProvider) FromAccount() ocrtypes.Account {
+	return ocrtypes.Account(p.spec.TransmitterID.String)
+}
+
+func (p *fakeProvider) Notify() <-chan struct{} {
+	return nil
+}
+
+func (p *fakeProvider) LatestConfigDetails(ctx context.Context) (uint64, ocrtypes.ConfigDigest, error) {
+	return 10, ocrtypes.ConfigDigest{1}, nil
+}
+
+func (p *fakeProvider) LatestConfig(ctx context.Context, changedInBlock uint64) (ocrtypes.ContractConfig, error) {
+	return newFakeContractConfig(changedInBlock), nil
+}
+
+func (p *fakeProvider) LatestBlockHeight(ctx context.Context) (uint64, error) {
+	return 42, nil
+}
+
+func (p *fakeProvider) ConfigDigest(config ocrtypes.ContractConfig) (ocrtypes.ConfigDigest, error) {
+	return ocrtypes.ConfigDigest{byte(config.ConfigCount)}, nil
+}
+
+func (p *fakeProvider) ConfigDigestPrefix() ocrtypes.ConfigDigestPrefix {
+	return ocrtypes.ConfigDigestPrefixEVM
+}
+
+func (p *fakeProvider) BuildReport(observations []median.ParsedAttributedObservation) (ocrtypes.Report, error) {
+	if len(observations) == 0 {
+		return nil, errors.New("no observations")
+	}
+	return ocrtypes.Report(observations[0].Value.Bytes()), nil
+}
+
+func (p *fakeProvider) MedianFromReport(report ocrtypes.Report) (*big.Int, error) {
+	return new(big.Int).SetBytes(report), nil
+}
+
+func (p *fakeProvider) LatestTransmissionDetails(ctx context.Context) (ocrtypes.ConfigDigest, uint32, uint8, *big.Int, time.Time, error) {
+	return ocrtypes.ConfigDigest{1}, 3, 4, big.NewInt(100), time.Unix(1646136000, 0), nil
+}
+
+func (p *fakeProvider) LatestRoundRequested(ctx context.Context, lookback time.Duration) (ocrtypes.ConfigDigest, uint32, uint8, error) {
+	return ocrtypes.ConfigDigest{1}, uint32(lookback.Seconds()), 1, nil
+}
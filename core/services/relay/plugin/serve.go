@@ -0,0 +1,98 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	pb "github.com/smartcontractkit/chainlink/core/services/relay/plugin/proto"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+const (
+	// protocolVersion is bumped whenever the relayer service changes
+	// incompatibly
+	protocolVersion = 2
+	// handshakePrefix starts the line with which a plugin tells the node
+	// where it serves
+	handshakePrefix = "RELAY_PLUGIN"
+	// envToken is the environment variable through which the node passes
+	// the token authenticating it to the plugin
+	envToken = "RELAY_PLUGIN_TOKEN"
+)
+
+func handshake(addr net.Addr) string {
+	return fmt.Sprintf("%s|%d|%s|%s", handshakePrefix, protocolVersion, addr.Network(), addr.String())
+}
+
+// Serve serves relayer as a plugin of the node which started this process,
+// with decodeSpec decoding the specs of the providers it is asked for.
+// It blocks until the node closes the relayer, the node exits, or the
+// process is signalled to stop.
+func Serve(relayer types.Relayer, decodeSpec SpecDecoder, lggr logger.Logger) error {
+	token := os.Getenv(envToken)
+	if token == "" {
+		return errors.Errorf("%s is not set: relay plugins must be started by the node", envToken)
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return errors.Wrap(err, "failed to listen")
+	}
+	srv := newServer(relayer, decodeSpec, lggr)
+	grpcServer := newGRPCServer(srv, token)
+
+	chErr := make(chan error, 1)
+	go func() {
+		chErr <- grpcServer.Serve(lis)
+	}()
+
+	// stdout carries nothing but the handshake, logs go to stderr
+	if _, err = fmt.Fprintln(os.Stdout, handshake(lis.Addr())); err != nil {
+		grpcServer.Stop()
+		return errors.Wrap(err, "failed to write handshake")
+	}
+	lggr.Infow("Serving relay plugin", "addr", lis.Addr().String())
+
+	// the node holds our stdin open for as long as it runs
+	chStdinClosed := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(ioutil.Discard, os.Stdin)
+		close(chStdinClosed)
+	}()
+
+	chSignal := make(chan os.Signal, 1)
+	signal.Notify(chSignal, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(chSignal)
+
+	select {
+	case err = <-chErr:
+		return errors.Wrap(err, "relay plugin server failed")
+	case <-srv.closed:
+		lggr.Info("Relay plugin closed by the node")
+	case <-chStdinClosed:
+		lggr.Warn("Node exited, closing relay plugin")
+		_, err = srv.Close(context.Background(), &emptypb.Empty{})
+	case sig := <-chSignal:
+		lggr.Infow("Relay plugin received signal, closing", "signal", sig)
+		_, err = srv.Close(context.Background(), &emptypb.Empty{})
+	}
+	grpcServer.GracefulStop()
+	return err
+}
+
+func newGRPCServer(srv *server, token string) *grpc.Server {
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(authenticate(token)))
+	pb.RegisterRelayerServer(grpcServer, srv)
+	return grpcServer
+}
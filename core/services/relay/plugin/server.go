@@ -0,0 +1,331 @@
+package plugin
+
+import (
+	"context"
+	"crypto/subtle"
+	"sync"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/multierr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	pb "github.com/smartcontractkit/chainlink/core/services/relay/plugin/proto"
+	"github.com/smartcontractkit/chainlink/core/services/relay/types"
+)
+
+// SpecDecoder decodes the chain agnostic OCR2Spec into the spec expected by
+// the NewOCR2Provider of a relayer.
+type SpecDecoder func(spec OCR2Spec) (interface{}, error)
+
+var _ pb.RelayerServer = (*server)(nil)
+
+// server serves a relayer, and the providers it creates, to the node.
+type server struct {
+	pb.UnimplementedRelayerServer
+
+	relayer    types.Relayer
+	decodeSpec SpecDecoder
+	lggr       logger.Logger
+
+	closeOnce sync.Once
+	// closed is closed once the node has closed the relayer
+	closed chan struct{}
+
+	mu        sync.RWMutex
+	providers map[string]types.OCR2Provider
+	// started holds the IDs of the providers the node has started, which
+	// are closed along with the relayer
+	started map[string]struct{}
+}
+
+func newServer(relayer types.Relayer, decodeSpec SpecDecoder, lggr logger.Logger) *server {
+	return &server{
+		relayer:    relayer,
+		decodeSpec: decodeSpec,
+		lggr:       lggr,
+		closed:     make(chan struct{}),
+		providers:  make(map[string]types.OCR2Provider),
+		started:    make(map[string]struct{}),
+	}
+}
+
+// authenticate rejects calls which do not carry token, so that only the node
+// which started the plugin can use it.
+func authenticate(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		tokens := md.Get(tokenKey)
+		if len(tokens) != 1 || subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(token)) != 1 {
+			return nil, status.Error(codes.Unauthenticated, "invalid relay plugin token")
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (s *server) Start(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.relayer.Start()
+}
+
+func (s *server) Close(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	var err error
+	for id := range s.started {
+		err = multierr.Append(err, errors.Wrapf(s.providers[id].Close(), "provider %s", id))
+	}
+	s.providers = make(map[string]types.OCR2Provider)
+	s.started = make(map[string]struct{})
+	s.mu.Unlock()
+
+	err = multierr.Combine(err, s.relayer.Close())
+	s.closeOnce.Do(func() { close(s.closed) })
+	return &emptypb.Empty{}, err
+}
+
+func (s *server) Ready(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.relayer.Ready()
+}
+
+func (s *server) Healthy(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, s.relayer.Healthy()
+}
+
+func (s *server) NewOCR2Provider(ctx context.Context, req *pb.NewOCR2ProviderRequest) (*pb.NewOCR2ProviderResponse, error) {
+	externalJobID, ocr2Spec, err := newOCR2ProviderRequestFromPB(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	spec, err := s.decodeSpec(ocr2Spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid spec")
+	}
+	provider, err := s.relayer.NewOCR2Provider(externalJobID, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.NewOCR2ProviderResponse{
+		ProviderId:         uuid.NewV4().String(),
+		ConfigDigestPrefix: uint32(provider.OffchainConfigDigester().ConfigDigestPrefix()),
+	}
+	// bootstrap providers have no transmitter, nor can they run a reporting
+	// plugin
+	if !ocr2Spec.IsBootstrap {
+		resp.FromAccount = string(provider.ContractTransmitter().FromAccount())
+		_, resp.Median = provider.(types.MedianProvider)
+	}
+
+	s.mu.Lock()
+	s.providers[resp.ProviderId] = provider
+	s.mu.Unlock()
+	return resp, nil
+}
+
+func (s *server) provider(id string) (types.OCR2Provider, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	provider, ok := s.providers[id]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "provider %s not found", id)
+	}
+	return provider, nil
+}
+
+func (s *server) medianProvider(id string) (types.MedianProvider, error) {
+	provider, err := s.provider(id)
+	if err != nil {
+		return nil, err
+	}
+	median, ok := provider.(types.MedianProvider)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "provider %s cannot run the median reporting plugin", id)
+	}
+	return median, nil
+}
+
+func (s *server) ProviderStart(ctx context.Context, req *pb.ProviderRequest) (*emptypb.Empty, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	if err = provider.Start(); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	s.started[req.ProviderId] = struct{}{}
+	s.mu.Unlock()
+	return &emptypb.Empty{}, nil
+}
+
+func (s *server) ProviderClose(ctx context.Context, req *pb.ProviderRequest) (*emptypb.Empty, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	delete(s.providers, req.ProviderId)
+	delete(s.started, req.ProviderId)
+	s.mu.Unlock()
+	return &emptypb.Empty{}, provider.Close()
+}
+
+func (s *server) ProviderReady(ctx context.Context, req *pb.ProviderRequest) (*emptypb.Empty, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, provider.Ready()
+}
+
+func (s *server) ProviderHealthy(ctx context.Context, req *pb.ProviderRequest) (*emptypb.Empty, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, provider.Healthy()
+}
+
+func (s *server) Transmit(ctx context.Context, req *pb.TransmitRequest) (*emptypb.Empty, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	reportContext, err := reportContextFromPB(req.ReportContext)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	signatures, err := signaturesFromPB(req.Signatures)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &emptypb.Empty{}, provider.ContractTransmitter().Transmit(ctx, reportContext, req.Report, signatures)
+}
+
+func (s *server) LatestConfigDigestAndEpoch(ctx context.Context, req *pb.ProviderRequest) (*pb.LatestConfigDigestAndEpochResponse, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	configDigest, epoch, err := provider.ContractTransmitter().LatestConfigDigestAndEpoch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestConfigDigestAndEpochResponse{ConfigDigest: configDigest[:], Epoch: epoch}, nil
+}
+
+func (s *server) LatestConfigDetails(ctx context.Context, req *pb.ProviderRequest) (*pb.LatestConfigDetailsResponse, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	changedInBlock, configDigest, err := provider.ContractConfigTracker().LatestConfigDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestConfigDetailsResponse{ChangedInBlock: changedInBlock, ConfigDigest: configDigest[:]}, nil
+}
+
+func (s *server) LatestConfig(ctx context.Context, req *pb.LatestConfigRequest) (*pb.LatestConfigResponse, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	config, err := provider.ContractConfigTracker().LatestConfig(ctx, req.ChangedInBlock)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestConfigResponse{Config: contractConfigToPB(config)}, nil
+}
+
+func (s *server) LatestBlockHeight(ctx context.Context, req *pb.ProviderRequest) (*pb.LatestBlockHeightResponse, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	blockHeight, err := provider.ContractConfigTracker().LatestBlockHeight(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestBlockHeightResponse{BlockHeight: blockHeight}, nil
+}
+
+func (s *server) ConfigDigest(ctx context.Context, req *pb.ConfigDigestRequest) (*pb.ConfigDigestResponse, error) {
+	provider, err := s.provider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	config, err := contractConfigFromPB(req.Config)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	configDigest, err := provider.OffchainConfigDigester().ConfigDigest(config)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ConfigDigestResponse{ConfigDigest: configDigest[:]}, nil
+}
+
+func (s *server) BuildReport(ctx context.Context, req *pb.BuildReportRequest) (*pb.BuildReportResponse, error) {
+	provider, err := s.medianProvider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	observations, err := observationsFromPB(req.Observations)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	report, err := provider.ReportCodec().BuildReport(observations)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BuildReportResponse{Report: report}, nil
+}
+
+func (s *server) MedianFromReport(ctx context.Context, req *pb.MedianFromReportRequest) (*pb.MedianFromReportResponse, error) {
+	provider, err := s.medianProvider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	median, err := provider.ReportCodec().MedianFromReport(req.Report)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MedianFromReportResponse{Median: bigIntToPB(median)}, nil
+}
+
+func (s *server) LatestTransmissionDetails(ctx context.Context, req *pb.ProviderRequest) (*pb.LatestTransmissionDetailsResponse, error) {
+	provider, err := s.medianProvider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	configDigest, epoch, round, latestAnswer, latestTimestamp, err := provider.MedianContract().LatestTransmissionDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestTransmissionDetailsResponse{
+		ConfigDigest:    configDigest[:],
+		Epoch:           epoch,
+		Round:           uint32(round),
+		LatestAnswer:    bigIntToPB(latestAnswer),
+		LatestTimestamp: timestamppb.New(latestTimestamp),
+	}, nil
+}
+
+func (s *server) LatestRoundRequested(ctx context.Context, req *pb.LatestRoundRequestedRequest) (*pb.LatestRoundRequestedResponse, error) {
+	provider, err := s.medianProvider(req.ProviderId)
+	if err != nil {
+		return nil, err
+	}
+	configDigest, epoch, round, err := provider.MedianContract().LatestRoundRequested(ctx, req.Lookback.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LatestRoundRequestedResponse{ConfigDigest: configDigest[:], Epoch: epoch, Round: uint32(round)}, nil
+}
@@ -0,0 +1,136 @@
+package plugin
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gopkg.in/guregu/null.v4"
+
+	pb "github.com/smartcontractkit/chainlink/core/services/relay/plugin/proto"
+)
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	interceptor := authenticate("secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/relay.v1.Relayer/Ready"}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tokenKey, "secret"))
+	_, err := interceptor(ctx, &emptypb.Empty{}, info, handler)
+	require.NoError(t, err)
+
+	for _, ctx := range []context.Context{
+		context.Background(),
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(tokenKey, "wrong")),
+		metadata.NewIncomingContext(context.Background(), metadata.Pairs(tokenKey, "secret", tokenKey, "secret")),
+	} {
+		_, err = interceptor(ctx, &emptypb.Empty{}, info, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	t.Parallel()
+
+	addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}
+	got, err := parseHandshake(handshake(addr))
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:1234", got)
+
+	_, err = parseHandshake("RELAY_PLUGIN|1|tcp|127.0.0.1:1234")
+	assert.EqualError(t, err, "relay plugin speaks protocol version 1, expected 2")
+	_, err = parseHandshake("RELAY_PLUGIN|2|unix|/tmp/plugin.sock")
+	assert.EqualError(t, err, `relay plugin serves on unsupported network "unix"`)
+	_, err = parseHandshake("RELAY_PLUGIN|2")
+	assert.EqualError(t, err, `invalid relay plugin handshake "RELAY_PLUGIN|2"`)
+}
+
+func TestMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OCR2Spec", func(t *testing.T) {
+		externalJobID := uuid.NewV4()
+		for _, spec := range []OCR2Spec{
+			{ID: 1, ContractID: "foo", TransmitterID: null.StringFrom("bar"), RelayConfig: []byte(`{"chainID":4}`)},
+			{ID: 2, ContractID: "foo", IsBootstrap: true},
+		} {
+			gotID, got, err := newOCR2ProviderRequestFromPB(newOCR2ProviderRequestToPB(externalJobID, spec))
+			require.NoError(t, err)
+			assert.Equal(t, externalJobID, gotID)
+			assert.Equal(t, spec, got)
+		}
+
+		_, _, err := newOCR2ProviderRequestFromPB(&pb.NewOCR2ProviderRequest{ExternalJobId: []byte{1}})
+		assert.Error(t, err)
+	})
+
+	t.Run("BigInt", func(t *testing.T) {
+		for _, i := range []*big.Int{nil, big.NewInt(0), big.NewInt(-42), new(big.Int).Lsh(big.NewInt(1), 200)} {
+			assert.Equal(t, i, bigIntFromPB(bigIntToPB(i)))
+		}
+	})
+
+	t.Run("ReportContext", func(t *testing.T) {
+		rc := ocrtypes.ReportContext{
+			ReportTimestamp: ocrtypes.ReportTimestamp{ConfigDigest: ocrtypes.ConfigDigest{1, 2, 3}, Epoch: 4, Round: 5},
+			ExtraHash:       [32]byte{6},
+		}
+		got, err := reportContextFromPB(reportContextToPB(rc))
+		require.NoError(t, err)
+		assert.Equal(t, rc, got)
+
+		c := reportContextToPB(rc)
+		c.ReportTimestamp.Round = 256
+		_, err = reportContextFromPB(c)
+		assert.EqualError(t, err, "round 256 overflows uint8")
+		c = reportContextToPB(rc)
+		c.ReportTimestamp.ConfigDigest = []byte{1}
+		_, err = reportContextFromPB(c)
+		assert.Error(t, err)
+	})
+
+	t.Run("ContractConfig", func(t *testing.T) {
+		config := ocrtypes.ContractConfig{
+			ConfigDigest:          ocrtypes.ConfigDigest{1},
+			ConfigCount:           2,
+			Signers:               []ocrtypes.OnchainPublicKey{{3}, {4}},
+			Transmitters:          []ocrtypes.Account{"a", "b"},
+			F:                     1,
+			OnchainConfig:         []byte{5},
+			OffchainConfigVersion: 6,
+			OffchainConfig:        []byte{7},
+		}
+		got, err := contractConfigFromPB(contractConfigToPB(config))
+		require.NoError(t, err)
+		assert.Equal(t, config, got)
+	})
+
+	t.Run("observations", func(t *testing.T) {
+		observations := []median.ParsedAttributedObservation{
+			{Timestamp: 1, Value: big.NewInt(2), JuelsPerFeeCoin: big.NewInt(3), Observer: 4},
+		}
+		got, err := observationsFromPB(observationsToPB(observations))
+		require.NoError(t, err)
+		assert.Equal(t, observations, got)
+
+		signatures := []ocrtypes.AttributedOnchainSignature{{Signature: []byte{1}, Signer: 2}}
+		gotSigs, err := signaturesFromPB(signaturesToPB(signatures))
+		require.NoError(t, err)
+		assert.Equal(t, signatures, gotSigs)
+	})
+}
@@ -0,0 +1,7 @@
+package plugin
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative proto/relayer.proto
+
+// tokenKey is the metadata key of the token authenticating the node to its
+// plugins
+const tokenKey = "x-relay-plugin-token"
@@ -28,6 +28,12 @@ type OCR2Provider interface {
 	ContractTransmitter() types.ContractTransmitter
 	ContractConfigTracker() types.ContractConfigTracker
 	OffchainConfigDigester() types.OffchainConfigDigester
+}
+
+// MedianProvider is an OCR2Provider which can also run the numerical median
+// reporting plugin.
+type MedianProvider interface {
+	OCR2Provider
 	ReportCodec() median.ReportCodec
 	MedianContract() median.MedianContract
 }
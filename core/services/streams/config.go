@@ -0,0 +1,198 @@
+package streams
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// Stream source types
+const (
+	TypeWebSocket = "websocket"
+	TypeGRPC      = "grpc"
+)
+
+// Config is the node configuration of the stream manager
+type Config interface {
+	StreamMaxStaleness() time.Duration
+	StreamSources() string
+}
+
+// Source configures a stream the node subscribes to, as given by
+// STREAM_SOURCES:
+//
+//	{
+//	  "binance-ethusd": {
+//	    "type": "websocket",
+//	    "url": "wss://stream.binance.com:9443/ws",
+//	    "subscribe": {"method": "SUBSCRIBE", "params": ["ethusdt@trade"], "id": 1},
+//	    "path": "p"
+//	  },
+//	  "prices-ethusd": {
+//	    "type": "grpc",
+//	    "url": "prices.example.com:443",
+//	    "method": "/prices.v1.Prices/Subscribe",
+//	    "request": {"pair": "ETH/USD"},
+//	    "path": "price",
+//	    "maxStaleness": "10s"
+//	  },
+//	  "quotes-ethusd": {
+//	    "type": "grpc",
+//	    "url": "quotes.example.com:443",
+//	    "method": "/quotes.v1.Quotes/Stream",
+//	    "descriptorSet": "/etc/chainlink/quotes.pb",
+//	    "request": {"pair": "ETH/USD"},
+//	    "path": "quote,price"
+//	  }
+//	}
+type Source struct {
+	// Type is either "websocket" or "grpc"
+	Type string `json:"type"`
+	// URL is the URL of a WebSocket stream, or the target of a gRPC stream
+	URL string `json:"url"`
+	// Headers are sent as WebSocket handshake headers or gRPC metadata
+	Headers map[string]string `json:"headers"`
+	// Subscribe is sent once connected to a WebSocket stream. Strings are
+	// sent as is, other JSON values are sent encoded.
+	Subscribe json.RawMessage `json:"subscribe"`
+	// Method is the full name of a server streaming gRPC method. Without a
+	// DescriptorSet, requests and responses are encoded as JSON, so the
+	// server must support the gRPC JSON codec.
+	Method string `json:"method"`
+	// DescriptorSet is the path of a binary FileDescriptorSet describing
+	// Method, as written by protoc --descriptor_set_out --include_imports.
+	// If set, messages are encoded as protobuf, converted from and to JSON
+	// with the canonical protobuf JSON mapping.
+	DescriptorSet string `json:"descriptorSet"`
+	// Request is the request sent to the gRPC method
+	Request json.RawMessage `json:"request"`
+	// Insecure disables TLS for gRPC streams
+	Insecure bool `json:"insecure"`
+	// Path is the comma separated path of the value in each message, as in
+	// jsonparse tasks. Messages without it, such as acknowledgements, are
+	// skipped.
+	Path string `json:"path"`
+	// MaxStaleness overrides STREAM_MAX_STALENESS for the source
+	MaxStaleness models.Duration `json:"maxStaleness"`
+}
+
+// ParseSources parses the sources configured by STREAM_SOURCES, defaulting
+// their max staleness to STREAM_MAX_STALENESS.
+func ParseSources(cfg Config) (map[string]Source, error) {
+	sources := make(map[string]Source)
+	s := strings.TrimSpace(cfg.StreamSources())
+	if s == "" {
+		return sources, nil
+	}
+	if err := json.Unmarshal([]byte(s), &sources); err != nil {
+		return nil, errors.Wrap(err, "invalid STREAM_SOURCES")
+	}
+
+	names := make([]string, 0, len(sources))
+	for name := range sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var err error
+	for _, name := range names {
+		source := sources[name]
+		if source.MaxStaleness.Duration() == 0 {
+			source.MaxStaleness = models.MustMakeDuration(cfg.StreamMaxStaleness())
+		}
+		sources[name] = source
+		if verr := source.validate(); verr != nil {
+			err = multierr.Append(err, errors.Wrapf(verr, "stream source %s", name))
+		}
+	}
+	return sources, errors.Wrap(err, "invalid STREAM_SOURCES")
+}
+
+func (s Source) validate() (err error) {
+	switch s.Type {
+	case TypeWebSocket:
+		u, perr := url.Parse(s.URL)
+		if perr != nil {
+			err = multierr.Append(err, errors.Wrap(perr, "invalid url"))
+		} else if u.Scheme != "ws" && u.Scheme != "wss" {
+			err = multierr.Append(err, errors.Errorf("url must be ws:// or wss://, got %q", s.URL))
+		}
+	case TypeGRPC:
+		if s.URL == "" {
+			err = multierr.Append(err, errors.New("url is required"))
+		}
+		if !strings.HasPrefix(s.Method, "/") || strings.Count(s.Method, "/") != 2 {
+			err = multierr.Append(err, errors.Errorf("method must be the full name of the method, such as /package.Service/Method, got %q", s.Method))
+		} else if s.DescriptorSet != "" {
+			if _, derr := s.methodDescriptor(); derr != nil {
+				err = multierr.Append(err, derr)
+			}
+		}
+	default:
+		err = multierr.Append(err, errors.Errorf("type must be %q or %q, got %q", TypeWebSocket, TypeGRPC, s.Type))
+	}
+	if strings.TrimSpace(s.Path) == "" {
+		err = multierr.Append(err, errors.New("path is required"))
+	}
+	if s.MaxStaleness.Duration() < 0 {
+		err = multierr.Append(err, errors.New("maxStaleness must not be negative"))
+	}
+	return err
+}
+
+// subscribeMessage returns the message sent once connected to a WebSocket
+// stream.
+func (s Source) subscribeMessage() []byte {
+	var str string
+	if err := json.Unmarshal(s.Subscribe, &str); err == nil {
+		return []byte(str)
+	}
+	return s.Subscribe
+}
+
+// extract returns the value at path in a JSON message. Numbers are returned
+// as decimals, so that no precision is lost.
+func extract(msg []byte, path string) (interface{}, bool) {
+	d := json.NewDecoder(bytes.NewReader(msg))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, false
+	}
+	for _, key := range strings.Split(path, ",") {
+		key = strings.TrimSpace(key)
+		switch typed := v.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if v, ok = typed[key]; !ok {
+				return nil, false
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(typed) {
+				return nil, false
+			}
+			v = typed[i]
+		default:
+			return nil, false
+		}
+	}
+	if n, ok := v.(json.Number); ok {
+		d, err := decimal.NewFromString(n.String())
+		if err != nil {
+			return nil, false
+		}
+		return d, true
+	}
+	return v, v != nil
+}
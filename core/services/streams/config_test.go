@@ -0,0 +1,68 @@
+package streams_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/streams"
+)
+
+type config struct {
+	maxStaleness time.Duration
+	sources      string
+}
+
+func (c config) StreamMaxStaleness() time.Duration { return c.maxStaleness }
+func (c config) StreamSources() string             { return c.sources }
+
+func TestParseSources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		sources, err := streams.ParseSources(config{maxStaleness: time.Minute})
+		require.NoError(t, err)
+		assert.Empty(t, sources)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		sources, err := streams.ParseSources(config{maxStaleness: time.Minute, sources: `{
+			"binance-ethusd": {"type": "websocket", "url": "wss://stream.binance.com:9443/ws", "subscribe": {"method": "SUBSCRIBE"}, "path": "p"},
+			"prices-ethusd": {"type": "grpc", "url": "prices.example.com:443", "method": "/prices.v1.Prices/Subscribe", "path": "price", "maxStaleness": "10s"}
+		}`})
+		require.NoError(t, err)
+		require.Len(t, sources, 2)
+
+		ws := sources["binance-ethusd"]
+		assert.Equal(t, streams.TypeWebSocket, ws.Type)
+		assert.Equal(t, "p", ws.Path)
+		assert.Equal(t, time.Minute, ws.MaxStaleness.Duration())
+
+		grpc := sources["prices-ethusd"]
+		assert.Equal(t, streams.TypeGRPC, grpc.Type)
+		assert.Equal(t, "/prices.v1.Prices/Subscribe", grpc.Method)
+		assert.Equal(t, 10*time.Second, grpc.MaxStaleness.Duration())
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := streams.ParseSources(config{sources: `{`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid STREAM_SOURCES")
+	})
+
+	t.Run("invalid sources", func(t *testing.T) {
+		_, err := streams.ParseSources(config{maxStaleness: time.Minute, sources: `{
+			"a": {"type": "websocket", "url": "https://example.com", "path": "p"},
+			"b": {"type": "grpc", "url": "example.com:443", "method": "Subscribe", "path": "p"},
+			"c": {"type": "mqtt", "url": "mqtt://example.com", "path": "p"},
+			"d": {"type": "websocket", "url": "wss://example.com"}
+		}`})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "stream source a: url must be ws:// or wss://")
+		assert.Contains(t, err.Error(), "stream source b: method must be the full name of the method")
+		assert.Contains(t, err.Error(), `stream source c: type must be "websocket" or "grpc"`)
+		assert.Contains(t, err.Error(), "stream source d: path is required")
+	})
+}
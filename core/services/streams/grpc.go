@@ -0,0 +1,172 @@
+package streams
+
+import (
+	"context"
+	"crypto/tls"
+	"io/ioutil"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// jsonCodec passes gRPC messages through as raw JSON, so that streams can be
+// subscribed to without their protobuf definitions if the server supports the
+// JSON codec.
+type jsonCodec struct{}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, errors.Errorf("jsonCodec: cannot marshal %T", v)
+	}
+	return b, nil
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return errors.Errorf("jsonCodec: cannot unmarshal into %T", v)
+	}
+	*b = append((*b)[:0], data...)
+	return nil
+}
+
+// protoCodec encodes the JSON messages of a stream as protobuf, using the
+// descriptors of its method, for servers which do not support the JSON codec.
+type protoCodec struct {
+	method protoreflect.MethodDescriptor
+}
+
+func (protoCodec) Name() string {
+	return "proto"
+}
+
+func (c protoCodec) Marshal(v interface{}) ([]byte, error) {
+	b, ok := v.([]byte)
+	if !ok {
+		return nil, errors.Errorf("protoCodec: cannot marshal %T", v)
+	}
+	msg := dynamicpb.NewMessage(c.method.Input())
+	if err := protojson.Unmarshal(b, msg); err != nil {
+		return nil, errors.Wrapf(err, "protoCodec: invalid %s", c.method.Input().FullName())
+	}
+	return proto.Marshal(msg)
+}
+
+func (c protoCodec) Unmarshal(data []byte, v interface{}) error {
+	b, ok := v.(*[]byte)
+	if !ok {
+		return errors.Errorf("protoCodec: cannot unmarshal into %T", v)
+	}
+	msg := dynamicpb.NewMessage(c.method.Output())
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return errors.Wrapf(err, "protoCodec: invalid %s", c.method.Output().FullName())
+	}
+	j, err := protojson.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "protoCodec")
+	}
+	*b = j
+	return nil
+}
+
+// methodDescriptor looks up the descriptor of the source's method in its
+// descriptor set.
+func (s Source) methodDescriptor() (protoreflect.MethodDescriptor, error) {
+	b, err := ioutil.ReadFile(s.DescriptorSet)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read descriptorSet")
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err = proto.Unmarshal(b, &set); err != nil {
+		return nil, errors.Wrap(err, "invalid descriptorSet")
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid descriptorSet")
+	}
+
+	i := strings.LastIndex(s.Method, "/")
+	service, method := strings.TrimPrefix(s.Method[:i], "/"), s.Method[i+1:]
+	d, err := files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, errors.Wrapf(err, "service %s not found in descriptorSet", service)
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, errors.Errorf("%s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, errors.Errorf("method %s not found in service %s", method, service)
+	}
+	if md.IsStreamingClient() || !md.IsStreamingServer() {
+		return nil, errors.Errorf("method %s must be server streaming", s.Method)
+	}
+	return md, nil
+}
+
+func subscribeGRPC(ctx context.Context, source Source, connected func(), onMessage func([]byte)) error {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if source.Insecure {
+		creds = insecure.NewCredentials()
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	conn, err := grpc.DialContext(dialCtx, source.URL, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	cancel()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect")
+	}
+	defer conn.Close()
+
+	if len(source.Headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(source.Headers))
+	}
+	var codec encoding.Codec = jsonCodec{}
+	if source.DescriptorSet != "" {
+		md, derr := source.methodDescriptor()
+		if derr != nil {
+			return derr
+		}
+		codec = protoCodec{md}
+	}
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, source.Method, grpc.ForceCodec(codec))
+	if err != nil {
+		return errors.Wrap(err, "failed to open stream")
+	}
+	request := []byte(source.Request)
+	if len(request) == 0 {
+		request = []byte("{}")
+	}
+	if err = stream.SendMsg(request); err != nil {
+		return errors.Wrap(err, "failed to subscribe")
+	}
+	if err = stream.CloseSend(); err != nil {
+		return errors.Wrap(err, "failed to subscribe")
+	}
+	connected()
+
+	for {
+		var msg []byte
+		if err = stream.RecvMsg(&msg); err != nil {
+			return errors.Wrap(err, "failed to read message")
+		}
+		onMessage(msg)
+	}
+}
@@ -0,0 +1,291 @@
+// Package streams holds persistent subscriptions to WebSocket and gRPC data
+// streams, caching the latest value received from each for stream pipeline
+// tasks.
+package streams
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promConnected = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stream_connected",
+		Help: "Whether the node is connected to the stream source (1) or not (0)",
+	}, []string{"source"})
+	promMessages = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_messages_total",
+		Help: "The number of values received from the stream source",
+	}, []string{"source"})
+	promReconnects = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "stream_reconnects_total",
+		Help: "The number of times the connection to the stream source was lost",
+	}, []string{"source"})
+	promLastMessage = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stream_last_message_timestamp_seconds",
+		Help: "When the latest value was received from the stream source",
+	}, []string{"source"})
+	promStaleness = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "stream_staleness_seconds",
+		Help: "How long ago the latest value was received from the stream source",
+	}, []string{"source"})
+)
+
+const (
+	// dialTimeout bounds how long connecting to a stream source may take
+	dialTimeout = 10 * time.Second
+	// pingWriteTimeout bounds how long sending a WebSocket ping may take
+	pingWriteTimeout = 5 * time.Second
+	// stalenessInterval is how often the staleness of each stream is reported
+	stalenessInterval = 5 * time.Second
+)
+
+// Manager subscribes to the stream sources configured by STREAM_SOURCES,
+// reconnecting as needed, and caches the latest value of each. It is
+// unhealthy while any source is disconnected or stale.
+type Manager interface {
+	services.Service
+	pipeline.StreamCache
+}
+
+// subscribeFunc connects to a stream source, calls connected once
+// subscribed, and then onMessage with each message until the stream fails or
+// ctx is done.
+type subscribeFunc func(ctx context.Context, source Source, connected func(), onMessage func([]byte)) error
+
+type manager struct {
+	utils.StartStopOnce
+	streams map[string]*stream
+	lggr    logger.Logger
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+var _ Manager = (*manager)(nil)
+
+// NewManager returns a Manager of the stream sources configured by
+// STREAM_SOURCES.
+func NewManager(cfg Config, lggr logger.Logger) (Manager, error) {
+	sources, err := ParseSources(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return newManager(sources, lggr), nil
+}
+
+func newManager(sources map[string]Source, lggr logger.Logger) *manager {
+	m := &manager{
+		streams: make(map[string]*stream, len(sources)),
+		lggr:    lggr.Named("StreamManager"),
+	}
+	for name, source := range sources {
+		s := &stream{name: name, source: source}
+		switch source.Type {
+		case TypeWebSocket:
+			s.subscribe = subscribeWebSocket
+		case TypeGRPC:
+			s.subscribe = subscribeGRPC
+		}
+		m.streams[name] = s
+	}
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	return m
+}
+
+// Start subscribes to each stream source in the background
+func (m *manager) Start() error {
+	return m.StartOnce("StreamManager", func() error {
+		for _, s := range m.streams {
+			promConnected.WithLabelValues(s.name).Set(0)
+			m.wg.Add(1)
+			go m.run(s)
+		}
+		if len(m.streams) > 0 {
+			m.wg.Add(1)
+			go m.reportStaleness()
+		}
+		return nil
+	})
+}
+
+// Close unsubscribes from all stream sources
+func (m *manager) Close() error {
+	return m.StopOnce("StreamManager", func() error {
+		m.cancel()
+		m.wg.Wait()
+		return nil
+	})
+}
+
+// Healthy returns an error if any stream source is disconnected, or has not
+// sent a value for longer than its max staleness.
+func (m *manager) Healthy() (err error) {
+	if err = m.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, name := range m.names() {
+		if serr := m.streams[name].health(now); serr != nil {
+			err = multierr.Append(err, errors.Wrapf(serr, "stream source %s", name))
+		}
+	}
+	return err
+}
+
+// Latest returns the latest value received from source, erroring if it is
+// older than maxAge, or than the max staleness of the source if maxAge is
+// zero.
+func (m *manager) Latest(source string, maxAge time.Duration) (interface{}, error) {
+	s, ok := m.streams[source]
+	if !ok {
+		return nil, errors.Errorf("unknown stream source %q", source)
+	}
+	if maxAge == 0 {
+		maxAge = s.source.MaxStaleness.Duration()
+	}
+	value, receivedAt := s.latest()
+	if receivedAt.IsZero() {
+		return nil, errors.Errorf("no value has been received from stream source %s yet", source)
+	}
+	if age := time.Since(receivedAt); age > maxAge {
+		return nil, errors.Errorf("latest value of stream source %s is stale: received %s ago, max age is %s", source, age.Round(time.Millisecond), maxAge)
+	}
+	return value, nil
+}
+
+func (m *manager) names() []string {
+	names := make([]string, 0, len(m.streams))
+	for name := range m.streams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// run keeps subscribed to the stream source until the manager is closed,
+// backing off between failed attempts.
+func (m *manager) run(s *stream) {
+	defer m.wg.Done()
+	lggr := m.lggr.With("source", s.name, "type", s.source.Type)
+	backoff := utils.NewBackoffSleeper()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-time.After(backoff.After()):
+		}
+
+		err := s.subscribe(m.ctx, s.source, func() {
+			lggr.Infow("Subscribed to stream")
+			s.setConnected(true)
+			backoff.Reset()
+		}, func(msg []byte) {
+			if !s.receive(msg, time.Now()) {
+				lggr.Debugw("Skipping stream message without a value", "path", s.source.Path)
+			}
+		})
+		wasConnected := s.setConnected(false)
+		if m.ctx.Err() != nil {
+			return
+		}
+		if wasConnected {
+			promReconnects.WithLabelValues(s.name).Inc()
+		}
+		lggr.Warnw("Stream failed, reconnecting", "err", err, "backoff", backoff.Duration())
+	}
+}
+
+func (m *manager) reportStaleness() {
+	defer m.wg.Done()
+	ticker := time.NewTicker(stalenessInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, s := range m.streams {
+				if _, receivedAt := s.latest(); !receivedAt.IsZero() {
+					promStaleness.WithLabelValues(s.name).Set(now.Sub(receivedAt).Seconds())
+				}
+			}
+		}
+	}
+}
+
+// stream caches the latest value received from a stream source
+type stream struct {
+	name      string
+	source    Source
+	subscribe subscribeFunc
+
+	mu         sync.RWMutex
+	connected  bool
+	value      interface{}
+	receivedAt time.Time
+}
+
+// setConnected records whether the stream is connected, and returns whether
+// it was before.
+func (s *stream) setConnected(connected bool) (was bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	was, s.connected = s.connected, connected
+	if connected {
+		promConnected.WithLabelValues(s.name).Set(1)
+	} else {
+		promConnected.WithLabelValues(s.name).Set(0)
+	}
+	return was
+}
+
+// receive caches the value in msg, returning false if it has none.
+func (s *stream) receive(msg []byte, now time.Time) bool {
+	value, ok := extract(msg, s.source.Path)
+	if !ok {
+		return false
+	}
+	s.mu.Lock()
+	s.value, s.receivedAt = value, now
+	s.mu.Unlock()
+
+	promMessages.WithLabelValues(s.name).Inc()
+	promLastMessage.WithLabelValues(s.name).Set(float64(now.UnixNano()) / float64(time.Second))
+	promStaleness.WithLabelValues(s.name).Set(0)
+	return true
+}
+
+func (s *stream) latest() (interface{}, time.Time) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.value, s.receivedAt
+}
+
+func (s *stream) health(now time.Time) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if !s.connected {
+		return errors.New("not connected")
+	}
+	if s.receivedAt.IsZero() {
+		return errors.New("no value received yet")
+	}
+	if staleness := now.Sub(s.receivedAt); staleness > s.source.MaxStaleness.Duration() {
+		return errors.Errorf("no value received for %s, max staleness is %s", staleness.Round(time.Millisecond), s.source.MaxStaleness.Duration())
+	}
+	return nil
+}
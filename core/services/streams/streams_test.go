@@ -0,0 +1,432 @@
+package streams
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func Test_extract(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		msg      string
+		path     string
+		expected interface{}
+		ok       bool
+	}{
+		{"number", `{"p": 3021.55}`, "p", decimal.RequireFromString("3021.55"), true},
+		{"large number", `{"p": 123456789012345678901234567890}`, "p", decimal.RequireFromString("123456789012345678901234567890"), true},
+		{"string", `{"p": "3021.55"}`, "p", "3021.55", true},
+		{"nested", `{"data": {"prices": [1, 2.5]}}`, "data, prices, 1", decimal.RequireFromString("2.5"), true},
+		{"object", `{"data": {"p": 1}}`, "data", map[string]interface{}{"p": json.Number("1")}, true},
+		{"missing key", `{"result": null, "id": 1}`, "p", nil, false},
+		{"null", `{"p": null}`, "p", nil, false},
+		{"index out of range", `{"p": [1]}`, "p,1", nil, false},
+		{"not an index", `{"p": [1]}`, "p,x", nil, false},
+		{"invalid JSON", `{"p": `, "p", nil, false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			value, ok := extract([]byte(test.msg), test.path)
+			require.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
+
+func newTestManager(t *testing.T, sources map[string]Source) *manager {
+	m := newManager(sources, logger.TestLogger(t))
+	require.NoError(t, m.Start())
+	t.Cleanup(func() { assert.NoError(t, m.Close()) })
+	return m
+}
+
+func TestManager_WebSocket(t *testing.T) {
+	t.Parallel()
+
+	chSubscribe := make(chan string, 1)
+	chSend := make(chan string)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"))
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer conn.Close()
+		_, msg, err := conn.ReadMessage()
+		if !assert.NoError(t, err) {
+			return
+		}
+		chSubscribe <- string(msg)
+		for msg := range chSend {
+			if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(chSend) })
+
+	m := newTestManager(t, map[string]Source{
+		"ethusd": {
+			Type:         TypeWebSocket,
+			URL:          "ws" + strings.TrimPrefix(server.URL, "http"),
+			Headers:      map[string]string{"X-Api-Key": "secret"},
+			Subscribe:    []byte(`{"method":"SUBSCRIBE","params":["ethusdt@trade"]}`),
+			Path:         "p",
+			MaxStaleness: models.MustMakeDuration(time.Minute),
+		},
+	})
+
+	select {
+	case msg := <-chSubscribe:
+		assert.JSONEq(t, `{"method":"SUBSCRIBE","params":["ethusdt@trade"]}`, msg)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for subscription")
+	}
+
+	_, err := m.Latest("ethusd", 0)
+	require.EqualError(t, err, "no value has been received from stream source ethusd yet")
+
+	chSend <- `{"result":null,"id":1}`
+	chSend <- `{"e":"trade","p":"3021.55"}`
+	require.Eventually(t, func() bool {
+		value, err := m.Latest("ethusd", 0)
+		return err == nil && value == "3021.55"
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, m.Healthy())
+
+	_, err = m.Latest("btcusd", 0)
+	require.EqualError(t, err, `unknown stream source "btcusd"`)
+}
+
+func Test_subscribeWebSocket_ReadDeadline(t *testing.T) {
+	t.Parallel()
+
+	// answerPings makes the server read, which answers pings with pongs
+	newServer := func(answerPings bool) string {
+		upgrader := websocket.Upgrader{}
+		chDone := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			conn, err := upgrader.Upgrade(w, r, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer conn.Close()
+			if answerPings {
+				for {
+					if _, _, err := conn.ReadMessage(); err != nil {
+						return
+					}
+				}
+			}
+			<-chDone
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(chDone) })
+		return "ws" + strings.TrimPrefix(server.URL, "http")
+	}
+	source := func(url string) Source {
+		return Source{Type: TypeWebSocket, URL: url, MaxStaleness: models.MustMakeDuration(200 * time.Millisecond)}
+	}
+
+	t.Run("drops a connection which does not answer pings", func(t *testing.T) {
+		chErr := make(chan error, 1)
+		go func() {
+			chErr <- subscribeWebSocket(context.Background(), source(newServer(false)), func() {}, func([]byte) {})
+		}()
+		select {
+		case err := <-chErr:
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "failed to read message")
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the connection to be dropped")
+		}
+	})
+
+	t.Run("keeps a quiet connection which answers pings", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		chErr := make(chan error, 1)
+		go func() {
+			chErr <- subscribeWebSocket(ctx, source(newServer(true)), func() {}, func([]byte) {})
+		}()
+		select {
+		case err := <-chErr:
+			t.Fatalf("connection dropped: %v", err)
+		case <-time.After(time.Second):
+		}
+		cancel()
+		select {
+		case <-chErr:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for the subscription to stop")
+		}
+	})
+}
+
+func TestManager_GRPC(t *testing.T) {
+	t.Parallel()
+
+	chRequest := make(chan string, 1)
+	server := grpc.NewServer(grpc.ForceServerCodec(jsonCodec{}), grpc.UnknownServiceHandler(func(srv interface{}, ss grpc.ServerStream) error {
+		method, _ := grpc.MethodFromServerStream(ss)
+		assert.Equal(t, "/prices.v1.Prices/Subscribe", method)
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		assert.Equal(t, []string{"secret"}, md.Get("x-api-key"))
+
+		var req []byte
+		if err := ss.RecvMsg(&req); err != nil {
+			return err
+		}
+		chRequest <- string(req)
+		for _, price := range []string{"3021.55", "3021.60"} {
+			if err := ss.SendMsg([]byte(`{"price":` + price + `}`)); err != nil {
+				return err
+			}
+		}
+		<-ss.Context().Done()
+		return nil
+	}))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	m := newTestManager(t, map[string]Source{
+		"ethusd": {
+			Type:         TypeGRPC,
+			URL:          lis.Addr().String(),
+			Headers:      map[string]string{"x-api-key": "secret"},
+			Method:       "/prices.v1.Prices/Subscribe",
+			Request:      []byte(`{"pair":"ETH/USD"}`),
+			Insecure:     true,
+			Path:         "price",
+			MaxStaleness: models.MustMakeDuration(time.Minute),
+		},
+	})
+
+	select {
+	case req := <-chRequest:
+		assert.JSONEq(t, `{"pair":"ETH/USD"}`, req)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for subscription")
+	}
+
+	require.Eventually(t, func() bool {
+		value, err := m.Latest("ethusd", 0)
+		return err == nil && decimal.RequireFromString("3021.60").Equal(value.(decimal.Decimal))
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+	require.NoError(t, m.Healthy())
+}
+
+// writeDescriptorSet writes the descriptor set of a prices.v1.Prices service
+// with a server streaming Subscribe method, and returns its path and method.
+func writeDescriptorSet(t *testing.T) (string, protoreflect.MethodDescriptor) {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("prices.proto"),
+		Package: proto.String("prices.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("SubscribeRequest"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("pair"), JsonName: proto.String("pair"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+				},
+			},
+			{
+				Name: proto.String("Price"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("price"), JsonName: proto.String("price"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+				},
+			},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Prices"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Subscribe"), InputType: proto.String(".prices.v1.SubscribeRequest"), OutputType: proto.String(".prices.v1.Price"), ServerStreaming: proto.Bool(true)},
+				},
+			},
+		},
+	}
+	b, err := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{file}})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "prices.pb")
+	require.NoError(t, ioutil.WriteFile(path, b, 0600))
+
+	fd, err := protodesc.NewFile(file, nil)
+	require.NoError(t, err)
+	return path, fd.Services().ByName("Prices").Methods().ByName("Subscribe")
+}
+
+func TestManager_GRPC_DescriptorSet(t *testing.T) {
+	t.Parallel()
+
+	path, method := writeDescriptorSet(t)
+
+	// The server speaks protobuf, so it does not support the JSON codec
+	chRequest := make(chan string, 1)
+	server := grpc.NewServer(grpc.ForceServerCodec(jsonCodec{}), grpc.UnknownServiceHandler(func(srv interface{}, ss grpc.ServerStream) error {
+		var raw []byte
+		if err := ss.RecvMsg(&raw); err != nil {
+			return err
+		}
+		req := dynamicpb.NewMessage(method.Input())
+		if err := proto.Unmarshal(raw, req); err != nil {
+			return err
+		}
+		chRequest <- req.Get(method.Input().Fields().ByName("pair")).String()
+
+		price := dynamicpb.NewMessage(method.Output())
+		price.Set(method.Output().Fields().ByName("price"), protoreflect.ValueOfFloat64(3021.6))
+		b, err := proto.Marshal(price)
+		if err != nil {
+			return err
+		}
+		if err = ss.SendMsg(b); err != nil {
+			return err
+		}
+		<-ss.Context().Done()
+		return nil
+	}))
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	m := newTestManager(t, map[string]Source{
+		"ethusd": {
+			Type:          TypeGRPC,
+			URL:           lis.Addr().String(),
+			Method:        "/prices.v1.Prices/Subscribe",
+			DescriptorSet: path,
+			Request:       []byte(`{"pair":"ETH/USD"}`),
+			Insecure:      true,
+			Path:          "price",
+			MaxStaleness:  models.MustMakeDuration(time.Minute),
+		},
+	})
+
+	select {
+	case pair := <-chRequest:
+		assert.Equal(t, "ETH/USD", pair)
+	case <-time.After(testutils.WaitTimeout(t)):
+		t.Fatal("timed out waiting for subscription")
+	}
+
+	require.Eventually(t, func() bool {
+		value, err := m.Latest("ethusd", 0)
+		return err == nil && decimal.RequireFromString("3021.6").Equal(value.(decimal.Decimal))
+	}, testutils.WaitTimeout(t), 10*time.Millisecond)
+}
+
+func TestSource_methodDescriptor(t *testing.T) {
+	t.Parallel()
+
+	path, _ := writeDescriptorSet(t)
+
+	md, err := Source{Method: "/prices.v1.Prices/Subscribe", DescriptorSet: path}.methodDescriptor()
+	require.NoError(t, err)
+	assert.Equal(t, "prices.v1.Price", string(md.Output().FullName()))
+
+	_, err = Source{Method: "/prices.v1.Prices/Unknown", DescriptorSet: path}.methodDescriptor()
+	require.EqualError(t, err, "method Unknown not found in service prices.v1.Prices")
+
+	_, err = Source{Method: "/prices.v1.Quotes/Subscribe", DescriptorSet: path}.methodDescriptor()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "service prices.v1.Quotes not found in descriptorSet")
+
+	_, err = Source{Method: "/prices.v1.Prices/Subscribe", DescriptorSet: filepath.Join(t.TempDir(), "missing.pb")}.methodDescriptor()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read descriptorSet")
+}
+
+func TestManager_Reconnect(t *testing.T) {
+	t.Parallel()
+
+	chSubscribed := make(chan struct{}, 2)
+	var attempts int
+	m := newManager(map[string]Source{
+		"ethusd": {Type: TypeWebSocket, Path: "p", MaxStaleness: models.MustMakeDuration(time.Minute)},
+	}, logger.TestLogger(t))
+	m.streams["ethusd"].subscribe = func(ctx context.Context, source Source, connected func(), onMessage func([]byte)) error {
+		attempts++
+		connected()
+		chSubscribed <- struct{}{}
+		if attempts == 1 {
+			onMessage([]byte(`{"p":1}`))
+			return context.DeadlineExceeded
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	require.NoError(t, m.Start())
+	t.Cleanup(func() { assert.NoError(t, m.Close()) })
+
+	// Reconnects immediately after a successful subscription fails
+	for i := 0; i < 2; i++ {
+		select {
+		case <-chSubscribed:
+		case <-time.After(testutils.WaitTimeout(t)):
+			t.Fatal("timed out waiting for subscription")
+		}
+	}
+	value, err := m.Latest("ethusd", 0)
+	require.NoError(t, err)
+	assert.Equal(t, decimal.NewFromInt(1), value)
+}
+
+func TestManager_Stale(t *testing.T) {
+	t.Parallel()
+
+	m := newManager(map[string]Source{
+		"ethusd": {Type: TypeWebSocket, Path: "p", MaxStaleness: models.MustMakeDuration(time.Minute)},
+	}, logger.TestLogger(t))
+	s := m.streams["ethusd"]
+	s.setConnected(true)
+	require.True(t, s.receive([]byte(`{"p":1}`), time.Now().Add(-2*time.Minute)))
+
+	_, err := m.Latest("ethusd", 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "latest value of stream source ethusd is stale")
+
+	value, err := m.Latest("ethusd", 5*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, decimal.NewFromInt(1), value)
+
+	err = s.health(time.Now())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no value received for 2m0s, max staleness is 1m0s")
+
+	s.setConnected(false)
+	require.EqualError(t, s.health(time.Now()), "not connected")
+}
@@ -0,0 +1,89 @@
+package streams
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+func subscribeWebSocket(ctx context.Context, source Source, connected func(), onMessage func([]byte)) error {
+	header := make(http.Header, len(source.Headers))
+	for k, v := range source.Headers {
+		header.Set(k, v)
+	}
+
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	conn, _, err := websocket.DefaultDialer.DialContext(dialCtx, source.URL, header)
+	cancel()
+	if err != nil {
+		return errors.Wrap(err, "failed to connect")
+	}
+	defer conn.Close()
+
+	// Closing the connection unblocks ReadMessage once ctx is done
+	chDone := make(chan struct{})
+	defer close(chDone)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-chDone:
+		}
+	}()
+
+	// A connection which has not received a message or pong within the max
+	// staleness is dead, so reading from it errors and it is reconnected.
+	readTimeout := source.MaxStaleness.Duration()
+	extendReadDeadline := func() error {
+		if readTimeout <= 0 {
+			return nil
+		}
+		return conn.SetReadDeadline(time.Now().Add(readTimeout))
+	}
+	if err = extendReadDeadline(); err != nil {
+		return errors.Wrap(err, "failed to set read deadline")
+	}
+	conn.SetPongHandler(func(string) error {
+		return extendReadDeadline()
+	})
+	if readTimeout > 0 {
+		go pingWebSocket(conn, readTimeout/2, chDone)
+	}
+
+	if len(source.Subscribe) > 0 {
+		if err = conn.WriteMessage(websocket.TextMessage, source.subscribeMessage()); err != nil {
+			return errors.Wrap(err, "failed to subscribe")
+		}
+	}
+	connected()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return errors.Wrap(err, "failed to read message")
+		}
+		if err = extendReadDeadline(); err != nil {
+			return errors.Wrap(err, "failed to set read deadline")
+		}
+		onMessage(msg)
+	}
+}
+
+// pingWebSocket pings conn every interval until chDone is closed, so that
+// quiet streams stay alive as long as the source answers with pongs.
+func pingWebSocket(conn *websocket.Conn, interval time.Duration, chDone <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			// Failures surface as read errors once the read deadline passes
+			_ = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(pingWriteTimeout))
+		case <-chDone:
+			return
+		}
+	}
+}
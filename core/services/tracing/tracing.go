@@ -0,0 +1,169 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/static"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+const (
+	instrumentationPrefix = "github.com/smartcontractkit/chainlink/"
+	// shutdownTimeout bounds how long Close waits for pending spans to be exported
+	shutdownTimeout = 5 * time.Second
+)
+
+func init() {
+	// The propagator is installed unconditionally so that trace context
+	// received from upstream callers is forwarded even when this node does
+	// not export spans itself.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Config is the subset of the node configuration used to set up tracing.
+type Config interface {
+	TracingEnabled() bool
+	TracingCollectorTarget() string
+	TracingFilePath() string
+}
+
+// Provider owns the global OpenTelemetry TracerProvider for the lifetime of
+// the application. Spans are exported to an OTLP collector over gRPC, to a
+// local file as newline-delimited JSON, or both.
+type Provider struct {
+	cfg      Config
+	lggr     logger.Logger
+	provider *sdktrace.TracerProvider
+	file     *os.File
+
+	utils.StartStopOnce
+}
+
+// NewProvider returns a Provider which installs itself as the global
+// TracerProvider on Start.
+func NewProvider(cfg Config, lggr logger.Logger) *Provider {
+	return &Provider{
+		cfg:  cfg,
+		lggr: lggr.Named("Tracing"),
+	}
+}
+
+func (p *Provider) Start() error {
+	return p.StartOnce("Tracing", func() error {
+		if !p.cfg.TracingEnabled() {
+			p.lggr.Debug("Tracing disabled")
+			return nil
+		}
+
+		var opts []sdktrace.TracerProviderOption
+		if target := p.cfg.TracingCollectorTarget(); target != "" {
+			exporter, err := otlptracegrpc.New(context.Background(),
+				otlptracegrpc.WithEndpoint(target),
+				otlptracegrpc.WithInsecure(),
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to create OTLP trace exporter")
+			}
+			opts = append(opts, sdktrace.WithBatcher(exporter))
+		}
+		if path := p.cfg.TracingFilePath(); path != "" {
+			f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+			if err != nil {
+				return errors.Wrapf(err, "failed to open trace file %s", path)
+			}
+			exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+			if err != nil {
+				return multierr.Combine(errors.Wrap(err, "failed to create file trace exporter"), f.Close())
+			}
+			p.file = f
+			opts = append(opts, sdktrace.WithBatcher(exporter))
+		}
+		if len(opts) == 0 {
+			p.lggr.Warn("Tracing is enabled but neither TRACING_COLLECTOR_TARGET nor TRACING_FILE_PATH is set, no spans will be exported")
+		}
+
+		opts = append(opts, sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", "chainlink"),
+			attribute.String("service.version", static.Version),
+			attribute.String("service.commit", static.Sha),
+		)))
+		p.provider = sdktrace.NewTracerProvider(opts...)
+		otel.SetTracerProvider(p.provider)
+
+		p.lggr.Infow("Tracing enabled", "collectorTarget", p.cfg.TracingCollectorTarget(), "filePath", p.cfg.TracingFilePath())
+		return nil
+	})
+}
+
+// Close flushes any buffered spans and shuts down the exporters.
+func (p *Provider) Close() error {
+	return p.StopOnce("Tracing", func() (err error) {
+		if p.provider != nil {
+			ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+			defer cancel()
+			err = p.provider.Shutdown(ctx)
+		}
+		if p.file != nil {
+			err = multierr.Combine(err, p.file.Close())
+		}
+		return err
+	})
+}
+
+// Tracer returns a named tracer from the global TracerProvider. It is safe to
+// call before the Provider is started; spans are delegated once it is.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(instrumentationPrefix + name)
+}
+
+// RecordError marks the span as failed if err is non-nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// InjectHTTPHeaders writes the trace context carried by ctx into header, so
+// that the receiving service can continue the trace.
+func InjectHTTPHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// Carrier serializes the trace context carried by ctx so that it can be
+// persisted and later restored with ContextFromCarrier. Returns nil if ctx
+// does not carry a span.
+func Carrier(ctx context.Context) map[string]string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	return carrier
+}
+
+// ContextFromCarrier restores a trace context serialized with Carrier on top
+// of ctx.
+func ContextFromCarrier(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
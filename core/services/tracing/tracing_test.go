@@ -0,0 +1,84 @@
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/tracing"
+)
+
+type testConfig struct {
+	enabled  bool
+	target   string
+	filePath string
+}
+
+func (c testConfig) TracingEnabled() bool           { return c.enabled }
+func (c testConfig) TracingCollectorTarget() string { return c.target }
+func (c testConfig) TracingFilePath() string        { return c.filePath }
+
+func TestProvider_FileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.json")
+	p := tracing.NewProvider(testConfig{enabled: true, filePath: path}, logger.TestLogger(t))
+	require.NoError(t, p.Start())
+
+	_, span := tracing.Tracer("test").Start(context.Background(), "test-span")
+	span.End()
+
+	require.NoError(t, p.Close())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), "test-span")
+}
+
+func TestProvider_Disabled(t *testing.T) {
+	p := tracing.NewProvider(testConfig{}, logger.TestLogger(t))
+	require.NoError(t, p.Start())
+	require.NoError(t, p.Ready())
+	require.NoError(t, p.Close())
+}
+
+func TestCarrier(t *testing.T) {
+	t.Run("without a span", func(t *testing.T) {
+		assert.Nil(t, tracing.Carrier(context.Background()))
+		assert.Equal(t, context.Background(), tracing.ContextFromCarrier(context.Background(), nil))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		sc := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{1, 2, 3},
+			SpanID:     trace.SpanID{4, 5, 6},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), sc)
+
+		carrier := tracing.Carrier(ctx)
+		require.NotEmpty(t, carrier)
+		assert.Contains(t, carrier, "traceparent")
+
+		restored := trace.SpanContextFromContext(tracing.ContextFromCarrier(context.Background(), carrier))
+		assert.Equal(t, sc.TraceID(), restored.TraceID())
+		assert.Equal(t, sc.SpanID(), restored.SpanID())
+		assert.True(t, restored.IsRemote())
+	})
+}
+
+func TestInjectHTTPHeaders(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	header := make(http.Header)
+	tracing.InjectHTTPHeaders(trace.ContextWithSpanContext(context.Background(), sc), header)
+	assert.Equal(t, "00-01000000000000000000000000000000-0200000000000000-01", header.Get("traceparent"))
+}
@@ -0,0 +1,162 @@
+package vrf
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/batch_vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+const (
+	// DefaultBatchFulfillmentGasBudget is the gas budget of batch fulfillments
+	// if the job spec does not set one.
+	DefaultBatchFulfillmentGasBudget uint64 = 2_500_000
+
+	// txBaseGas is the base cost of a transaction, which a batch pays once
+	// instead of once per fulfillment.
+	txBaseGas = 21_000
+
+	// batchOverheadPerProof is the gas the batch coordinator spends per proof on
+	// top of the fulfillment itself, i.e. for decoding it and calling the
+	// coordinator.
+	batchOverheadPerProof = 10_000
+
+	// simulationTimeout bounds the eth_calls simulating fulfillments.
+	simulationTimeout = 10 * time.Second
+)
+
+var (
+	batchCoordinatorABI = evmtypes.MustGetABI(batch_vrf_coordinator_v2.BatchVRFCoordinatorV2ABI)
+	coordinatorV2ABI    = evmtypes.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI)
+
+	promFulfillmentGasPerRequest = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "vrf_v2_fulfillment_gas_per_request",
+		Help:    "The gas limit of VRF v2 fulfillment transactions divided by the number of requests they fulfill",
+		Buckets: []float64{50_000, 100_000, 150_000, 200_000, 300_000, 500_000, 750_000, 1_000_000, 2_500_000},
+	}, []string{"job_id", "batch"})
+	promFulfillmentsEnqueued = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_v2_fulfillments_enqueued",
+		Help: "The number of VRF v2 requests enqueued for fulfillment, by whether they were batched",
+	}, []string{"job_id", "batch"})
+	promBatchProofsRemoved = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_v2_batch_proofs_removed",
+		Help: "The number of proofs removed from VRF v2 batch fulfillments because their fulfillment reverted",
+	}, []string{"job_id"})
+)
+
+// fulfillment is a request whose fulfillment has been computed but not
+// enqueued yet.
+type fulfillment struct {
+	req      pendingRequest
+	maxLink  *big.Int
+	run      pipeline.Run
+	payload  []byte
+	gasLimit uint64
+}
+
+// batchFulfillment accumulates fulfillments of a single subscription, up to
+// a gas budget.
+type batchFulfillment struct {
+	fulfillments []fulfillment
+	gasBudget    uint64
+}
+
+// batchGasLimit returns the gas limit of a batch of fulfillments whose
+// individual gas limits are given. Each individual gas limit includes the
+// base transaction cost, which the batch only pays once.
+func batchGasLimit(gasLimits ...uint64) uint64 {
+	total := uint64(txBaseGas)
+	for _, gl := range gasLimits {
+		if gl > txBaseGas {
+			gl -= txBaseGas
+		}
+		total += gl + batchOverheadPerProof
+	}
+	return total
+}
+
+func (b *batchFulfillment) gasLimit() uint64 {
+	var gasLimits []uint64
+	for _, f := range b.fulfillments {
+		gasLimits = append(gasLimits, f.gasLimit)
+	}
+	return batchGasLimit(gasLimits...)
+}
+
+// canAdd returns whether f fits into the gas budget of the batch.
+func (b *batchFulfillment) canAdd(f fulfillment) bool {
+	return b.gasLimit()+f.gasLimit-txBaseGas+batchOverheadPerProof <= b.gasBudget
+}
+
+func (b *batchFulfillment) add(f fulfillment) {
+	b.fulfillments = append(b.fulfillments, f)
+}
+
+func (b *batchFulfillment) reset() {
+	b.fulfillments = nil
+}
+
+func (b *batchFulfillment) maxLink() *big.Int {
+	total := big.NewInt(0)
+	for _, f := range b.fulfillments {
+		total.Add(total, f.maxLink)
+	}
+	return total
+}
+
+func (b *batchFulfillment) requestIDs() []common.Hash {
+	var ids []common.Hash
+	for _, f := range b.fulfillments {
+		ids = append(ids, common.BytesToHash(f.req.req.RequestId.Bytes()))
+	}
+	return ids
+}
+
+// payload encodes the batch as a call to the batch coordinator, from the
+// payloads of the individual fulfillments to the coordinator.
+func (b *batchFulfillment) payload() ([]byte, error) {
+	method := coordinatorV2ABI.Methods["fulfillRandomWords"]
+	var (
+		proofs []vrf_coordinator_v2.VRFProof
+		rcs    []vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+	)
+	for _, f := range b.fulfillments {
+		if len(f.payload) < 4 {
+			return nil, errors.Errorf("invalid fulfillment payload 0x%x", f.payload)
+		}
+		args, err := method.Inputs.Unpack(f.payload[4:])
+		if err != nil {
+			return nil, errors.Wrap(err, "unpacking fulfillment payload")
+		}
+		proof := *abi.ConvertType(args[0], new(vrf_coordinator_v2.VRFProof)).(*vrf_coordinator_v2.VRFProof)
+		rc := *abi.ConvertType(args[1], new(vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)).(*vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)
+		proofs = append(proofs, proof)
+		rcs = append(rcs, rc)
+	}
+	return batchCoordinatorABI.Pack("fulfillRandomWords", proofs, rcs)
+}
+
+// simulate returns an error if calling to with payload from fromAddress
+// reverts.
+func (lsn *listenerV2) simulate(fromAddress, to common.Address, payload []byte, gasLimit uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), simulationTimeout)
+	defer cancel()
+	_, err := lsn.ethClient.CallContract(ctx, ethereum.CallMsg{
+		From: fromAddress,
+		To:   &to,
+		Gas:  gasLimit,
+		Data: payload,
+	}, nil)
+	return err
+}
@@ -0,0 +1,93 @@
+package vrf
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+)
+
+func TestBatchFulfillment_GasBudget(t *testing.T) {
+	batch := batchFulfillment{gasBudget: 500_000}
+	f := fulfillment{gasLimit: 200_000}
+
+	require.True(t, batch.canAdd(f))
+	batch.add(f)
+	assert.Equal(t, uint64(210_000), batch.gasLimit())
+
+	// The second fulfillment does not pay the base transaction cost again
+	require.True(t, batch.canAdd(f))
+	batch.add(f)
+	assert.Equal(t, uint64(21_000+2*(179_000+10_000)), batch.gasLimit())
+	assert.Less(t, batch.gasLimit(), 2*f.gasLimit)
+
+	require.False(t, batch.canAdd(f))
+
+	batch.reset()
+	assert.Empty(t, batch.fulfillments)
+}
+
+func TestBatchFulfillment_Payload(t *testing.T) {
+	newFulfillment := func(requestID, seed int64) fulfillment {
+		two := [2]*big.Int{big.NewInt(1), big.NewInt(2)}
+		payload, err := coordinatorV2ABI.Pack("fulfillRandomWords",
+			vrf_coordinator_v2.VRFProof{
+				Pk:            two,
+				Gamma:         two,
+				C:             big.NewInt(3),
+				S:             big.NewInt(4),
+				Seed:          big.NewInt(seed),
+				UWitness:      common.HexToAddress("0x01"),
+				CGammaWitness: two,
+				SHashWitness:  two,
+				ZInv:          big.NewInt(5),
+			},
+			vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment{
+				BlockNum:         10,
+				SubId:            1,
+				CallbackGasLimit: 100_000,
+				NumWords:         1,
+				Sender:           common.HexToAddress("0x02"),
+			})
+		require.NoError(t, err)
+		return fulfillment{
+			req: pendingRequest{req: &vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested{
+				RequestId: big.NewInt(requestID),
+				SubId:     1,
+			}},
+			maxLink: big.NewInt(requestID * 100),
+			payload: payload,
+		}
+	}
+
+	batch := batchFulfillment{}
+	batch.add(newFulfillment(1, 11))
+	batch.add(newFulfillment(2, 22))
+
+	payload, err := batch.payload()
+	require.NoError(t, err)
+
+	method := batchCoordinatorABI.Methods["fulfillRandomWords"]
+	assert.Equal(t, method.ID, payload[:4])
+	args, err := method.Inputs.Unpack(payload[4:])
+	require.NoError(t, err)
+	proofs := *abi.ConvertType(args[0], new([]vrf_coordinator_v2.VRFProof)).(*[]vrf_coordinator_v2.VRFProof)
+	rcs := *abi.ConvertType(args[1], new([]vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)).(*[]vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)
+	require.Len(t, proofs, 2)
+	require.Len(t, rcs, 2)
+	assert.Equal(t, big.NewInt(11), proofs[0].Seed)
+	assert.Equal(t, big.NewInt(22), proofs[1].Seed)
+	assert.Equal(t, common.HexToAddress("0x02"), rcs[1].Sender)
+
+	assert.Equal(t, big.NewInt(300), batch.maxLink())
+	assert.Equal(t, []common.Hash{common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))}, batch.requestIDs())
+
+	batch.add(fulfillment{payload: []byte{0x01}})
+	_, err = batch.payload()
+	require.Error(t, err)
+}
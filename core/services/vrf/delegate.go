@@ -74,6 +74,9 @@ func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
 	if jb.VRFSpec == nil || jb.PipelineSpec == nil {
 		return nil, errors.Errorf("vrf.Delegate expects a VRFSpec and PipelineSpec to be present, got %+v", jb)
 	}
+	jb.PipelineSpec.JobName = jb.Name.ValueOrZero()
+	jb.PipelineSpec.ExternalJobID = jb.ExternalJobID
+	jb.PipelineSpec.JobID = jb.ID
 	pl, err := jb.PipelineSpec.Pipeline()
 	if err != nil {
 		return nil, err
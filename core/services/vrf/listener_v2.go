@@ -303,6 +303,16 @@ func (lsn *listenerV2) processRequestsPerSub(
 
 	// Attempt to process every request, break if we run out of balance
 	var processed = make(map[string]struct{})
+	// If we successfully enqueued for the bptxm, subtract that balance
+	// And loop to attempt to enqueue another fulfillment
+	enqueued := func(f fulfillment) {
+		startBalanceNoReserveLink = startBalanceNoReserveLink.Sub(startBalanceNoReserveLink, f.maxLink)
+		processed[f.req.req.RequestId.String()] = struct{}{}
+	}
+	var batch *batchFulfillment
+	if lsn.job.VRFSpec.BatchFulfillmentEnabled && lsn.job.VRFSpec.BatchCoordinatorAddress != nil {
+		batch = &batchFulfillment{gasBudget: lsn.job.VRFSpec.BatchFulfillmentGasBudget}
+	}
 	for _, req := range reqs {
 		vrfRequest := req.req
 		rlog := lggr.With(
@@ -350,48 +360,43 @@ func (lsn *listenerV2) processRequestsPerSub(
 			rlog.Warnw("Unable to get max link for fulfillment, skipping request", "err", err)
 			continue
 		}
-		if startBalance.Cmp(maxLink) < 0 {
+		// The fulfillments waiting in the batch are only subtracted from the
+		// balance once enqueued, so they have to be accounted for here.
+		required := maxLink
+		if batch != nil {
+			required = new(big.Int).Add(maxLink, batch.maxLink())
+		}
+		if startBalanceNoReserveLink.Cmp(required) < 0 {
 			// Insufficient funds, have to wait for a user top up
 			// leave it unprocessed for now
-			rlog.Infow("Insufficient link balance to fulfill a request, breaking", "maxLink", maxLink)
+			rlog.Infow("Insufficient link balance to fulfill a request, breaking", "maxLink", maxLink, "requiredLink", required)
 			break
 		}
+		f := fulfillment{
+			req:      req,
+			maxLink:  maxLink,
+			run:      run,
+			payload:  hexutil.MustDecode(payload),
+			gasLimit: gaslimit,
+		}
+		if batch != nil {
+			if !batch.canAdd(f) {
+				lsn.enqueueBatch(fromAddress, batch, lggr, enqueued)
+			}
+			rlog.Infow("Adding fulfillment to batch", "batchSize", len(batch.fulfillments)+1)
+			batch.add(f)
+			continue
+		}
 		rlog.Infow("Enqueuing fulfillment")
 		// We have enough balance to service it, lets enqueue for bptxm
-		err = lsn.q.Transaction(func(tx pg.Queryer) error {
-			if err = lsn.pipelineRunner.InsertFinishedRun(&run, true, pg.WithQueryer(tx)); err != nil {
-				return err
-			}
-			if err = lsn.logBroadcaster.MarkConsumed(req.lb, pg.WithQueryer(tx)); err != nil {
-				return err
-			}
-			_, err = lsn.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
-				FromAddress:    fromAddress,
-				ToAddress:      lsn.coordinator.Address(),
-				EncodedPayload: hexutil.MustDecode(payload),
-				GasLimit:       gaslimit,
-				Meta: &bulletprooftxmanager.EthTxMeta{
-					RequestID: common.BytesToHash(vrfRequest.RequestId.Bytes()),
-					MaxLink:   maxLink.String(),
-					SubID:     vrfRequest.SubId,
-				},
-				MinConfirmations: null.Uint32From(uint32(lsn.cfg.MinRequiredOutgoingConfirmations())),
-				Strategy:         bulletprooftxmanager.NewSendEveryStrategy(),
-				Checker: bulletprooftxmanager.TransmitCheckerSpec{
-					CheckerType:           bulletprooftxmanager.TransmitCheckerTypeVRFV2,
-					VRFCoordinatorAddress: lsn.coordinator.Address(),
-				},
-			}, pg.WithQueryer(tx))
-			return err
-		})
-		if err != nil {
+		if err = lsn.enqueueFulfillment(fromAddress, f); err != nil {
 			rlog.Errorw("Error enqueuing fulfillment, requeuing request", "err", err)
 			continue
 		}
-		// If we successfully enqueued for the bptxm, subtract that balance
-		// And loop to attempt to enqueue another fulfillment
-		startBalanceNoReserveLink = startBalanceNoReserveLink.Sub(startBalanceNoReserveLink, maxLink)
-		processed[vrfRequest.RequestId.String()] = struct{}{}
+		enqueued(f)
+	}
+	if batch != nil {
+		lsn.enqueueBatch(fromAddress, batch, lggr, enqueued)
 	}
 	// Remove all the confirmed logs
 	var toKeep []pendingRequest
@@ -413,6 +418,135 @@ func (lsn *listenerV2) processRequestsPerSub(
 	)
 }
 
+// enqueueFulfillment enqueues a transaction fulfilling a single request
+// through the coordinator.
+func (lsn *listenerV2) enqueueFulfillment(fromAddress common.Address, f fulfillment) error {
+	vrfRequest := f.req.req
+	err := lsn.q.Transaction(func(tx pg.Queryer) error {
+		if err := lsn.pipelineRunner.InsertFinishedRun(&f.run, true, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+		if err := lsn.logBroadcaster.MarkConsumed(f.req.lb, pg.WithQueryer(tx)); err != nil {
+			return err
+		}
+		_, err := lsn.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+			FromAddress:    fromAddress,
+			ToAddress:      lsn.coordinator.Address(),
+			EncodedPayload: f.payload,
+			GasLimit:       f.gasLimit,
+			Meta: &bulletprooftxmanager.EthTxMeta{
+				RequestID: common.BytesToHash(vrfRequest.RequestId.Bytes()),
+				MaxLink:   f.maxLink.String(),
+				SubID:     vrfRequest.SubId,
+			},
+			MinConfirmations: null.Uint32From(uint32(lsn.cfg.MinRequiredOutgoingConfirmations())),
+			Strategy:         bulletprooftxmanager.NewSendEveryStrategy(),
+			Checker: bulletprooftxmanager.TransmitCheckerSpec{
+				CheckerType:           bulletprooftxmanager.TransmitCheckerTypeVRFV2,
+				VRFCoordinatorAddress: lsn.coordinator.Address(),
+			},
+		}, pg.WithQueryer(tx))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	jobID := fmt.Sprintf("%d", lsn.job.ID)
+	promFulfillmentsEnqueued.WithLabelValues(jobID, "false").Inc()
+	promFulfillmentGasPerRequest.WithLabelValues(jobID, "false").Observe(float64(f.gasLimit))
+	return nil
+}
+
+// enqueueBatch enqueues a single transaction fulfilling all the requests of
+// batch through the batch coordinator, and resets the batch. The batch is
+// simulated first. If it reverts, every proof is simulated on its own, and
+// those which revert are removed from the batch and left for a later
+// attempt, before the remaining ones are retried. Should the batch keep
+// reverting without any single proof doing so, its requests are fulfilled
+// individually.
+func (lsn *listenerV2) enqueueBatch(fromAddress common.Address, batch *batchFulfillment, lggr logger.Logger, enqueued func(fulfillment)) {
+	defer batch.reset()
+	jobID := fmt.Sprintf("%d", lsn.job.ID)
+	batchCoordinator := lsn.job.VRFSpec.BatchCoordinatorAddress.Address()
+	for len(batch.fulfillments) > 1 {
+		payload, err := batch.payload()
+		if err != nil {
+			lggr.Errorw("Unable to encode batch fulfillment, fulfilling requests individually", "err", err)
+			break
+		}
+		gasLimit := batch.gasLimit()
+		blog := lggr.With("batchSize", len(batch.fulfillments), "gasLimit", gasLimit)
+		if err = lsn.simulate(fromAddress, batchCoordinator, payload, gasLimit); err != nil {
+			blog.Warnw("Batch fulfillment reverted in simulation, removing offending proofs", "err", err)
+			var remaining []fulfillment
+			for _, f := range batch.fulfillments {
+				if err = lsn.simulate(fromAddress, lsn.coordinator.Address(), f.payload, f.gasLimit); err != nil {
+					blog.Warnw("Removing reverting proof from batch, requeuing request", "reqID", f.req.req.RequestId.String(), "err", err)
+					promBatchProofsRemoved.WithLabelValues(jobID).Inc()
+					continue
+				}
+				remaining = append(remaining, f)
+			}
+			if len(remaining) == len(batch.fulfillments) {
+				blog.Warnw("No proof of the batch reverts on its own, fulfilling requests individually")
+				break
+			}
+			batch.fulfillments = remaining
+			continue
+		}
+
+		blog.Infow("Enqueuing batch fulfillment")
+		err = lsn.q.Transaction(func(tx pg.Queryer) error {
+			for i := range batch.fulfillments {
+				f := batch.fulfillments[i]
+				if err = lsn.pipelineRunner.InsertFinishedRun(&f.run, true, pg.WithQueryer(tx)); err != nil {
+					return err
+				}
+				if err = lsn.logBroadcaster.MarkConsumed(f.req.lb, pg.WithQueryer(tx)); err != nil {
+					return err
+				}
+			}
+			_, err = lsn.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+				FromAddress:    fromAddress,
+				ToAddress:      batchCoordinator,
+				EncodedPayload: payload,
+				GasLimit:       gasLimit,
+				Meta: &bulletprooftxmanager.EthTxMeta{
+					RequestIDs: batch.requestIDs(),
+					MaxLink:    batch.maxLink().String(),
+					SubID:      batch.fulfillments[0].req.req.SubId,
+				},
+				MinConfirmations: null.Uint32From(uint32(lsn.cfg.MinRequiredOutgoingConfirmations())),
+				Strategy:         bulletprooftxmanager.NewSendEveryStrategy(),
+				// The batch reverts if any of its requests has been fulfilled
+				// in the meantime, so simulate it before every broadcast.
+				Checker: bulletprooftxmanager.TransmitCheckerSpec{
+					CheckerType: bulletprooftxmanager.TransmitCheckerTypeSimulate,
+				},
+			}, pg.WithQueryer(tx))
+			return err
+		})
+		if err != nil {
+			blog.Errorw("Error enqueuing batch fulfillment, requeuing requests", "err", err)
+			return
+		}
+		for _, f := range batch.fulfillments {
+			enqueued(f)
+		}
+		promFulfillmentsEnqueued.WithLabelValues(jobID, "true").Add(float64(len(batch.fulfillments)))
+		promFulfillmentGasPerRequest.WithLabelValues(jobID, "true").Observe(float64(gasLimit) / float64(len(batch.fulfillments)))
+		return
+	}
+
+	for _, f := range batch.fulfillments {
+		if err := lsn.enqueueFulfillment(fromAddress, f); err != nil {
+			lggr.Errorw("Error enqueuing fulfillment, requeuing request", "reqID", f.req.req.RequestId.String(), "err", err)
+			continue
+		}
+		enqueued(f)
+	}
+}
+
 func (lsn *listenerV2) estimateFeeJuels(
 	req *vrf_coordinator_v2.VRFCoordinatorV2RandomWordsRequested,
 	maxGasPriceWei *big.Int,
@@ -57,6 +57,12 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if spec.RequestTimeout == 0 {
 		spec.RequestTimeout = 24 * time.Hour
 	}
+	if spec.BatchFulfillmentEnabled && spec.BatchCoordinatorAddress == nil {
+		return jb, errors.Wrap(ErrKeyNotSet, "batchCoordinatorAddress must be set when batchFulfillmentEnabled")
+	}
+	if spec.BatchFulfillmentGasBudget == 0 {
+		spec.BatchFulfillmentGasBudget = DefaultBatchFulfillmentGasBudget
+	}
 	var foundVRFTask bool
 	for _, t := range jb.Pipeline.Tasks {
 		if t.Type() == pipeline.TaskTypeVRF || t.Type() == pipeline.TaskTypeVRFV2 {
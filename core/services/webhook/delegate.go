@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/xeipuuv/gojsonschema"
 
 	"github.com/pkg/errors"
 
@@ -71,6 +72,7 @@ func (d *Delegate) ServicesForSpec(spec job.Job) ([]job.Service, error) {
 		spec.PipelineSpec = &pipeline.Spec{}
 	}
 	spec.PipelineSpec.JobName = spec.Name.ValueOrZero()
+	spec.PipelineSpec.ExternalJobID = spec.ExternalJobID
 	spec.PipelineSpec.JobID = spec.ID
 
 	service := &pseudoService{
@@ -113,7 +115,8 @@ func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJob
 
 type registeredJob struct {
 	job.Job
-	chRemove chan struct{}
+	chRemove      chan struct{}
+	requestSchema *gojsonschema.Schema
 }
 
 func (r *webhookJobRunner) addSpec(spec job.Job) error {
@@ -123,7 +126,15 @@ func (r *webhookJobRunner) addSpec(spec job.Job) error {
 	if exists {
 		return errors.Errorf("a webhook job with that UUID already exists (uuid: %v)", spec.ExternalJobID)
 	}
-	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{})}
+	var requestSchema *gojsonschema.Schema
+	if spec.WebhookSpec != nil && spec.WebhookSpec.RequestSchema.Valid {
+		var err error
+		requestSchema, err = compileRequestSchema(spec.WebhookSpec.RequestSchema.String)
+		if err != nil {
+			return err
+		}
+	}
+	r.specsByUUID[spec.ExternalJobID] = registeredJob{spec, make(chan struct{}), requestSchema}
 	return nil
 }
 
@@ -157,6 +168,19 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 		"uuid", spec.ExternalJobID,
 	)
 
+	jobRun := map[string]interface{}{
+		"requestBody": requestBody,
+		"meta":        meta.Val,
+	}
+	if spec.requestSchema != nil {
+		request, err := validateRequestBody(spec.requestSchema, requestBody)
+		if err != nil {
+			jobLggr.Debugw("Rejected webhook job run request", "error", err)
+			return 0, err
+		}
+		jobRun["request"] = request
+	}
+
 	ctx, cancel := utils.CombinedContext(ctx, spec.chRemove)
 	defer cancel()
 
@@ -166,10 +190,7 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 			"externalJobID": spec.ExternalJobID,
 			"name":          spec.Name.ValueOrZero(),
 		},
-		"jobRun": map[string]interface{}{
-			"requestBody": requestBody,
-			"meta":        meta.Val,
-		},
+		"jobRun": jobRun,
 	})
 
 	run := pipeline.NewRun(*spec.PipelineSpec, vars)
@@ -15,6 +15,7 @@ import (
 	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -94,3 +95,77 @@ func TestWebhookDelegate(t *testing.T) {
 
 	runner.AssertExpectations(t)
 }
+
+func TestWebhookDelegate_RequestSchema(t *testing.T) {
+	var (
+		spec = &job.Job{
+			ID:            123,
+			Type:          job.Webhook,
+			SchemaVersion: 1,
+			ExternalJobID: uuid.NewV4(),
+			WebhookSpec: &job.WebhookSpec{
+				RequestSchema: null.StringFrom(`{
+					"type": "object",
+					"required": ["data"],
+					"properties": {
+						"data": {
+							"type": "object",
+							"required": ["amount"],
+							"properties": {"amount": {"type": "number"}, "final": {"type": "boolean"}}
+						}
+					}
+				}`),
+			},
+			PipelineSpec: &pipeline.Spec{},
+		}
+		meta      = pipeline.JSONSerializable{}
+		runner    = new(pipelinemocks.Runner)
+		eiManager = new(webhookmocks.ExternalInitiatorManager)
+		delegate  = webhook.NewDelegate(runner, eiManager, logger.TestLogger(t))
+	)
+
+	services, err := delegate.ServicesForSpec(*spec)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	require.NoError(t, services[0].Start())
+	defer services[0].Close()
+
+	t.Run("rejects bodies that do not match the schema", func(t *testing.T) {
+		_, err := delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, `{"data":{"amount":"12","final":1}}`, meta)
+		var bodyErr *webhook.RequestBodyError
+		require.True(t, errors.As(err, &bodyErr))
+		require.Len(t, bodyErr.Errors, 2)
+		fields := []string{bodyErr.Errors[0].Field, bodyErr.Errors[1].Field}
+		assert.ElementsMatch(t, []string{"/data/amount", "/data/final"}, fields)
+		assert.Equal(t, "invalid_type", bodyErr.Errors[0].Type)
+	})
+
+	t.Run("rejects bodies that are not JSON", func(t *testing.T) {
+		_, err := delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, "foo", meta)
+		var bodyErr *webhook.RequestBodyError
+		require.True(t, errors.As(err, &bodyErr))
+		require.Len(t, bodyErr.Errors, 1)
+		assert.Equal(t, "", bodyErr.Errors[0].Field)
+		assert.Equal(t, "invalid_json", bodyErr.Errors[0].Type)
+	})
+
+	t.Run("exposes the typed request to the pipeline", func(t *testing.T) {
+		requestBody := `{"data":{"amount":12.5,"final":true}}`
+		runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
+			Return(false, nil).
+			Run(func(args mock.Arguments) {
+				run := args.Get(1).(*pipeline.Run)
+				run.ID = int64(1)
+
+				jobRun := run.Inputs.Val.(map[string]interface{})["jobRun"].(map[string]interface{})
+				assert.Equal(t, requestBody, jobRun["requestBody"])
+				assert.Equal(t, map[string]interface{}{
+					"data": map[string]interface{}{"amount": 12.5, "final": true},
+				}, jobRun["request"])
+			}).Once()
+
+		_, err := delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta)
+		require.NoError(t, err)
+		runner.AssertExpectations(t)
+	})
+}
@@ -2,9 +2,9 @@ package webhook
 
 import (
 	"bytes"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
@@ -26,6 +26,9 @@ type ExternalInitiatorManager interface {
 	Notify(webhookSpecID int32) error
 	DeleteJob(webhookSpecID int32) error
 	FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error)
+	Resync(name string) (int, error)
+	NotificationStatuses(eiIDs []int64) (map[int64]NotificationStatus, error)
+	DeliverPendingNotifications() error
 }
 
 //go:generate mockery --name HTTPClient --output ./mocks/ --case=underscore
@@ -36,6 +39,9 @@ type HTTPClient interface {
 type externalInitiatorManager struct {
 	q          pg.Q
 	httpclient HTTPClient
+	lggr       logger.Logger
+	// deliverMu ensures that notifications are delivered once, and in order
+	deliverMu sync.Mutex
 }
 
 var _ ExternalInitiatorManager = (*externalInitiatorManager)(nil)
@@ -46,49 +52,42 @@ func NewExternalInitiatorManager(db *sqlx.DB, httpclient HTTPClient, lggr logger
 	return &externalInitiatorManager{
 		q:          pg.NewQ(db, namedLogger, cfg),
 		httpclient: httpclient,
+		lggr:       namedLogger,
 	}
 }
 
-// Notify sends a POST notification to the External Initiator
-// responsible for initiating the Job Spec.
-func (m externalInitiatorManager) Notify(webhookSpecID int32) error {
+// Notify sends a POST notification to the External Initiators
+// responsible for initiating the Job Spec. Notifications that cannot be
+// delivered are kept in the outbox and retried with backoff.
+func (m *externalInitiatorManager) Notify(webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(webhookSpecID)
 	if err != nil {
 		return err
 	}
-	for _, eiWebhookSpec := range eiWebhookSpecs {
-		ei := eiWebhookSpec.ExternalInitiator
-		if ei.URL == nil {
+	var notifications []Notification
+	for i := range eiWebhookSpecs {
+		if eiWebhookSpecs[i].ExternalInitiator.URL == nil {
 			continue
 		}
-		notice := JobSpecNotice{
-			JobID:  jobID,
-			Type:   ei.Name,
-			Params: eiWebhookSpec.Spec,
-		}
-		buf, err := json.Marshal(notice)
-		if err != nil {
-			return errors.Wrap(err, "new Job Spec notification")
-		}
-		req, err := newNotifyHTTPRequest(buf, ei)
-		if err != nil {
-			return errors.Wrap(err, "creating notify HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrap(err, "could not notify '%s' (%s)")
-		}
-		if err := resp.Body.Close(); err != nil {
-			return err
-		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
-		}
+		notifications = append(notifications, Notification{
+			ExternalInitiatorID: eiWebhookSpecs[i].ExternalInitiatorID,
+			Kind:                NotificationCreate,
+			JobID:               jobID,
+			Params:              &eiWebhookSpecs[i].Spec,
+		})
 	}
-	return nil
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err = m.q.Transaction(func(tx pg.Queryer) error {
+		return enqueue(tx, notifications)
+	}); err != nil {
+		return err
+	}
+	return m.deliverPending(externalInitiatorIDs(notifications))
 }
 
-func (m externalInitiatorManager) Load(webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error) {
+func (m *externalInitiatorManager) Load(webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error) {
 	err = m.q.Transaction(func(tx pg.Queryer) error {
 		if err = tx.Get(&jobID, "SELECT external_job_id FROM jobs WHERE webhook_spec_id = $1", webhookSpecID); err != nil {
 			if err = errors.Wrapf(err, "failed to load job ID from job for webhook spec with ID %d", webhookSpecID); err != nil {
@@ -111,7 +110,7 @@ func (m externalInitiatorManager) Load(webhookSpecID int32) (eiWebhookSpecs []jo
 	return
 }
 
-func (m externalInitiatorManager) eagerLoadExternalInitiator(q pg.Queryer, txs []job.ExternalInitiatorWebhookSpec) error {
+func (m *externalInitiatorManager) eagerLoadExternalInitiator(q pg.Queryer, txs []job.ExternalInitiatorWebhookSpec) error {
 	var ids []int64
 	for _, tx := range txs {
 		ids = append(ids, tx.ExternalInitiatorID)
@@ -135,36 +134,41 @@ func (m externalInitiatorManager) eagerLoadExternalInitiator(q pg.Queryer, txs [
 	return nil
 }
 
-func (m externalInitiatorManager) DeleteJob(webhookSpecID int32) error {
+// DeleteJob sends a DELETE notification to the External Initiators
+// responsible for initiating the Job Spec. Notifications that cannot be
+// delivered are kept in the outbox and retried with backoff.
+func (m *externalInitiatorManager) DeleteJob(webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(webhookSpecID)
 	if err != nil {
 		return err
 	}
+	var notifications []Notification
 	for _, eiWebhookSpec := range eiWebhookSpecs {
-		ei := eiWebhookSpec.ExternalInitiator
-		if ei.URL == nil {
+		if eiWebhookSpec.ExternalInitiator.URL == nil {
 			continue
 		}
-
-		req, err := newDeleteJobFromExternalInitiatorHTTPRequest(ei, jobID)
-		if err != nil {
-			return errors.Wrap(err, "creating delete HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrapf(err, "could not delete job from remote external initiator at %s", req.URL)
-		}
-		if err := resp.Body.Close(); err != nil {
-			return err
-		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
+		notifications = append(notifications, Notification{
+			ExternalInitiatorID: eiWebhookSpec.ExternalInitiatorID,
+			Kind:                NotificationDelete,
+			JobID:               jobID,
+		})
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err = m.q.Transaction(func(tx pg.Queryer) error {
+		// There is no need to tell the external initiators about the job anymore
+		if _, err = tx.Exec(`DELETE FROM external_initiator_notifications WHERE job_id = $1 AND kind = $2 AND delivered_at IS NULL`, jobID, NotificationCreate); err != nil {
+			return errors.Wrap(err, "failed to delete pending external initiator notifications")
 		}
+		return enqueue(tx, notifications)
+	}); err != nil {
+		return err
 	}
-	return nil
+	return m.deliverPending(externalInitiatorIDs(notifications))
 }
 
-func (m externalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
+func (m *externalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
 	var exi bridges.ExternalInitiator
 	err := m.q.Get(&exi, "SELECT * FROM external_initiators WHERE lower(external_initiators.name) = lower($1)", name)
 	return exi, err
@@ -209,6 +213,13 @@ var _ ExternalInitiatorManager = (*NullExternalInitiatorManager)(nil)
 
 func (NullExternalInitiatorManager) Notify(int32) error    { return nil }
 func (NullExternalInitiatorManager) DeleteJob(int32) error { return nil }
+func (NullExternalInitiatorManager) Resync(string) (int, error) {
+	return 0, nil
+}
+func (NullExternalInitiatorManager) NotificationStatuses([]int64) (map[int64]NotificationStatus, error) {
+	return map[int64]NotificationStatus{}, nil
+}
+func (NullExternalInitiatorManager) DeliverPendingNotifications() error { return nil }
 func (NullExternalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
 	return bridges.ExternalInitiator{}, nil
 }
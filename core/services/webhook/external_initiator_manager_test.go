@@ -1,6 +1,7 @@
 package webhook_test
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -130,3 +131,154 @@ func Test_ExternalInitiatorManager_DeleteJob(t *testing.T) {
 
 	client.AssertExpectations(t)
 }
+
+func Test_ExternalInitiatorManager_RetriesNotifications(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := newBridgeORM(t, db, cfg)
+
+	ei := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, "http://example.com/foo"),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	jb1, webhookSpec1 := cltest.MustInsertWebhookSpec(t, db)
+	jb2, webhookSpec2 := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec1.ID, `{"name": "one"}`)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec2.ID, `{"name": "two"}`)
+
+	client := new(webhookmocks.HTTPClient)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t), cfg)
+
+	notifies := func(jobID string) interface{} {
+		return mock.MatchedBy(func(r *http.Request) bool {
+			body, err := r.GetBody()
+			require.NoError(t, err)
+			b, err := ioutil.ReadAll(body)
+			require.NoError(t, err)
+			return r.Method == "POST" && gjson.GetBytes(b, "jobId").Str == jobID
+		})
+	}
+	ok := func() *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}
+	}
+	pending := func() int {
+		var n int
+		require.NoError(t, db.Get(&n, `SELECT count(*) FROM external_initiator_notifications WHERE delivered_at IS NULL`))
+		return n
+	}
+
+	// The EI is down
+	client.On("Do", notifies(jb1.ExternalJobID.String())).Once().
+		Return(&http.Response{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable", Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.Error(t, eim.Notify(webhookSpec1.ID))
+	// The second notification is held back by the first one, until it is retried
+	require.NoError(t, eim.Notify(webhookSpec2.ID))
+	client.AssertExpectations(t)
+	assert.Equal(t, 2, pending())
+
+	statuses, err := eim.NotificationStatuses([]int64{ei.ID})
+	require.NoError(t, err)
+	require.Contains(t, statuses, ei.ID)
+	assert.Equal(t, 2, statuses[ei.ID].Pending)
+	assert.Equal(t, 0, statuses[ei.ID].Delivered)
+	assert.Contains(t, statuses[ei.ID].LastError.String, "503 Service Unavailable")
+
+	// Nothing is due yet
+	require.NoError(t, eim.DeliverPendingNotifications())
+	client.AssertExpectations(t)
+
+	// The EI is back up once the backoff elapsed
+	pgtest.MustExec(t, db, `UPDATE external_initiator_notifications SET next_attempt_at = NOW() - interval '1 second'`)
+	client.On("Do", notifies(jb1.ExternalJobID.String())).Once().Return(ok(), nil)
+	client.On("Do", notifies(jb2.ExternalJobID.String())).Once().Return(ok(), nil)
+	require.NoError(t, eim.DeliverPendingNotifications())
+	client.AssertExpectations(t)
+	assert.Equal(t, 0, pending())
+
+	statuses, err = eim.NotificationStatuses([]int64{ei.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 0, statuses[ei.ID].Pending)
+	assert.Equal(t, 2, statuses[ei.ID].Delivered)
+	assert.False(t, statuses[ei.ID].LastError.Valid)
+
+	// A resync enqueues all the jobs of the EI again, to push in the background
+	n, err := eim.Resync(ei.Name)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 2, pending())
+
+	client.On("Do", notifies(jb1.ExternalJobID.String())).Once().Return(ok(), nil)
+	client.On("Do", notifies(jb2.ExternalJobID.String())).Once().Return(ok(), nil)
+	require.NoError(t, eim.DeliverPendingNotifications())
+	client.AssertExpectations(t)
+	assert.Equal(t, 0, pending())
+}
+
+func Test_ExternalInitiatorManager_DownExternalInitiatorDoesNotStarveOthers(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := newBridgeORM(t, db, cfg)
+
+	eiDown := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL: cltest.MustWebURL(t, "http://down.example.com"),
+	})
+	eiUp := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL: cltest.MustWebURL(t, "http://up.example.com"),
+	})
+	jb, _ := cltest.MustInsertWebhookSpec(t, db)
+
+	// A backlog of the down EI, enqueued before the notification of the other
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_notifications (external_initiator_id, kind, job_id, next_attempt_at, created_at)
+		SELECT $1, 'create', $2, NOW(), NOW() FROM generate_series(1, 2000)`, eiDown.ID, jb.ExternalJobID)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_notifications (external_initiator_id, kind, job_id, next_attempt_at, created_at)
+		VALUES ($1, 'create', $2, NOW(), NOW())`, eiUp.ID, jb.ExternalJobID)
+
+	client := new(webhookmocks.HTTPClient)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t), cfg)
+
+	// The down EI fails its first notification, which holds back the rest
+	client.On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Host == "down.example.com" })).Once().
+		Return(&http.Response{StatusCode: http.StatusServiceUnavailable, Status: "503 Service Unavailable", Body: io.NopCloser(strings.NewReader(""))}, nil)
+	client.On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.URL.Host == "up.example.com" })).Once().
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.Error(t, eim.DeliverPendingNotifications())
+	client.AssertExpectations(t)
+
+	statuses, err := eim.NotificationStatuses([]int64{eiDown.ID, eiUp.ID})
+	require.NoError(t, err)
+	assert.Equal(t, 2000, statuses[eiDown.ID].Pending)
+	assert.Equal(t, 0, statuses[eiUp.ID].Pending)
+	assert.Equal(t, 1, statuses[eiUp.ID].Delivered)
+}
+
+func Test_ExternalInitiatorManager_DeleteJobDropsPendingNotify(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	borm := newBridgeORM(t, db, cfg)
+
+	ei := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, "http://example.com/foo"),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, ei.ID, webhookSpec.ID, `{}`)
+
+	client := new(webhookmocks.HTTPClient)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t), cfg)
+
+	client.On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.Method == "POST" })).Once().
+		Return(nil, errors.New("connection refused"))
+	require.Error(t, eim.Notify(webhookSpec.ID))
+
+	// The EI never learned about the job, so it does not know it either
+	client.On("Do", mock.MatchedBy(func(r *http.Request) bool { return r.Method == "DELETE" })).Once().
+		Return(&http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.DeleteJob(webhookSpec.ID))
+	client.AssertExpectations(t)
+
+	var kinds []string
+	require.NoError(t, db.Select(&kinds, `SELECT kind FROM external_initiator_notifications ORDER BY id`))
+	assert.Equal(t, []string{"delete"}, kinds)
+}
@@ -0,0 +1,359 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// NotificationKind is the kind of job change an external initiator is
+// notified of.
+type NotificationKind string
+
+const (
+	// NotificationCreate tells the external initiator about a job it initiates.
+	NotificationCreate NotificationKind = "create"
+	// NotificationDelete tells the external initiator that a job was deleted.
+	NotificationDelete NotificationKind = "delete"
+)
+
+const (
+	// notificationBatchSize is the maximum number of pending notifications
+	// attempted per external initiator per delivery round
+	notificationBatchSize = 100
+	// notificationRetention is how long delivered notifications are kept
+	notificationRetention = 7 * 24 * time.Hour
+	// notifierPollPeriod is how often pending notifications are retried
+	notifierPollPeriod = 10 * time.Second
+)
+
+// Notification is a job change to deliver to an external initiator. It stays
+// in the outbox, and is retried with backoff, until the external initiator
+// accepts it.
+type Notification struct {
+	ID                  int64
+	ExternalInitiatorID int64
+	Kind                NotificationKind
+	JobID               uuid.UUID
+	Params              *models.JSON
+	Attempts            int
+	NextAttemptAt       time.Time
+	LastAttemptAt       null.Time
+	LastError           null.String
+	DeliveredAt         null.Time
+	CreatedAt           time.Time
+}
+
+// NotificationStatus summarizes the delivery of notifications to an external
+// initiator.
+type NotificationStatus struct {
+	Pending         int       `db:"pending"`
+	Delivered       int       `db:"delivered"`
+	LastAttemptAt   null.Time `db:"last_attempt_at"`
+	LastDeliveredAt null.Time `db:"last_delivered_at"`
+	// LastError is the error of the latest failed attempt of a notification
+	// that is still pending, if any.
+	LastError null.String `db:"last_error"`
+}
+
+// notificationBackoff returns how long to wait before the next attempt of a
+// notification that failed the given number of times.
+func notificationBackoff(attempts int) time.Duration {
+	b := backoff.Backoff{
+		Min:    5 * time.Second,
+		Max:    time.Hour,
+		Factor: 2,
+	}
+	return b.ForAttempt(float64(attempts - 1))
+}
+
+// enqueue persists the notifications in the outbox.
+func enqueue(tx pg.Queryer, notifications []Notification) error {
+	for _, n := range notifications {
+		if _, err := tx.Exec(`INSERT INTO external_initiator_notifications (external_initiator_id, kind, job_id, params, next_attempt_at, created_at)
+			VALUES ($1, $2, $3, $4, NOW(), NOW())`, n.ExternalInitiatorID, n.Kind, n.JobID, n.Params); err != nil {
+			return errors.Wrap(err, "failed to insert external initiator notification")
+		}
+	}
+	return nil
+}
+
+// externalInitiatorIDs returns the IDs of the external initiators to notify.
+func externalInitiatorIDs(notifications []Notification) []int64 {
+	var ids []int64
+	for _, n := range notifications {
+		ids = append(ids, n.ExternalInitiatorID)
+	}
+	return ids
+}
+
+// DeliverPendingNotifications attempts to deliver the notifications that are
+// due, and prunes old delivered ones.
+func (m *externalInitiatorManager) DeliverPendingNotifications() error {
+	if err := m.q.ExecQ(`DELETE FROM external_initiator_notifications WHERE delivered_at < $1`, time.Now().Add(-notificationRetention)); err != nil {
+		return errors.Wrap(err, "failed to prune delivered external initiator notifications")
+	}
+	return m.deliverPending(nil)
+}
+
+// deliverPending attempts the pending notifications of the given external
+// initiators, or of all of them if nil, in the order they were enqueued. A
+// notification that is not due, or that fails, holds back the following
+// notifications of its external initiator.
+func (m *externalInitiatorManager) deliverPending(eiIDs []int64) error {
+	m.deliverMu.Lock()
+	defer m.deliverMu.Unlock()
+
+	// The batch is taken per external initiator, so that one which is down
+	// with a backlog of notifications does not starve the others
+	var filter string
+	args := []interface{}{notificationBatchSize}
+	if eiIDs != nil {
+		filter = "AND external_initiator_id = ANY($2)"
+		args = append(args, pq.Array(eiIDs))
+	}
+	var pending []Notification
+	err := m.q.Select(&pending, `SELECT * FROM external_initiator_notifications WHERE id IN (
+		SELECT id FROM (
+			SELECT id, row_number() OVER (PARTITION BY external_initiator_id ORDER BY id ASC) AS position
+			FROM external_initiator_notifications WHERE delivered_at IS NULL `+filter+`
+		) batches WHERE position <= $1
+	) ORDER BY id ASC`, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to load pending external initiator notifications")
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	eis, err := m.loadExternalInitiators(externalInitiatorIDs(pending))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	heldBack := make(map[int64]struct{})
+	var merr error
+	for _, n := range pending {
+		if _, held := heldBack[n.ExternalInitiatorID]; held {
+			continue
+		}
+		if n.NextAttemptAt.After(now) {
+			heldBack[n.ExternalInitiatorID] = struct{}{}
+			continue
+		}
+		ei := eis[n.ExternalInitiatorID]
+		if sendErr := m.send(ei, n); sendErr != nil {
+			heldBack[n.ExternalInitiatorID] = struct{}{}
+			merr = multierr.Append(merr, sendErr)
+			next := time.Now().Add(notificationBackoff(n.Attempts + 1))
+			if err = m.q.ExecQ(`UPDATE external_initiator_notifications SET attempts = attempts + 1, last_attempt_at = NOW(), last_error = $2, next_attempt_at = $3 WHERE id = $1`,
+				n.ID, sendErr.Error(), next); err != nil {
+				return multierr.Append(merr, errors.Wrap(err, "failed to record failed external initiator notification"))
+			}
+			m.lggr.Warnw("Failed to notify external initiator, will retry",
+				"externalInitiator", ei.Name, "jobID", n.JobID, "kind", n.Kind, "attempts", n.Attempts+1, "nextAttemptAt", next, "error", sendErr)
+			continue
+		}
+		if err = m.q.ExecQ(`UPDATE external_initiator_notifications SET attempts = attempts + 1, last_attempt_at = NOW(), delivered_at = NOW() WHERE id = $1`, n.ID); err != nil {
+			return multierr.Append(merr, errors.Wrap(err, "failed to record delivered external initiator notification"))
+		}
+	}
+	return merr
+}
+
+func (m *externalInitiatorManager) loadExternalInitiators(ids []int64) (map[int64]bridges.ExternalInitiator, error) {
+	var externalInitiators []bridges.ExternalInitiator
+	if err := m.q.Select(&externalInitiators, `SELECT * FROM external_initiators WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return nil, errors.Wrap(err, "failed to load external initiators of notifications")
+	}
+	eis := make(map[int64]bridges.ExternalInitiator)
+	for _, ei := range externalInitiators {
+		eis[ei.ID] = ei
+	}
+	return eis, nil
+}
+
+// send delivers a single notification to its external initiator.
+func (m *externalInitiatorManager) send(ei bridges.ExternalInitiator, n Notification) error {
+	if ei.URL == nil {
+		// Nothing to notify
+		return nil
+	}
+
+	var req *http.Request
+	var err error
+	switch n.Kind {
+	case NotificationCreate:
+		notice := JobSpecNotice{
+			JobID: n.JobID,
+			Type:  ei.Name,
+		}
+		if n.Params != nil {
+			notice.Params = *n.Params
+		}
+		var buf []byte
+		if buf, err = json.Marshal(notice); err != nil {
+			return errors.Wrap(err, "new Job Spec notification")
+		}
+		req, err = newNotifyHTTPRequest(buf, ei)
+	case NotificationDelete:
+		req, err = newDeleteJobFromExternalInitiatorHTTPRequest(ei, n.JobID)
+	default:
+		return errors.Errorf("unknown notification kind %s", n.Kind)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "creating %s HTTP request", n.Kind)
+	}
+
+	resp, err := m.httpclient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not notify '%s' (%s)", ei.Name, ei.URL)
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	// The external initiator may never have learned about a deleted job
+	if n.Kind == NotificationDelete && resp.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return fmt.Errorf("notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
+	}
+	return nil
+}
+
+// Resync enqueues a create notification for every job initiated by the named
+// external initiator, so that it can recover the jobs it missed. It returns
+// the number of jobs, which are delivered in the background by the
+// ExternalInitiatorNotifier.
+func (m *externalInitiatorManager) Resync(name string) (int, error) {
+	ei, err := m.FindExternalInitiatorByName(name)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to find external initiator %s", name)
+	}
+	if ei.URL == nil {
+		return 0, errors.Errorf("external initiator %s has no URL to notify", name)
+	}
+
+	var eiJobs []struct {
+		ExternalJobID uuid.UUID   `db:"external_job_id"`
+		Spec          models.JSON `db:"spec"`
+	}
+	err = m.q.Select(&eiJobs, `SELECT jobs.external_job_id, external_initiator_webhook_specs.spec FROM jobs
+		JOIN external_initiator_webhook_specs ON external_initiator_webhook_specs.webhook_spec_id = jobs.webhook_spec_id
+		WHERE external_initiator_webhook_specs.external_initiator_id = $1
+		ORDER BY jobs.id ASC`, ei.ID)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to load jobs of external initiator %s", name)
+	}
+
+	var notifications []Notification
+	for i := range eiJobs {
+		notifications = append(notifications, Notification{
+			ExternalInitiatorID: ei.ID,
+			Kind:                NotificationCreate,
+			JobID:               eiJobs[i].ExternalJobID,
+			Params:              &eiJobs[i].Spec,
+		})
+	}
+	if err = m.q.Transaction(func(tx pg.Queryer) error {
+		return enqueue(tx, notifications)
+	}); err != nil {
+		return 0, err
+	}
+	return len(notifications), nil
+}
+
+// NotificationStatuses returns the notification delivery status of the given
+// external initiators, by ID. External initiators that were never notified
+// are omitted.
+func (m *externalInitiatorManager) NotificationStatuses(eiIDs []int64) (map[int64]NotificationStatus, error) {
+	var rows []struct {
+		ExternalInitiatorID int64 `db:"external_initiator_id"`
+		NotificationStatus
+	}
+	err := m.q.Select(&rows, `SELECT external_initiator_id,
+		count(*) FILTER (WHERE delivered_at IS NULL) AS pending,
+		count(*) FILTER (WHERE delivered_at IS NOT NULL) AS delivered,
+		max(last_attempt_at) AS last_attempt_at,
+		max(delivered_at) AS last_delivered_at,
+		(array_agg(last_error ORDER BY last_attempt_at DESC) FILTER (WHERE delivered_at IS NULL AND last_error IS NOT NULL))[1] AS last_error
+		FROM external_initiator_notifications
+		WHERE external_initiator_id = ANY($1)
+		GROUP BY external_initiator_id`, pq.Array(eiIDs))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load external initiator notification statuses")
+	}
+	statuses := make(map[int64]NotificationStatus)
+	for _, row := range rows {
+		statuses[row.ExternalInitiatorID] = row.NotificationStatus
+	}
+	return statuses, nil
+}
+
+type externalInitiatorNotifier struct {
+	utils.StartStopOnce
+	eim    ExternalInitiatorManager
+	lggr   logger.Logger
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+// NewExternalInitiatorNotifier returns a service that periodically retries the
+// pending notifications of external initiators.
+func NewExternalInitiatorNotifier(eim ExternalInitiatorManager, lggr logger.Logger) *externalInitiatorNotifier {
+	return &externalInitiatorNotifier{
+		eim:    eim,
+		lggr:   lggr.Named("ExternalInitiatorNotifier"),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (n *externalInitiatorNotifier) Start() error {
+	return n.StartOnce("ExternalInitiatorNotifier", func() error {
+		n.wgDone.Add(1)
+		go n.run()
+		return nil
+	})
+}
+
+func (n *externalInitiatorNotifier) Close() error {
+	return n.StopOnce("ExternalInitiatorNotifier", func() error {
+		close(n.chStop)
+		n.wgDone.Wait()
+		return nil
+	})
+}
+
+func (n *externalInitiatorNotifier) run() {
+	defer n.wgDone.Done()
+
+	ticker := time.NewTicker(notifierPollPeriod)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.chStop:
+			return
+		case <-ticker.C:
+			if err := n.eim.DeliverPendingNotifications(); err != nil {
+				n.lggr.Debugw("Some external initiator notifications are still pending", "error", err)
+			}
+		}
+	}
+}
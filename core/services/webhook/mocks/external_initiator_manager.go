@@ -5,6 +5,8 @@ package mocks
 import (
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 	mock "github.com/stretchr/testify/mock"
+
+	webhook "github.com/smartcontractkit/chainlink/core/services/webhook"
 )
 
 // ExternalInitiatorManager is an autogenerated mock type for the ExternalInitiatorManager type
@@ -12,6 +14,20 @@ type ExternalInitiatorManager struct {
 	mock.Mock
 }
 
+// DeliverPendingNotifications provides a mock function with given fields:
+func (_m *ExternalInitiatorManager) DeliverPendingNotifications() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteJob provides a mock function with given fields: webhookSpecID
 func (_m *ExternalInitiatorManager) DeleteJob(webhookSpecID int32) error {
 	ret := _m.Called(webhookSpecID)
@@ -47,6 +63,29 @@ func (_m *ExternalInitiatorManager) FindExternalInitiatorByName(name string) (br
 	return r0, r1
 }
 
+// NotificationStatuses provides a mock function with given fields: eiIDs
+func (_m *ExternalInitiatorManager) NotificationStatuses(eiIDs []int64) (map[int64]webhook.NotificationStatus, error) {
+	ret := _m.Called(eiIDs)
+
+	var r0 map[int64]webhook.NotificationStatus
+	if rf, ok := ret.Get(0).(func([]int64) map[int64]webhook.NotificationStatus); ok {
+		r0 = rf(eiIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]webhook.NotificationStatus)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]int64) error); ok {
+		r1 = rf(eiIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Notify provides a mock function with given fields: webhookSpecID
 func (_m *ExternalInitiatorManager) Notify(webhookSpecID int32) error {
 	ret := _m.Called(webhookSpecID)
@@ -60,3 +99,24 @@ func (_m *ExternalInitiatorManager) Notify(webhookSpecID int32) error {
 
 	return r0
 }
+
+// Resync provides a mock function with given fields: name
+func (_m *ExternalInitiatorManager) Resync(name string) (int, error) {
+	ret := _m.Called(name)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// RequestBodyFieldError describes why a field of a request body does not
+// conform to the request schema of a webhook job.
+type RequestBodyFieldError struct {
+	// Field is the JSON pointer of the field, e.g. "/data/amount", or "" for
+	// the whole body.
+	Field string
+	// Type is the kind of violation, e.g. "required" or "invalid_type".
+	Type        string
+	Description string
+}
+
+// RequestBodyError is returned when a run request body does not conform to the
+// request schema of a webhook job.
+type RequestBodyError struct {
+	Errors []RequestBodyFieldError
+}
+
+func (e *RequestBodyError) Error() string {
+	var msgs []string
+	for _, fe := range e.Errors {
+		if fe.Field == "" {
+			msgs = append(msgs, fe.Description)
+		} else {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", fe.Field, fe.Description))
+		}
+	}
+	return "request body does not match the request schema: " + strings.Join(msgs, "; ")
+}
+
+// compileRequestSchema parses the JSON Schema of a webhook job.
+func compileRequestSchema(schema string) (*gojsonschema.Schema, error) {
+	compiled, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(schema))
+	return compiled, errors.Wrap(err, "invalid request schema")
+}
+
+// validateRequestBody decodes the request body and checks it against the
+// schema. It returns the decoded body, with JSON types preserved.
+func validateRequestBody(schema *gojsonschema.Schema, requestBody string) (interface{}, error) {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(requestBody), &decoded); err != nil {
+		return nil, &RequestBodyError{Errors: []RequestBodyFieldError{{
+			Type:        "invalid_json",
+			Description: fmt.Sprintf("request body is not valid JSON: %v", err),
+		}}}
+	}
+
+	result, err := schema.Validate(gojsonschema.NewGoLoader(decoded))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to validate request body")
+	}
+	if result.Valid() {
+		return decoded, nil
+	}
+
+	bodyErr := &RequestBodyError{}
+	for _, re := range result.Errors() {
+		bodyErr.Errors = append(bodyErr.Errors, RequestBodyFieldError{
+			Field:       jsonPointer(re.Context()),
+			Type:        re.Type(),
+			Description: re.Description(),
+		})
+	}
+	return nil, bodyErr
+}
+
+// jsonPointer converts a validation context, e.g. "(root).data.amount", into a
+// JSON pointer, e.g. "/data/amount".
+func jsonPointer(context *gojsonschema.JsonContext) string {
+	if context == nil {
+		return ""
+	}
+	return strings.TrimPrefix(context.String("/"), gojsonschema.STRING_CONTEXT_ROOT)
+}
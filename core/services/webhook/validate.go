@@ -1,9 +1,12 @@
 package webhook
 
 import (
+	"strings"
+
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/store/models"
@@ -16,6 +19,7 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	RequestSchema      string                             `toml:"requestSchema"`
 }
 
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -57,8 +61,17 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 		return jb, err
 	}
 
+	var requestSchema null.String
+	if strings.TrimSpace(tomlSpec.RequestSchema) != "" {
+		if _, err = compileRequestSchema(tomlSpec.RequestSchema); err != nil {
+			return jb, err
+		}
+		requestSchema = null.StringFrom(tomlSpec.RequestSchema)
+	}
+
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		RequestSchema:                 requestSchema,
 	}
 
 	return jb, nil
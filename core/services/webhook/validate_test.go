@@ -142,6 +142,41 @@ func TestValidatedWebJobSpec(t *testing.T) {
 				require.EqualError(t, err, "unable to find external initiator named bar: something exploded; unable to find external initiator named baz: something exploded")
 			},
 		},
+		{
+			name: "with request schema",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            requestSchema   = '{"type": "object", "required": ["amount"]}'
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+                ds_parse    [type=jsonparse path="data,price"];
+                ds -> ds_parse;
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.WebhookSpec)
+				assert.Equal(t, `{"type": "object", "required": ["amount"]}`, s.WebhookSpec.RequestSchema.ValueOrZero())
+			},
+		},
+		{
+			name: "with invalid request schema",
+			toml: `
+            type            = "webhook"
+            schemaVersion   = 1
+            requestSchema   = '{"type": "thing"}'
+            observationSource   = """
+                ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+                ds_parse    [type=jsonparse path="data,price"];
+                ds -> ds_parse;
+            """
+            `,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "invalid request schema")
+			},
+		},
 	}
 	for _, tc := range tt {
 		tc := tc
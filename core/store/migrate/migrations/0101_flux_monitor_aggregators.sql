@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE flux_monitor_specs ADD COLUMN aggregators jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE flux_monitor_specs DROP COLUMN aggregators;
+-- +goose StatementEnd
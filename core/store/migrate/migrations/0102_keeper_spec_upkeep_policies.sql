@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE keeper_specs
+    ADD COLUMN max_gas_price numeric(78,0),
+    ADD COLUMN priority_tiers jsonb,
+    ADD COLUMN allowed_upkeep_ids bigint[],
+    ADD COLUMN denied_upkeep_ids bigint[];
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE keeper_specs
+    DROP COLUMN max_gas_price,
+    DROP COLUMN priority_tiers,
+    DROP COLUMN allowed_upkeep_ids,
+    DROP COLUMN denied_upkeep_ids;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE offchainreporting2_oracle_specs
+    ADD COLUMN plugin_type text NOT NULL DEFAULT 'median',
+    ADD COLUMN plugin_config jsonb NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE offchainreporting2_oracle_specs
+    DROP COLUMN plugin_type,
+    DROP COLUMN plugin_config;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE offchainreporting_oracle_specs ADD COLUMN shadow_observation_source text NOT NULL DEFAULT '';
+ALTER TABLE offchainreporting2_oracle_specs ADD COLUMN shadow_observation_source text NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE offchainreporting_oracle_specs DROP COLUMN shadow_observation_source;
+ALTER TABLE offchainreporting2_oracle_specs DROP COLUMN shadow_observation_source;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE vrf_specs
+    ADD COLUMN batch_fulfillment_enabled bool NOT NULL DEFAULT false,
+    ADD COLUMN batch_coordinator_address bytea,
+    ADD COLUMN batch_fulfillment_gas_budget bigint NOT NULL DEFAULT 2500000;
+ALTER TABLE vrf_specs ADD CONSTRAINT batch_coordinator_address_len_chk CHECK (octet_length(batch_coordinator_address) = 20);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vrf_specs
+    DROP COLUMN batch_fulfillment_enabled,
+    DROP COLUMN batch_coordinator_address,
+    DROP COLUMN batch_fulfillment_gas_budget;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE blockhash_store_backwards_runs (
+    id BIGSERIAL PRIMARY KEY,
+    job_id int NOT NULL REFERENCES jobs(id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    start_block bigint NOT NULL,
+    target_block bigint NOT NULL,
+    next_block bigint NOT NULL,
+    state text NOT NULL,
+    error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    CONSTRAINT chk_blocks CHECK (target_block >= 0 AND target_block < start_block AND next_block < start_block),
+    CONSTRAINT chk_state CHECK (state IN ('running', 'completed', 'errored'))
+);
+CREATE INDEX idx_blockhash_store_backwards_runs_job_id ON blockhash_store_backwards_runs (job_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE blockhash_store_backwards_runs;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE direct_request_specs
+    ADD COLUMN min_contract_payment_per_word numeric(78, 0),
+    ADD COLUMN response_shape text NOT NULL DEFAULT '',
+    ADD COLUMN response_words bigint NOT NULL DEFAULT 0,
+    ADD COLUMN callback_gas_limit bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE direct_request_specs
+    DROP COLUMN min_contract_payment_per_word,
+    DROP COLUMN response_shape,
+    DROP COLUMN response_words,
+    DROP COLUMN callback_gas_limit;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE direct_request_specs ADD COLUMN requester_rules jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE direct_request_specs DROP COLUMN requester_rules;
+-- +goose StatementEnd
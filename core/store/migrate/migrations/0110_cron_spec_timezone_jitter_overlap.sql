@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    ADD COLUMN timezone text NOT NULL DEFAULT '',
+    ADD COLUMN jitter bigint NOT NULL DEFAULT 0,
+    ADD COLUMN overlap_policy text NOT NULL DEFAULT 'allow';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cron_specs
+    DROP COLUMN timezone,
+    DROP COLUMN jitter,
+    DROP COLUMN overlap_policy;
+-- +goose StatementEnd
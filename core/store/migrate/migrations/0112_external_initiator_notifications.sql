@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE external_initiator_notifications (
+    id BIGSERIAL PRIMARY KEY,
+    external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    kind text NOT NULL CHECK (kind IN ('create', 'delete')),
+    job_id uuid NOT NULL,
+    params jsonb,
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL,
+    last_attempt_at timestamp with time zone,
+    last_error text,
+    delivered_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_external_initiator_notifications_pending ON external_initiator_notifications (external_initiator_id, id) WHERE delivered_at IS NULL;
+CREATE INDEX idx_external_initiator_notifications_delivered_at ON external_initiator_notifications (delivered_at) WHERE delivered_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE external_initiator_notifications;
+-- +goose StatementEnd
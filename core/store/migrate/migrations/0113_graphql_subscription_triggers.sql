@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION public.notifypipelineruninsertion() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		PERFORM pg_notify('insert_on_pipeline_runs'::text, NEW.id::text);
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER notify_pipeline_run_insertion AFTER INSERT ON public.pipeline_runs FOR EACH ROW EXECUTE PROCEDURE public.notifypipelineruninsertion();
+
+CREATE OR REPLACE FUNCTION public.notifyethtxstatechange() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		PERFORM pg_notify('eth_tx_state_change'::text, NEW.id::text);
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER notify_eth_tx_state_change_on_insert AFTER INSERT ON public.eth_txes FOR EACH ROW EXECUTE PROCEDURE public.notifyethtxstatechange();
+CREATE TRIGGER notify_eth_tx_state_change_on_update AFTER UPDATE OF state ON public.eth_txes FOR EACH ROW WHEN (OLD.state IS DISTINCT FROM NEW.state) EXECUTE PROCEDURE public.notifyethtxstatechange();
+
+CREATE OR REPLACE FUNCTION public.notifyjobspecerrorupsert() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+        BEGIN
+		PERFORM pg_notify('upsert_on_job_spec_errors'::text, NEW.id::text);
+		RETURN NULL;
+        END
+        $$;
+
+CREATE TRIGGER notify_job_spec_error_upsert AFTER INSERT OR UPDATE ON public.job_spec_errors FOR EACH ROW EXECUTE PROCEDURE public.notifyjobspecerrorupsert();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION notifypipelineruninsertion, notifyethtxstatechange, notifyjobspecerrorupsert CASCADE;
+-- +goose StatementEnd
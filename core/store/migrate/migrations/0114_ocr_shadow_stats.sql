@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE ocr_shadow_stats (
+    job_id integer PRIMARY KEY REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    observations bigint NOT NULL DEFAULT 0,
+    compared bigint NOT NULL DEFAULT 0,
+    live_errors bigint NOT NULL DEFAULT 0,
+    shadow_errors bigint NOT NULL DEFAULT 0,
+    deviation_total double precision NOT NULL DEFAULT 0,
+    max_deviation_percent double precision NOT NULL DEFAULT 0,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE TABLE ocr_shadow_comparisons (
+    id BIGSERIAL PRIMARY KEY,
+    job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE DEFERRABLE INITIALLY IMMEDIATE,
+    live numeric(78,0),
+    shadow numeric(78,0),
+    live_error text NOT NULL DEFAULT '',
+    shadow_error text NOT NULL DEFAULT '',
+    deviation_percent double precision,
+    created_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_ocr_shadow_comparisons_job_id_id ON ocr_shadow_comparisons (job_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE ocr_shadow_comparisons;
+DROP TABLE ocr_shadow_stats;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The height after which the tx of a broadcasted msg can no longer be included
+ALTER TABLE terra_msgs ADD COLUMN timeout_height bigint;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE terra_msgs DROP COLUMN timeout_height;
+-- +goose StatementEnd
@@ -11,7 +11,16 @@ type JSONAPIErrors struct {
 
 // JSONAPIError is an individual JSONAPI Error.
 type JSONAPIError struct {
-	Detail string `json:"detail"`
+	Detail string              `json:"detail"`
+	Code   string              `json:"code,omitempty"`
+	Source *JSONAPIErrorSource `json:"source,omitempty"`
+}
+
+// JSONAPIErrorSource points to the part of the request that caused a JSONAPI
+// Error.
+type JSONAPIErrorSource struct {
+	// Pointer is a JSON pointer into the request body, e.g. "/data/amount".
+	Pointer string `json:"pointer"`
 }
 
 // NewJSONAPIErrors creates an instance of JSONAPIErrors, with the intention
@@ -0,0 +1,83 @@
+package web
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// AlertsController manages the alerts raised by the node and their silences
+type AlertsController struct {
+	App chainlink.Application
+}
+
+// CreateSilenceRequest is the request to silence alerts for a duration
+type CreateSilenceRequest struct {
+	Rule     string            `json:"rule"`
+	Labels   map[string]string `json:"labels"`
+	Comment  string            `json:"comment"`
+	Duration models.Duration   `json:"duration"`
+}
+
+// Index lists the pending and firing alerts
+// Example:
+// "GET <application>/alerts"
+func (ac *AlertsController) Index(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewAlertResources(ac.App.AlertEngine().Alerts()), "alerts")
+}
+
+// IndexSilences lists the silences which have not ended yet
+// Example:
+// "GET <application>/alerts/silences"
+func (ac *AlertsController) IndexSilences(c *gin.Context) {
+	jsonAPIResponse(c, presenters.NewSilenceResources(ac.App.AlertEngine().Silences()), "silences")
+}
+
+// CreateSilence silences the matching alerts for the requested duration
+// Example:
+// "POST <application>/alerts/silences"
+func (ac *AlertsController) CreateSilence(c *gin.Context) {
+	request := CreateSilenceRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.Duration.Duration() <= 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("duration must be positive"))
+		return
+	}
+
+	silence, err := ac.App.AlertEngine().AddSilence(alerts.Silence{
+		Rule:    request.Rule,
+		Labels:  request.Labels,
+		Comment: request.Comment,
+		EndsAt:  time.Now().Add(request.Duration.Duration()),
+	})
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewSilenceResource(silence), "silences", http.StatusCreated)
+}
+
+// DestroySilence expires a silence
+// Example:
+// "DELETE <application>/alerts/silences/:ID"
+func (ac *AlertsController) DestroySilence(c *gin.Context) {
+	err := ac.App.AlertEngine().ExpireSilence(c.Param("ID"))
+	if errors.Is(err, alerts.ErrSilenceNotFound) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, nil, "silences", http.StatusNoContent)
+}
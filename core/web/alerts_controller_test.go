@@ -0,0 +1,97 @@
+package web_test
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestAlertsController_Index(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/alerts")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resources := []presenters.AlertResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+	assert.Empty(t, resources)
+}
+
+func TestAlertsController_Silences(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	body := []byte(`{"rule": "bridge_down", "labels": {"bridge": "foo"}, "comment": "upgrading", "duration": "1h"}`)
+	resp, cleanup := client.Post("/v2/alerts/silences", bytes.NewReader(body))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	created := presenters.SilenceResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &created))
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, alerts.RuleBridgeDown, created.Rule)
+	assert.Equal(t, map[string]string{"bridge": "foo"}, created.Labels)
+	assert.Equal(t, "upgrading", created.Comment)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), created.EndsAt, time.Minute)
+
+	resp, cleanup = client.Get("/v2/alerts/silences")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	silences := []presenters.SilenceResource{}
+	require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &silences))
+	require.Len(t, silences, 1)
+	assert.Equal(t, created.ID, silences[0].ID)
+
+	resp, cleanup = client.Delete("/v2/alerts/silences/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+	assert.Empty(t, app.AlertEngine().Silences())
+
+	resp, cleanup = client.Delete("/v2/alerts/silences/" + created.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
+
+func TestAlertsController_CreateSilence_Invalid(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+
+	tests := []struct {
+		name string
+		body string
+	}{
+		{"missing duration", `{"rule": "bridge_down"}`},
+		{"unknown rule", `{"rule": "foo", "duration": "1h"}`},
+		{"invalid JSON", `{"rule": `},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			resp, cleanup := client.Post("/v2/alerts/silences", bytes.NewBufferString(test.body))
+			t.Cleanup(cleanup)
+			cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+		})
+	}
+}
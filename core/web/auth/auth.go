@@ -78,26 +78,37 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
+	user, err := findUserByToken(authr, token)
+	if err != nil {
+		return err
+	}
+
+	c.Set(SessionUserKey, &user)
+
+	return nil
+}
+
+// findUserByToken returns the user the API token belongs to, or
+// auth.ErrorAuthFailed if it does not belong to any user.
+func findUserByToken(authr Authenticator, token *auth.Token) (clsessions.User, error) {
 	user, err := authr.FindUser()
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return auth.ErrorAuthFailed
+			return user, auth.ErrorAuthFailed
 		}
 
-		return err
+		return user, err
 	}
 
 	ok, err := clsessions.AuthenticateUserByToken(token, &user)
 	if err != nil {
-		return err
+		return user, err
 	}
 	if !ok {
-		return auth.ErrorAuthFailed
+		return user, auth.ErrorAuthFailed
 	}
 
-	c.Set(SessionUserKey, &user)
-
-	return nil
+	return user, nil
 }
 
 var _ authMethod = AuthenticateByToken
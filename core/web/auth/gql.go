@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 
+	"github.com/smartcontractkit/chainlink/core/auth"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 )
 
@@ -15,30 +16,53 @@ type GQLSession struct {
 	User      *clsessions.User
 }
 
-// AuthenticateGQL middleware checks the session cookie for a user and sets it
-// on the request context if it exists. It is the responsibility of each resolver
-// to validate whether it requires an authenticated user.
-//
-// We currently only support GQL authentication by session cookie.
+// AuthenticateGQL middleware checks the session cookie, or failing that the API
+// token headers, for a user and sets it on the request context if it exists. It
+// is the responsibility of each resolver to validate whether it requires an
+// authenticated user.
 func AuthenticateGQL(authenticator Authenticator) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		session := sessions.Default(c)
-		sessionID, ok := session.Get(SessionIDKey).(string)
-		if !ok {
+		if sessionID, ok := session.Get(SessionIDKey).(string); ok {
+			user, err := authenticator.AuthorizedUserWithSession(sessionID)
+			if err == nil {
+				ctx := SetGQLAuthenticatedSession(c.Request.Context(), user, sessionID)
+				c.Request = c.Request.WithContext(ctx)
+				return
+			}
+		}
+
+		if c.GetHeader(APIKey) == "" {
 			return
 		}
 
-		user, err := authenticator.AuthorizedUserWithSession(sessionID)
+		ctx, err := AuthenticateGQLByToken(c.Request.Context(), authenticator, &auth.Token{
+			AccessKey: c.GetHeader(APIKey),
+			Secret:    c.GetHeader(APISecret),
+		})
 		if err != nil {
 			return
 		}
 
-		ctx := SetGQLAuthenticatedSession(c.Request.Context(), user, sessionID)
-
 		c.Request = c.Request.WithContext(ctx)
 	}
 }
 
+// AuthenticateGQLByToken sets the user the API token belongs to as the
+// authenticated session in the context. Token authenticated sessions have no
+// session ID.
+//
+// This is used to authenticate GQL subscriptions by the token sent with the
+// websocket connection_init message.
+func AuthenticateGQLByToken(ctx context.Context, authenticator Authenticator, token *auth.Token) (context.Context, error) {
+	user, err := findUserByToken(authenticator, token)
+	if err != nil {
+		return ctx, err
+	}
+
+	return SetGQLAuthenticatedSession(ctx, user, ""), nil
+}
+
 // SetGQLAuthenticatedSession sets the authenticated session in the context
 //
 // There shouldn't be a need to do this outside of testing
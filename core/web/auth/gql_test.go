@@ -9,7 +9,9 @@ import (
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	clauth "github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/sessions/mocks"
@@ -68,6 +70,70 @@ func Test_AuthenticateGQL_Authenticated(t *testing.T) {
 	r.ServeHTTP(w, req)
 }
 
+func Test_AuthenticateGQL_AuthenticatedByToken(t *testing.T) {
+	t.Parallel()
+
+	user := cltest.MustRandomUser(t)
+	apiToken := clauth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret}
+	require.NoError(t, user.SetAuthToken(&apiToken))
+	authr := userFindSuccesser{user: user}
+	sessionStore := sessions.NewCookieStore([]byte(cltest.SessionSecret))
+
+	r := gin.Default()
+	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
+	r.Use(auth.AuthenticateGQL(authr))
+
+	called := false
+	r.GET("/", func(c *gin.Context) {
+		called = true
+		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
+		require.True(t, ok)
+		assert.Equal(t, user.Email, session.User.Email)
+		assert.Empty(t, session.SessionID)
+
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set(auth.APIKey, cltest.APIKey)
+	req.Header.Set(auth.APISecret, cltest.APISecret)
+	r.ServeHTTP(w, req)
+
+	assert.True(t, called)
+}
+
+func Test_AuthenticateGQL_InvalidToken(t *testing.T) {
+	t.Parallel()
+
+	user := cltest.MustRandomUser(t)
+	apiToken := clauth.Token{AccessKey: cltest.APIKey, Secret: cltest.APISecret}
+	require.NoError(t, user.SetAuthToken(&apiToken))
+	authr := userFindSuccesser{user: user}
+	sessionStore := sessions.NewCookieStore([]byte(cltest.SessionSecret))
+
+	r := gin.Default()
+	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
+	r.Use(auth.AuthenticateGQL(authr))
+
+	called := false
+	r.GET("/", func(c *gin.Context) {
+		called = true
+		_, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
+		assert.False(t, ok)
+
+		c.String(http.StatusOK, "")
+	})
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/", nil)
+	req.Header.Set(auth.APIKey, cltest.APIKey)
+	req.Header.Set(auth.APISecret, "bad-secret")
+	r.ServeHTTP(w, req)
+
+	assert.True(t, called)
+}
+
 func Test_GetAndSetGQLAuthenticatedSession(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,114 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// BlockhashStoreController manages the backwards runs of BlockhashStore
+// feeder jobs, which store the hashes of historical blocks.
+type BlockhashStoreController struct {
+	App chainlink.Application
+}
+
+// CreateBHSBackwardsRunRequest is a request to store the hashes of the blocks
+// from StartBlock-1 down to TargetBlock. The hash of StartBlock must be stored
+// already.
+type CreateBHSBackwardsRunRequest struct {
+	StartBlock  int64 `json:"startBlock"`
+	TargetBlock int64 `json:"targetBlock"`
+}
+
+// Index lists the backwards runs of a BlockhashStore feeder job, newest first.
+// Example:
+//  "GET <application>/jobs/:ID/blockhash_store/backwards_runs"
+func (bc *BlockhashStoreController) Index(c *gin.Context) {
+	jb, ok := bc.findJob(c)
+	if !ok {
+		return
+	}
+
+	runs, err := bc.orm().FindBackwardsRuns(jb.ID, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewBHSBackwardsRunResources(runs), "blockhash_store_backwards_runs")
+}
+
+// Create starts a backwards run of a BlockhashStore feeder job. The run is
+// advanced by the job every poll period, and resumes after restarts.
+// Example:
+//  "POST <application>/jobs/:ID/blockhash_store/backwards_runs"
+func (bc *BlockhashStoreController) Create(c *gin.Context) {
+	jb, ok := bc.findJob(c)
+	if !ok {
+		return
+	}
+
+	request := CreateBHSBackwardsRunRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.TargetBlock < 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("targetBlock must not be negative"))
+		return
+	}
+	if request.StartBlock <= request.TargetBlock {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("startBlock must be greater than targetBlock"))
+		return
+	}
+
+	run := blockhashstore.BackwardsRun{
+		JobID:       jb.ID,
+		StartBlock:  request.StartBlock,
+		TargetBlock: request.TargetBlock,
+	}
+	if err := bc.orm().CreateBackwardsRun(&run, pg.WithParentCtx(c.Request.Context())); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponseWithStatus(c, presenters.NewBHSBackwardsRunResource(run), "blockhash_store_backwards_runs", http.StatusCreated)
+}
+
+func (bc *BlockhashStoreController) orm() blockhashstore.ORM {
+	return blockhashstore.NewORM(bc.App.GetSqlxDB(), bc.App.GetLogger(), bc.App.GetConfig())
+}
+
+// findJob finds the BlockhashStore job by job ID or external job ID, writing
+// an error response if there is none.
+func (bc *BlockhashStoreController) findJob(c *gin.Context) (jb job.Job, ok bool) {
+	var err error
+	if externalJobID, pErr := uuid.FromString(c.Param("ID")); pErr == nil {
+		jb, err = bc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(c.Request.Context()))
+	} else if pErr = jb.SetID(c.Param("ID")); pErr == nil {
+		jb, err = bc.App.JobORM().FindJobTx(jb.ID)
+	} else {
+		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
+		return jb, false
+	}
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return jb, false
+	}
+	if jb.Type != job.BlockhashStore {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job is a %s job, not a %s job", jb.Type, job.BlockhashStore))
+		return jb, false
+	}
+	return jb, true
+}
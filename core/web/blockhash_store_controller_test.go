@@ -0,0 +1,120 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/testdata/testspecs"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func setupBlockhashStoreControllerTest(t *testing.T) (*cltest.TestApplication, cltest.HTTPClientCleaner, job.Job) {
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+
+	jb, err := blockhashstore.ValidatedSpec(
+		testspecs.GenerateBlockhashStoreSpec(testspecs.BlockhashStoreSpecParams{JobID: uuid.NewV4().String()}).Toml())
+	require.NoError(t, err)
+	require.NoError(t, app.JobORM().CreateJob(&jb))
+
+	return app, app.NewHTTPClient(), jb
+}
+
+func TestBlockhashStoreController_Create(t *testing.T) {
+	t.Parallel()
+
+	_, client, jb := setupBlockhashStoreControllerTest(t)
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/blockhash_store/backwards_runs", jb.ID),
+		bytes.NewBufferString(`{"startBlock": 100, "targetBlock": 90}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	var run presenters.BHSBackwardsRunResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &run))
+	assert.Equal(t, jb.ID, run.JobID)
+	assert.Equal(t, int64(100), run.StartBlock)
+	assert.Equal(t, int64(90), run.TargetBlock)
+	assert.Equal(t, int64(99), run.NextBlock)
+	assert.Equal(t, int64(10), run.Remaining)
+	assert.Equal(t, string(blockhashstore.BackwardsRunRunning), run.State)
+	assert.Nil(t, run.Error)
+
+	for _, tt := range []struct {
+		name string
+		body string
+	}{
+		{"invalid JSON", `{"startBlock": "foo"}`},
+		{"negative target", `{"startBlock": 100, "targetBlock": -1}`},
+		{"start not above target", `{"startBlock": 90, "targetBlock": 90}`},
+	} {
+		resp, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%d/blockhash_store/backwards_runs", jb.ID), bytes.NewBufferString(tt.body))
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, tt.name)
+	}
+}
+
+func TestBlockhashStoreController_Index(t *testing.T) {
+	t.Parallel()
+
+	app, client, jb := setupBlockhashStoreControllerTest(t)
+
+	orm := blockhashstore.NewORM(app.GetSqlxDB(), app.GetLogger(), app.GetConfig())
+	first := blockhashstore.BackwardsRun{JobID: jb.ID, StartBlock: 100, TargetBlock: 90}
+	require.NoError(t, orm.CreateBackwardsRun(&first))
+	second := blockhashstore.BackwardsRun{JobID: jb.ID, StartBlock: 50, TargetBlock: 0}
+	require.NoError(t, orm.CreateBackwardsRun(&second))
+
+	// By job ID and by external job ID
+	for _, id := range []string{fmt.Sprintf("%d", jb.ID), jb.ExternalJobID.String()} {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%s/blockhash_store/backwards_runs", id))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var runs []presenters.BHSBackwardsRunResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &runs))
+		require.Len(t, runs, 2)
+		// Newest first
+		assert.Equal(t, fmt.Sprintf("%d", second.ID), runs[0].ID)
+		assert.Equal(t, fmt.Sprintf("%d", first.ID), runs[1].ID)
+	}
+}
+
+func TestBlockhashStoreController_NotABlockhashStoreJob(t *testing.T) {
+	t.Parallel()
+
+	app, client, _ := setupBlockhashStoreControllerTest(t)
+
+	drjb, err := directrequest.ValidatedDirectRequestSpec(string(cltest.MustReadFile(t, "../testdata/tomlspecs/direct-request-spec.toml")))
+	require.NoError(t, err)
+	require.NoError(t, app.JobORM().CreateJob(&drjb))
+
+	for _, tt := range []struct {
+		id     string
+		status int
+	}{
+		{fmt.Sprintf("%d", drjb.ID), http.StatusUnprocessableEntity},
+		{"foo", http.StatusUnprocessableEntity},
+		{"999999", http.StatusNotFound},
+		{uuid.NewV4().String(), http.StatusNotFound},
+	} {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%s/blockhash_store/backwards_runs", tt.id))
+		t.Cleanup(cleanup)
+		assert.Equal(t, tt.status, resp.StatusCode, tt.id)
+
+		resp, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%s/blockhash_store/backwards_runs", tt.id),
+			bytes.NewBufferString(`{"startBlock": 100, "targetBlock": 90}`))
+		t.Cleanup(cleanup)
+		assert.Equal(t, tt.status, resp.StatusCode, tt.id)
+	}
+}
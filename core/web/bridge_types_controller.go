@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -49,6 +50,11 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest, orm bridges.ORM) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if secrets.IsRef(bt.OutgoingToken) {
+		if err := secrets.CheckBridgeRef(bt.OutgoingToken); err != nil {
+			fe.Add(err.Error())
+		}
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -207,6 +213,7 @@ func (btc *BridgeTypesController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, fmt.Errorf("failed to delete bridge: %+v", err))
 		return
 	}
+	btc.App.PipelineRunner().ForgetBridge(bt.Name.String())
 
 	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
 }
@@ -102,6 +102,33 @@ func TestValidateBridgeType(t *testing.T) {
 				URL:  cltest.WebURL(t, "https://denergy.eth"),
 			},
 			nil,
+		},
+		{
+			"valid outgoing token secret reference",
+			bridges.BridgeTypeRequest{
+				Name:          "adapterwithsecret",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				OutgoingToken: "secret://vault/secret/bridges/denergy#token",
+			},
+			nil,
+		},
+		{
+			"outgoing token secret reference outside of bridges",
+			bridges.BridgeTypeRequest{
+				Name:          "adapterwithsecret",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				OutgoingToken: "secret://env/DATABASE_URL",
+			},
+			models.NewJSONAPIErrorsWith(`bridge tokens must be secret://file or secret://vault references, got "secret://env/DATABASE_URL"`),
+		},
+		{
+			"invalid outgoing token secret reference",
+			bridges.BridgeTypeRequest{
+				Name:          "adapterwithsecret",
+				URL:           cltest.WebURL(t, "https://denergy.eth"),
+				OutgoingToken: "secret://vault",
+			},
+			models.NewJSONAPIErrorsWith(`invalid secret reference "secret://vault", expected secret://<provider>/<path>`),
 		}}
 
 	for _, test := range tests {
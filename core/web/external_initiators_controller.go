@@ -46,9 +46,27 @@ type ExternalInitiatorsController struct {
 
 func (eic *ExternalInitiatorsController) Index(c *gin.Context, size, page, offset int) {
 	eis, count, err := eic.App.BridgeORM().ExternalInitiators(offset, size)
+	if err != nil {
+		paginatedResponse(c, "externalInitiators", size, page, nil, count, err)
+		return
+	}
+	var ids []int64
+	for _, ei := range eis {
+		ids = append(ids, ei.ID)
+	}
+	statuses, err := eic.App.GetExternalInitiatorManager().NotificationStatuses(ids)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
 	var resources []presenters.ExternalInitiatorResource
 	for _, ei := range eis {
-		resources = append(resources, presenters.NewExternalInitiatorResource(ei))
+		resource := presenters.NewExternalInitiatorResource(ei)
+		if status, ok := statuses[ei.ID]; ok {
+			resource.Notifications = presenters.NewExternalInitiatorNotificationStatus(status)
+		}
+		resources = append(resources, resource)
 	}
 
 	paginatedResponse(c, "externalInitiators", size, page, resources, count, err)
@@ -88,6 +106,44 @@ func (eic *ExternalInitiatorsController) Create(c *gin.Context) {
 	jsonAPIResponseWithStatus(c, resp, "external initiator authentication", http.StatusCreated)
 }
 
+// Resync enqueues notifications to an ExternalInitiator of all the jobs it
+// initiates, so that it can recover the jobs it missed. They are delivered in
+// the background.
+// Example:
+//  "POST <application>/external_initiators/:Name/resync"
+func (eic *ExternalInitiatorsController) Resync(c *gin.Context) {
+	name := c.Param("Name")
+	exi, err := eic.App.BridgeORM().FindExternalInitiatorByName(name)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("external initiator not found"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if exi.URL == nil {
+		jsonAPIError(c, http.StatusBadRequest, errors.Errorf("external initiator %s has no URL to notify", exi.Name))
+		return
+	}
+
+	eim := eic.App.GetExternalInitiatorManager()
+	if _, err = eim.Resync(exi.Name); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	statuses, err := eim.NotificationStatuses([]int64{exi.ID})
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resource := presenters.NewExternalInitiatorResource(exi)
+	if status, ok := statuses[exi.ID]; ok {
+		resource.Notifications = presenters.NewExternalInitiatorNotificationStatus(status)
+	}
+	jsonAPIResponse(c, resource, "externalInitiator")
+}
+
 // Destroy deletes an ExternalInitiator
 func (eic *ExternalInitiatorsController) Destroy(c *gin.Context) {
 	name := c.Param("Name")
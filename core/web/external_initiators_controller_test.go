@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/manyminds/api2go/jsonapi"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 )
 
 func TestValidateExternalInitiator(t *testing.T) {
@@ -238,3 +241,79 @@ func TestExternalInitiatorsController_DeleteNotFound(t *testing.T) {
 		assert.Equal(t, http.StatusText(http.StatusNotFound), http.StatusText(resp.StatusCode))
 	}
 }
+
+func TestExternalInitiatorsController_Resync(t *testing.T) {
+	t.Parallel()
+
+	var notified int32
+	eiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			atomic.AddInt32(&notified, 1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer eiServer.Close()
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.EVMEnabled = null.BoolFrom(false)
+	app := cltest.NewApplicationWithConfig(t, cfg, cltest.UseRealExternalInitiatorManager)
+	require.NoError(t, app.Start())
+
+	db := app.GetSqlxDB()
+	borm := bridges.NewORM(db, logger.TestLogger(t), app.GetConfig())
+	eiWithURL := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{
+		NamePrefix: "foo",
+		URL:        cltest.MustWebURL(t, eiServer.URL),
+	})
+	eiNoURL := cltest.MustInsertExternalInitiatorWithOpts(t, borm, cltest.ExternalInitiatorOpts{NamePrefix: "bar"})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	pgtest.MustExec(t, db, `INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES ($1,$2,$3)`, eiWithURL.ID, webhookSpec.ID, `{}`)
+
+	client := app.NewHTTPClient()
+
+	t.Run("resyncs the jobs of the external initiator", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/external_initiators/"+eiWithURL.Name+"/resync", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var ei presenters.ExternalInitiatorResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &ei))
+		require.NotNil(t, ei.Notifications)
+		// the notification is delivered in the background
+		assert.Equal(t, 1, ei.Notifications.Pending+ei.Notifications.Delivered)
+	})
+
+	t.Run("lists the notification status", func(t *testing.T) {
+		require.NoError(t, app.GetExternalInitiatorManager().DeliverPendingNotifications())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&notified))
+
+		resp, cleanup := client.Get("/v2/external_initiators")
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var links jsonapi.Links
+		eis := []presenters.ExternalInitiatorResource{}
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &eis, &links))
+		require.Len(t, eis, 2)
+		for _, ei := range eis {
+			if ei.Name == eiWithURL.Name {
+				require.NotNil(t, ei.Notifications)
+				assert.Equal(t, 1, ei.Notifications.Delivered)
+			} else {
+				assert.Nil(t, ei.Notifications)
+			}
+		}
+	})
+
+	t.Run("without URL", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/external_initiators/"+eiNoURL.Name+"/resync", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+	})
+
+	t.Run("unknown external initiator", func(t *testing.T) {
+		resp, cleanup := client.Post("/v2/external_initiators/not-exist/resync", nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+	})
+}
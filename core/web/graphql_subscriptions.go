@@ -0,0 +1,275 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
+)
+
+// Message types of the graphql-ws protocol, as implemented by Apollo's
+// subscriptions-transport-ws.
+//
+// See https://github.com/apollographql/subscriptions-transport-ws/blob/master/PROTOCOL.md
+const (
+	gqlWSProtocol = "graphql-ws"
+
+	gqlWSConnectionInit      = "connection_init"
+	gqlWSConnectionAck       = "connection_ack"
+	gqlWSConnectionError     = "connection_error"
+	gqlWSConnectionKeepAlive = "ka"
+	gqlWSConnectionTerminate = "connection_terminate"
+	gqlWSStart               = "start"
+	gqlWSStop                = "stop"
+	gqlWSData                = "data"
+	gqlWSError               = "error"
+	gqlWSComplete            = "complete"
+)
+
+const (
+	gqlWSKeepAliveInterval = 15 * time.Second
+	gqlWSWriteTimeout      = 10 * time.Second
+)
+
+type gqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+type gqlWSStartPayload struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// gqlWSInitPayload holds the connection params sent with connection_init.
+// Clients which cannot set headers on the websocket handshake may
+// authenticate by sending their API token here.
+type gqlWSInitPayload struct {
+	APIKey    string `json:"x-api-key"`
+	APISecret string `json:"x-api-secret"`
+}
+
+type gqlWSErrorPayload struct {
+	Message string `json:"message"`
+}
+
+// graphqlSubscriptionHandler serves GraphQL operations, most notably
+// subscriptions, over a websocket using the graphql-ws protocol.
+func graphqlSubscriptionHandler(app chainlink.Application, schema *graphql.Schema) gin.HandlerFunc {
+	upgrader := websocket.Upgrader{
+		Subprotocols: []string{gqlWSProtocol},
+		// Cross origin requests have already been vetted by the CORS middleware
+		CheckOrigin: func(*http.Request) bool { return true },
+	}
+	lggr := app.GetLogger().Named("GraphQLSubscriptions")
+
+	return func(c *gin.Context) {
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			// The upgrader has already replied with an HTTP error
+			lggr.Debugw("Failed to upgrade GraphQL websocket connection", "err", err)
+			return
+		}
+		conn.SetReadLimit(app.GetConfig().DefaultHTTPLimit())
+
+		ws := &gqlWSConnection{
+			conn:   conn,
+			schema: schema,
+			authr:  app.SessionORM(),
+			lggr:   lggr,
+			ops:    make(map[string]*gqlWSOperation),
+		}
+		ws.serve(c.Request.Context())
+	}
+}
+
+type gqlWSConnection struct {
+	conn   *websocket.Conn
+	schema *graphql.Schema
+	authr  webauth.Authenticator
+	lggr   logger.Logger
+
+	writeMu sync.Mutex
+	opsMu   sync.Mutex
+	ops     map[string]*gqlWSOperation
+	wg      sync.WaitGroup
+}
+
+type gqlWSOperation struct {
+	cancel context.CancelFunc
+}
+
+// serve reads messages until the client terminates the connection, then
+// stops all running operations.
+func (ws *gqlWSConnection) serve(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		ws.wg.Wait()
+		ws.conn.Close()
+	}()
+
+	initialized := false
+	for {
+		var msg gqlWSMessage
+		if err := ws.conn.ReadJSON(&msg); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				ws.lggr.Debugw("Failed to read GraphQL websocket message", "err", err)
+			}
+			return
+		}
+
+		switch msg.Type {
+		case gqlWSConnectionInit:
+			var err error
+			if ctx, err = ws.init(ctx, msg.Payload); err != nil {
+				ws.write(gqlWSMessage{Type: gqlWSConnectionError, Payload: errorPayload(err)})
+				return
+			}
+			ws.write(gqlWSMessage{Type: gqlWSConnectionAck})
+			if !initialized {
+				initialized = true
+				ws.wg.Add(1)
+				go ws.keepAlive(ctx)
+			}
+		case gqlWSStart:
+			if !initialized {
+				ws.write(gqlWSMessage{ID: msg.ID, Type: gqlWSError, Payload: errorPayload(errors.New("connection has not been initialized"))})
+				continue
+			}
+			ws.start(ctx, msg.ID, msg.Payload)
+		case gqlWSStop:
+			ws.stop(msg.ID)
+		case gqlWSConnectionTerminate:
+			return
+		default:
+			ws.write(gqlWSMessage{ID: msg.ID, Type: gqlWSError, Payload: errorPayload(errors.Errorf("unknown message type %q", msg.Type))})
+		}
+	}
+}
+
+// init authenticates the connection by the API token in the connection params,
+// unless it was already authenticated by the handshake request.
+func (ws *gqlWSConnection) init(ctx context.Context, payload json.RawMessage) (context.Context, error) {
+	if _, ok := webauth.GetGQLAuthenticatedSession(ctx); ok || len(payload) == 0 {
+		return ctx, nil
+	}
+
+	var params gqlWSInitPayload
+	if err := json.Unmarshal(payload, &params); err != nil {
+		return ctx, errors.Wrap(err, "invalid connection params")
+	}
+	if params.APIKey == "" {
+		return ctx, nil
+	}
+
+	return webauth.AuthenticateGQLByToken(ctx, ws.authr, &auth.Token{
+		AccessKey: params.APIKey,
+		Secret:    params.APISecret,
+	})
+}
+
+// start executes an operation and streams its results until it completes or
+// is stopped.
+func (ws *gqlWSConnection) start(ctx context.Context, id string, payload json.RawMessage) {
+	var params gqlWSStartPayload
+	if err := json.Unmarshal(payload, &params); err != nil {
+		ws.write(gqlWSMessage{ID: id, Type: gqlWSError, Payload: errorPayload(errors.Wrap(err, "invalid operation"))})
+		return
+	}
+
+	ws.opsMu.Lock()
+	if _, exists := ws.ops[id]; exists {
+		ws.opsMu.Unlock()
+		ws.write(gqlWSMessage{ID: id, Type: gqlWSError, Payload: errorPayload(errors.Errorf("operation %q is already running", id))})
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	ws.ops[id] = &gqlWSOperation{cancel: cancel}
+	ws.opsMu.Unlock()
+
+	responses, err := ws.schema.Subscribe(ctx, params.Query, params.OperationName, params.Variables)
+	if err != nil {
+		ws.finish(id)
+		ws.write(gqlWSMessage{ID: id, Type: gqlWSError, Payload: errorPayload(err)})
+		return
+	}
+
+	ws.wg.Add(1)
+	go func() {
+		defer ws.wg.Done()
+		for resp := range responses {
+			b, err := json.Marshal(resp)
+			if err != nil {
+				ws.lggr.Errorw("Failed to marshal GraphQL response", "err", err)
+				continue
+			}
+			ws.write(gqlWSMessage{ID: id, Type: gqlWSData, Payload: b})
+		}
+		ws.finish(id)
+		ws.write(gqlWSMessage{ID: id, Type: gqlWSComplete})
+	}()
+}
+
+// stop cancels a running operation. Its results stop streaming and the client
+// is sent a complete message.
+func (ws *gqlWSConnection) stop(id string) {
+	ws.opsMu.Lock()
+	defer ws.opsMu.Unlock()
+	if op, exists := ws.ops[id]; exists {
+		op.cancel()
+	}
+}
+
+// finish releases a completed operation, so that its ID may be reused.
+func (ws *gqlWSConnection) finish(id string) {
+	ws.opsMu.Lock()
+	defer ws.opsMu.Unlock()
+	if op, exists := ws.ops[id]; exists {
+		op.cancel()
+		delete(ws.ops, id)
+	}
+}
+
+func (ws *gqlWSConnection) keepAlive(ctx context.Context) {
+	defer ws.wg.Done()
+	ticker := time.NewTicker(gqlWSKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ws.write(gqlWSMessage{Type: gqlWSConnectionKeepAlive})
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (ws *gqlWSConnection) write(msg gqlWSMessage) {
+	ws.writeMu.Lock()
+	defer ws.writeMu.Unlock()
+	if err := ws.conn.SetWriteDeadline(time.Now().Add(gqlWSWriteTimeout)); err != nil {
+		return
+	}
+	if err := ws.conn.WriteJSON(msg); err != nil {
+		ws.lggr.Debugw("Failed to write GraphQL websocket message", "type", msg.Type, "err", err)
+	}
+}
+
+func errorPayload(err error) json.RawMessage {
+	b, _ := json.Marshal(gqlWSErrorPayload{Message: err.Error()})
+	return b
+}
@@ -0,0 +1,153 @@
+package web_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web"
+)
+
+type gqlWSMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func dialGQLWS(t *testing.T, url string, header http.Header) *websocket.Conn {
+	t.Helper()
+
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-ws"}}
+	conn, resp, err := dialer.Dial(strings.Replace(url, "http", "ws", 1)+"/query", header)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		resp.Body.Close()
+		conn.Close()
+	})
+	assert.Equal(t, "graphql-ws", conn.Subprotocol())
+
+	return conn
+}
+
+func sendGQLWS(t *testing.T, conn *websocket.Conn, msg gqlWSMessage) {
+	t.Helper()
+
+	require.NoError(t, conn.WriteJSON(msg))
+}
+
+func readGQLWS(t *testing.T, conn *websocket.Conn) gqlWSMessage {
+	t.Helper()
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(10*time.Second)))
+	var msg gqlWSMessage
+	require.NoError(t, conn.ReadJSON(&msg))
+
+	return msg
+}
+
+func TestGraphQLSubscriptions_Unauthenticated(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	ts := httptest.NewServer(web.Router(app, nil))
+	defer ts.Close()
+
+	conn := dialGQLWS(t, ts.URL, nil)
+
+	sendGQLWS(t, conn, gqlWSMessage{Type: "connection_init"})
+	assert.Equal(t, "connection_ack", readGQLWS(t, conn).Type)
+
+	sendGQLWS(t, conn, gqlWSMessage{
+		ID:      "1",
+		Type:    "start",
+		Payload: json.RawMessage(`{"query": "subscription { jobRunFinished { id } }"}`),
+	})
+
+	msg := readGQLWS(t, conn)
+	assert.Equal(t, "1", msg.ID)
+	assert.Equal(t, "data", msg.Type)
+	assert.Contains(t, string(msg.Payload), "Unauthorized")
+
+	msg = readGQLWS(t, conn)
+	assert.Equal(t, "1", msg.ID)
+	assert.Equal(t, "complete", msg.Type)
+}
+
+func TestGraphQLSubscriptions_InvalidConnectionToken(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	ts := httptest.NewServer(web.Router(app, nil))
+	defer ts.Close()
+
+	conn := dialGQLWS(t, ts.URL, nil)
+
+	sendGQLWS(t, conn, gqlWSMessage{
+		Type:    "connection_init",
+		Payload: json.RawMessage(`{"x-api-key": "` + cltest.APIKey + `", "x-api-secret": "bad-secret"}`),
+	})
+	assert.Equal(t, "connection_error", readGQLWS(t, conn).Type)
+}
+
+func TestGraphQLSubscriptions_NodeStateChanged(t *testing.T) {
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	ts := httptest.NewServer(web.Router(app, nil))
+	defer ts.Close()
+
+	cookie := cltest.MustGenerateSessionCookie(t, app.MustSeedNewSession())
+	conn := dialGQLWS(t, ts.URL, http.Header{"Cookie": []string{cookie.String()}})
+
+	sendGQLWS(t, conn, gqlWSMessage{Type: "connection_init"})
+	assert.Equal(t, "connection_ack", readGQLWS(t, conn).Type)
+
+	sendGQLWS(t, conn, gqlWSMessage{
+		ID:      "1",
+		Type:    "start",
+		Payload: json.RawMessage(`{"query": "subscription { nodeStateChanged { name state previousState } }"}`),
+	})
+	// Operations are started in order, so once the query has completed the
+	// subscription is known to be listening
+	sendGQLWS(t, conn, gqlWSMessage{
+		ID:      "2",
+		Type:    "start",
+		Payload: json.RawMessage(`{"query": "{ __typename }"}`),
+	})
+	assert.Equal(t, gqlWSMessage{ID: "2", Type: "data", Payload: json.RawMessage(`{"data":{"__typename":"Query"}}`)}, readGQLWS(t, conn))
+	assert.Equal(t, gqlWSMessage{ID: "2", Type: "complete"}, readGQLWS(t, conn))
+
+	node := evmclient.NewNode(logger.TestLogger(t), *cltest.MustParseURL(t, "ws://example.invalid"), nil, "subscribed node")
+	require.Error(t, node.Dial(context.Background()))
+
+	// Nodes of other tests may change state concurrently
+	for {
+		msg := readGQLWS(t, conn)
+		require.Equal(t, "1", msg.ID)
+		require.Equal(t, "data", msg.Type)
+		if strings.Contains(string(msg.Payload), "subscribed node") {
+			assert.JSONEq(t, `{"data": {"nodeStateChanged": {"name": "subscribed node", "state": "DEAD", "previousState": "UNDIALED"}}}`, string(msg.Payload))
+			break
+		}
+	}
+
+	sendGQLWS(t, conn, gqlWSMessage{ID: "1", Type: "stop"})
+	for {
+		msg := readGQLWS(t, conn)
+		if msg.Type == "complete" {
+			assert.Equal(t, "1", msg.ID)
+			break
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// OCRShadowController shows how the shadow pipelines of OCR jobs compare to
+// their live observations.
+type OCRShadowController struct {
+	App chainlink.Application
+}
+
+// Show returns the shadow pipeline stats of an OCR or OCR2 job, by job ID or
+// external job ID.
+// Example:
+//  "GET <application>/jobs/:ID/shadow_observations"
+func (sc *OCRShadowController) Show(c *gin.Context) {
+	var err error
+	jb := job.Job{}
+	if externalJobID, pErr := uuid.FromString(c.Param("ID")); pErr == nil {
+		jb, err = sc.App.JobORM().FindJobByExternalJobID(externalJobID, pg.WithParentCtx(c.Request.Context()))
+	} else if pErr = jb.SetID(c.Param("ID")); pErr == nil {
+		jb, err = sc.App.JobORM().FindJobTx(jb.ID)
+	} else {
+		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
+		return
+	}
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	var shadowSource string
+	switch {
+	case jb.OffchainreportingOracleSpec != nil:
+		shadowSource = jb.OffchainreportingOracleSpec.ShadowObservationSource
+	case jb.Offchainreporting2OracleSpec != nil:
+		shadowSource = jb.Offchainreporting2OracleSpec.ShadowObservationSource
+	}
+	if shadowSource == "" {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job has no shadowObservationSource"))
+		return
+	}
+
+	orm := ocrcommon.NewShadowORM(sc.App.GetSqlxDB(), sc.App.GetLogger(), sc.App.GetConfig())
+	stats, err := orm.FindShadowStats(jb.ID, pg.WithParentCtx(c.Request.Context()))
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewShadowStatsResource(stats), "shadow_observations")
+}
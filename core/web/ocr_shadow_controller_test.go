@@ -0,0 +1,73 @@
+package web_test
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestOCRShadowController_Show(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplication(t)
+	require.NoError(t, app.Start())
+	client := app.NewHTTPClient()
+	db := app.GetSqlxDB()
+
+	jb := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+	_, err := db.Exec(`UPDATE offchainreporting_oracle_specs SET shadow_observation_source = 'ds [type=memo value=1];' WHERE id = $1`, jb.OffchainreportingOracleSpec.ID)
+	require.NoError(t, err)
+	withoutShadow := cltest.MustInsertV2JobSpec(t, db, testutils.NewAddress())
+
+	deviation := 10.0
+	orm := ocrcommon.NewShadowORM(db, app.GetLogger(), app.GetConfig())
+	require.NoError(t, orm.RecordShadowComparison(jb.ID, ocrcommon.ShadowComparison{
+		Timestamp: time.Now(), Live: big.NewInt(100), Shadow: big.NewInt(110), DeviationPercent: &deviation,
+	}))
+	require.NoError(t, orm.RecordShadowComparison(jb.ID, ocrcommon.ShadowComparison{
+		Timestamp: time.Now(), Live: big.NewInt(100), ShadowError: "bridge down",
+	}))
+
+	// By job ID and by external job ID
+	for _, id := range []string{fmt.Sprintf("%d", jb.ID), jb.ExternalJobID.String()} {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%s/shadow_observations", id))
+		t.Cleanup(cleanup)
+		cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+		var stats presenters.ShadowStatsResource
+		require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &stats))
+		assert.Equal(t, fmt.Sprintf("%d", jb.ID), stats.ID)
+		assert.Equal(t, uint64(2), stats.Observations)
+		assert.Equal(t, uint64(1), stats.Compared)
+		assert.Equal(t, uint64(1), stats.ShadowErrors)
+		assert.Equal(t, uint64(0), stats.LiveErrors)
+		assert.Equal(t, deviation, stats.MaxDeviationPercent)
+		assert.Len(t, stats.Recent, 2)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		id     string
+		status int
+	}{
+		{"no shadowObservationSource", fmt.Sprintf("%d", withoutShadow.ID), http.StatusNotFound},
+		{"invalid ID", "foo", http.StatusUnprocessableEntity},
+		{"missing job", "999999", http.StatusNotFound},
+		{"missing external job", uuid.NewV4().String(), http.StatusNotFound},
+	} {
+		resp, cleanup := client.Get(fmt.Sprintf("/v2/jobs/%s/shadow_observations", tt.id))
+		t.Cleanup(cleanup)
+		assert.Equal(t, tt.status, resp.StatusCode, tt.name)
+	}
+}
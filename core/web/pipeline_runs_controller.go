@@ -2,6 +2,7 @@ package web
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
@@ -117,9 +119,13 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		}
 		if canRun {
 			jobRunID, err3 := prc.App.RunWebhookJobV2(c.Request.Context(), jobUUID, string(bodyBytes), pipeline.JSONSerializable{})
+			var bodyErr *webhook.RequestBodyError
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
+			} else if errors.As(err3, &bodyErr) {
+				jsonAPIError(c, http.StatusBadRequest, requestBodyJSONAPIErrors(bodyErr))
+				return
 			} else if err3 != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err3)
 				return
@@ -151,6 +157,47 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("bad job ID"))
 }
 
+// Replay re-executes a finished pipeline run of a job, with the vars it was
+// originally run with, in a sandbox which never submits transactions. The run
+// is replayed with the pipeline spec it was run with, or the current pipeline
+// spec of the job with spec=current. The response compares the results of
+// each task in the original run and in the replay.
+// Example:
+// "POST <application>/jobs/:ID/runs/:runID/replay?spec=current"
+func (prc *PipelineRunsController) Replay(c *gin.Context) {
+	jobSpec := job.Job{}
+	if err := jobSpec.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	pipelineRun := pipeline.Run{}
+	if err := pipelineRun.SetID(c.Param("runID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	var currentSpec bool
+	switch spec := c.Query("spec"); spec {
+	case "", "run":
+	case "current":
+		currentSpec = true
+	default:
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("spec must be run or current, got %s", spec))
+		return
+	}
+
+	replay, err := prc.App.ReplayJobRunV2(c.Request.Context(), jobSpec.ID, pipelineRun.ID, currentSpec)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineRunReplayResource(replay, prc.App.GetLogger()), "pipelineRunReplay")
+}
+
 // Resume finishes a task and resumes the pipeline run.
 // Example:
 // "PATCH <application>/jobs/:ID/runs/:runID"
@@ -181,3 +228,17 @@ func (prc *PipelineRunsController) Resume(c *gin.Context) {
 
 	c.Status(http.StatusOK)
 }
+
+// requestBodyJSONAPIErrors returns a JSONAPI Error for each field of a webhook
+// job run request body that does not match the request schema of the job.
+func requestBodyJSONAPIErrors(bodyErr *webhook.RequestBodyError) *models.JSONAPIErrors {
+	jae := models.NewJSONAPIErrors()
+	for _, fe := range bodyErr.Errors {
+		jae.Errors = append(jae.Errors, models.JSONAPIError{
+			Detail: fe.Description,
+			Code:   fe.Type,
+			Source: &models.JSONAPIErrorSource{Pointer: fe.Field},
+		})
+	}
+	return jae
+}
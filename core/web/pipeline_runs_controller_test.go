@@ -149,6 +149,86 @@ func TestPipelineRunsController_CreateNoBody_HappyPath(t *testing.T) {
 	}
 }
 
+func TestPipelineRunsController_CreateWithRequestSchema(t *testing.T) {
+	t.Parallel()
+
+	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
+	defer assertMocksCalled()
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.SetTriggerFallbackDBPollInterval(10 * time.Millisecond)
+	cfg.Overrides.EVMRPCEnabled = null.BoolFrom(false)
+
+	app := cltest.NewApplicationWithConfig(t, cfg, ethClient)
+	require.NoError(t, app.Start())
+
+	// Add the job
+	var uuid uuid.UUID
+	{
+		tomlStr := `
+type            = "webhook"
+schemaVersion   = 1
+requestSchema   = '''
+{
+	"type": "object",
+	"required": ["amount"],
+	"properties": {"amount": {"type": "number"}}
+}
+'''
+observationSource = """
+    multiply [type=multiply input="$(jobRun.request.amount)" times=100];
+"""
+`
+		jb, err := webhook.ValidatedWebhookSpec(tomlStr, app.GetExternalInitiatorManager())
+		require.NoError(t, err)
+
+		err = app.AddJobV2(context.Background(), &jb)
+		require.NoError(t, err)
+
+		uuid = jb.ExternalJobID
+	}
+
+	// Give the job.Spawner ample time to discover the job and start its service
+	// (because Postgres events don't seem to work here)
+	time.Sleep(3 * time.Second)
+
+	client := app.NewHTTPClient()
+
+	t.Run("invalid body", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+uuid.String()+"/runs", strings.NewReader(`{"amount":"lots"}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+
+		errs := cltest.ParseJSONAPIErrors(t, response.Body)
+		require.Len(t, errs.Errors, 1)
+		assert.Equal(t, "invalid_type", errs.Errors[0].Code)
+		require.NotNil(t, errs.Errors[0].Source)
+		assert.Equal(t, "/amount", errs.Errors[0].Source.Pointer)
+	})
+
+	t.Run("body that is not JSON", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+uuid.String()+"/runs", strings.NewReader(`amount`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusBadRequest)
+
+		errs := cltest.ParseJSONAPIErrors(t, response.Body)
+		require.Len(t, errs.Errors, 1)
+		assert.Equal(t, "invalid_json", errs.Errors[0].Code)
+	})
+
+	t.Run("valid body", func(t *testing.T) {
+		response, cleanup := client.Post("/v2/jobs/"+uuid.String()+"/runs", strings.NewReader(`{"amount":1.5}`))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunResource
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+		require.Len(t, parsedResponse.Outputs, 1)
+		require.NotNil(t, parsedResponse.Outputs[0])
+		assert.Equal(t, "150", *parsedResponse.Outputs[0])
+	})
+}
+
 func TestPipelineRunsController_Index_GlobalHappyPath(t *testing.T) {
 	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
 
@@ -245,6 +325,47 @@ func TestPipelineRunsController_ShowRun_InvalidID(t *testing.T) {
 	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
 }
 
+func TestPipelineRunsController_Replay_HappyPath(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	for _, query := range []string{"", "?spec=current"} {
+		response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/replay%s", jobID, runIDs[0], query), nil)
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusOK)
+
+		var parsedResponse presenters.PipelineRunReplayResource
+		err := web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &parsedResponse)
+		require.NoError(t, err)
+
+		assert.Equal(t, strconv.Itoa(int(runIDs[0])), parsedResponse.ID)
+		assert.Equal(t, query != "", parsedResponse.CurrentSpec)
+		assert.False(t, parsedResponse.OutputsChanged)
+		assert.Equal(t, parsedResponse.Original.Outputs, parsedResponse.Replayed.Outputs)
+		require.Len(t, parsedResponse.TaskRuns, 8)
+		for _, tr := range parsedResponse.TaskRuns {
+			assert.False(t, tr.Changed, tr.DotID)
+			require.NotNil(t, tr.Original, tr.DotID)
+			require.NotNil(t, tr.Replayed, tr.DotID)
+		}
+	}
+}
+
+func TestPipelineRunsController_Replay_Errors(t *testing.T) {
+	client, jobID, runIDs := setupPipelineRunsControllerTests(t)
+
+	response, cleanup := client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/replay?spec=latest", jobID, runIDs[0]), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/replay", jobID, runIDs[1]+100), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+
+	response, cleanup = client.Post(fmt.Sprintf("/v2/jobs/%v/runs/%v/replay", jobID+100, runIDs[0]), nil)
+	defer cleanup()
+	cltest.AssertServerResponse(t, response, http.StatusNotFound)
+}
+
 func setupPipelineRunsControllerTests(t *testing.T) (cltest.HTTPClientCleaner, int32, []int64) {
 	t.Parallel()
 	ethClient, _, assertMocksCalled := cltest.NewEthMocksWithStartupAssertions(t)
@@ -0,0 +1,92 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/alerts"
+)
+
+// AlertResource represents an alert JSONAPI resource.
+type AlertResource struct {
+	JAID
+	Rule           string            `json:"rule"`
+	Severity       alerts.Severity   `json:"severity"`
+	Labels         map[string]string `json:"labels"`
+	Summary        string            `json:"summary"`
+	State          alerts.AlertState `json:"state"`
+	StartsAt       time.Time         `json:"startsAt"`
+	LastNotifiedAt *time.Time        `json:"lastNotifiedAt"`
+	Silenced       bool              `json:"silenced"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (AlertResource) GetName() string {
+	return "alerts"
+}
+
+// NewAlertResource constructs a new AlertResource
+func NewAlertResource(alert alerts.Alert) *AlertResource {
+	r := &AlertResource{
+		JAID:     NewJAID(alert.ID),
+		Rule:     alert.Rule,
+		Severity: alert.Severity,
+		Labels:   alert.Labels,
+		Summary:  alert.Summary,
+		State:    alert.State,
+		StartsAt: alert.StartsAt,
+		Silenced: alert.Silenced,
+	}
+	if !alert.LastNotifiedAt.IsZero() {
+		lastNotifiedAt := alert.LastNotifiedAt
+		r.LastNotifiedAt = &lastNotifiedAt
+	}
+
+	return r
+}
+
+// NewAlertResources constructs a list of AlertResources
+func NewAlertResources(as []alerts.Alert) []AlertResource {
+	rs := []AlertResource{}
+	for _, alert := range as {
+		rs = append(rs, *NewAlertResource(alert))
+	}
+
+	return rs
+}
+
+// SilenceResource represents an alert silence JSONAPI resource.
+type SilenceResource struct {
+	JAID
+	Rule      string            `json:"rule"`
+	Labels    map[string]string `json:"labels"`
+	Comment   string            `json:"comment"`
+	CreatedAt time.Time         `json:"createdAt"`
+	EndsAt    time.Time         `json:"endsAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (SilenceResource) GetName() string {
+	return "silences"
+}
+
+// NewSilenceResource constructs a new SilenceResource
+func NewSilenceResource(silence alerts.Silence) *SilenceResource {
+	return &SilenceResource{
+		JAID:      NewJAID(silence.ID),
+		Rule:      silence.Rule,
+		Labels:    silence.Labels,
+		Comment:   silence.Comment,
+		CreatedAt: silence.CreatedAt,
+		EndsAt:    silence.EndsAt,
+	}
+}
+
+// NewSilenceResources constructs a list of SilenceResources
+func NewSilenceResources(silences []alerts.Silence) []SilenceResource {
+	rs := []SilenceResource{}
+	for _, silence := range silences {
+		rs = append(rs, *NewSilenceResource(silence))
+	}
+
+	return rs
+}
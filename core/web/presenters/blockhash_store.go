@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+)
+
+// BHSBackwardsRunResource is a JSONAPI resource of a backwards run of a
+// BlockhashStore feeder job.
+type BHSBackwardsRunResource struct {
+	JAID
+	JobID       int32     `json:"jobID"`
+	StartBlock  int64     `json:"startBlock"`
+	TargetBlock int64     `json:"targetBlock"`
+	NextBlock   int64     `json:"nextBlock"`
+	Remaining   int64     `json:"remaining"`
+	State       string    `json:"state"`
+	Error       *string   `json:"error"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r BHSBackwardsRunResource) GetName() string {
+	return "blockhash_store_backwards_runs"
+}
+
+// NewBHSBackwardsRunResource returns a new BHSBackwardsRunResource for run.
+func NewBHSBackwardsRunResource(run blockhashstore.BackwardsRun) BHSBackwardsRunResource {
+	return BHSBackwardsRunResource{
+		JAID:        NewJAIDInt64(run.ID),
+		JobID:       run.JobID,
+		StartBlock:  run.StartBlock,
+		TargetBlock: run.TargetBlock,
+		NextBlock:   run.NextBlock,
+		Remaining:   run.Remaining(),
+		State:       string(run.State),
+		Error:       run.Error.Ptr(),
+		CreatedAt:   run.CreatedAt,
+		UpdatedAt:   run.UpdatedAt,
+	}
+}
+
+// NewBHSBackwardsRunResources returns a slice of BHSBackwardsRunResources for
+// runs.
+func NewBHSBackwardsRunResources(runs []blockhashstore.BackwardsRun) []BHSBackwardsRunResource {
+	rs := []BHSBackwardsRunResource{}
+	for _, run := range runs {
+		rs = append(rs, NewBHSBackwardsRunResource(run))
+	}
+	return rs
+}
@@ -16,6 +16,7 @@ type BridgeResource struct {
 	// The IncomingToken is only provided when creating a Bridge
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
+	SendOutgoingToken      bool         `json:"sendOutgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
 	CreatedAt              time.Time    `json:"createdAt"`
 }
@@ -34,6 +35,7 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		URL:                    b.URL.String(),
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
+		SendOutgoingToken:      b.SendOutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
 		CreatedAt:              b.CreatedAt,
 	}
@@ -44,6 +44,7 @@ func TestBridgeResource(t *testing.T) {
 			"url":"https://bridge.example.com/api",
 			"confirmations":1,
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"sendOutgoingToken":false,
 			"minimumContractPayment":"1",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
@@ -69,6 +70,7 @@ func TestBridgeResource(t *testing.T) {
 			"confirmations":1,
 			"incomingToken": "cd+OfGXy3UHEDAlD0y27F6/rJE14X1UI",
 			"outgoingToken":"vjNL7X8Ea6GFJoa6PBsvK2ECzNK3b8IZ",
+			"sendOutgoingToken":false,
 			"minimumContractPayment":"1",
 			"createdAt":"2000-01-01T00:00:00Z"
 		}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -56,12 +57,35 @@ func (ei *ExternalInitiatorAuthentication) SetID(name string) error {
 
 type ExternalInitiatorResource struct {
 	JAID
-	Name          string         `json:"name"`
-	URL           *models.WebURL `json:"url"`
-	AccessKey     string         `json:"accessKey"`
-	OutgoingToken string         `json:"outgoingToken"`
-	CreatedAt     time.Time      `json:"createdAt"`
-	UpdatedAt     time.Time      `json:"updatedAt"`
+	Name          string                               `json:"name"`
+	URL           *models.WebURL                       `json:"url"`
+	AccessKey     string                               `json:"accessKey"`
+	OutgoingToken string                               `json:"outgoingToken"`
+	Notifications *ExternalInitiatorNotificationStatus `json:"notifications,omitempty"`
+	CreatedAt     time.Time                            `json:"createdAt"`
+	UpdatedAt     time.Time                            `json:"updatedAt"`
+}
+
+// ExternalInitiatorNotificationStatus summarizes the delivery of job
+// notifications to an external initiator.
+type ExternalInitiatorNotificationStatus struct {
+	Pending         int        `json:"pending"`
+	Delivered       int        `json:"delivered"`
+	LastAttemptAt   *time.Time `json:"lastAttemptAt"`
+	LastDeliveredAt *time.Time `json:"lastDeliveredAt"`
+	LastError       *string    `json:"lastError"`
+}
+
+// NewExternalInitiatorNotificationStatus generates an
+// ExternalInitiatorNotificationStatus from a webhook.NotificationStatus.
+func NewExternalInitiatorNotificationStatus(status webhook.NotificationStatus) *ExternalInitiatorNotificationStatus {
+	return &ExternalInitiatorNotificationStatus{
+		Pending:         status.Pending,
+		Delivered:       status.Delivered,
+		LastAttemptAt:   status.LastAttemptAt.Ptr(),
+		LastDeliveredAt: status.LastDeliveredAt.Ptr(),
+		LastError:       status.LastError.Ptr(),
+	}
 }
 
 func NewExternalInitiatorResource(ei bridges.ExternalInitiator) ExternalInitiatorResource {
@@ -41,15 +41,20 @@ const (
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
 type DirectRequestSpec struct {
-	ContractAddress             ethkey.EIP55Address      `json:"contractAddress"`
-	MinIncomingConfirmations    clnull.Uint32            `json:"minIncomingConfirmations"`
-	MinIncomingConfirmationsEnv bool                     `json:"minIncomingConfirmationsEnv,omitempty"`
-	MinContractPayment          *assets.Link             `json:"minContractPaymentLinkJuels"`
-	Requesters                  models.AddressCollection `json:"requesters"`
-	Initiator                   string                   `json:"initiator"`
-	CreatedAt                   time.Time                `json:"createdAt"`
-	UpdatedAt                   time.Time                `json:"updatedAt"`
-	EVMChainID                  *utils.Big               `json:"evmChainID"`
+	ContractAddress             ethkey.EIP55Address             `json:"contractAddress"`
+	MinIncomingConfirmations    clnull.Uint32                   `json:"minIncomingConfirmations"`
+	MinIncomingConfirmationsEnv bool                            `json:"minIncomingConfirmationsEnv,omitempty"`
+	MinContractPayment          *assets.Link                    `json:"minContractPaymentLinkJuels"`
+	MinContractPaymentPerWord   *assets.Link                    `json:"minContractPaymentPerWordLinkJuels"`
+	ResponseShape               string                          `json:"responseShape"`
+	ResponseWords               uint32                          `json:"responseWords"`
+	CallbackGasLimit            uint32                          `json:"callbackGasLimit"`
+	Requesters                  models.AddressCollection        `json:"requesters"`
+	RequesterRules              job.DirectRequestRequesterRules `json:"requesterRules,omitempty"`
+	Initiator                   string                          `json:"initiator"`
+	CreatedAt                   time.Time                       `json:"createdAt"`
+	UpdatedAt                   time.Time                       `json:"updatedAt"`
+	EVMChainID                  *utils.Big                      `json:"evmChainID"`
 }
 
 // NewDirectRequestSpec initializes a new DirectRequestSpec from a
@@ -60,7 +65,12 @@ func NewDirectRequestSpec(spec *job.DirectRequestSpec) *DirectRequestSpec {
 		MinIncomingConfirmations:    spec.MinIncomingConfirmations,
 		MinIncomingConfirmationsEnv: spec.MinIncomingConfirmationsEnv,
 		MinContractPayment:          spec.MinContractPayment,
+		MinContractPaymentPerWord:   spec.MinContractPaymentPerWord,
+		ResponseShape:               string(spec.ResponseShape),
+		ResponseWords:               spec.ResponseWords,
+		CallbackGasLimit:            spec.CallbackGasLimit,
 		Requesters:                  spec.Requesters,
+		RequesterRules:              spec.RequesterRules,
 		// This is hardcoded to runlog. When we support other initiators, we need
 		// to change this
 		Initiator:  "runlog",
@@ -86,6 +96,8 @@ type FluxMonitorSpec struct {
 	CreatedAt           time.Time           `json:"createdAt"`
 	UpdatedAt           time.Time           `json:"updatedAt"`
 	EVMChainID          *utils.Big          `json:"evmChainID"`
+	// Aggregators is only set for multi-aggregator jobs
+	Aggregators job.FluxMonitorAggregators `json:"aggregators,omitempty"`
 }
 
 // NewFluxMonitorSpec initializes a new DirectFluxMonitorSpec from a
@@ -115,6 +127,7 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		CreatedAt:           spec.CreatedAt,
 		UpdatedAt:           spec.UpdatedAt,
 		EVMChainID:          spec.EVMChainID,
+		Aggregators:         spec.Aggregators,
 	}
 }
 
@@ -144,6 +157,7 @@ type OffChainReportingSpec struct {
 	ObservationGracePeriodEnv                 bool                 `json:"observationGracePeriodEnv,omitempty"`
 	ContractTransmitterTransmitTimeout        *models.Interval     `json:"contractTransmitterTransmitTimeout"`
 	ContractTransmitterTransmitTimeoutEnv     bool                 `json:"contractTransmitterTransmitTimeoutEnv,omitempty"`
+	ShadowObservationSource                   string               `json:"shadowObservationSource,omitempty"`
 }
 
 // NewOffChainReportingSpec initializes a new OffChainReportingSpec from a
@@ -174,6 +188,7 @@ func NewOffChainReportingSpec(spec *job.OffchainReportingOracleSpec) *OffChainRe
 		ObservationGracePeriodEnv:                 spec.ObservationGracePeriodEnv,
 		ContractTransmitterTransmitTimeout:        spec.ContractTransmitterTransmitTimeout,
 		ContractTransmitterTransmitTimeoutEnv:     spec.ContractTransmitterTransmitTimeoutEnv,
+		ShadowObservationSource:                   spec.ShadowObservationSource,
 	}
 }
 
@@ -182,6 +197,8 @@ type OffChainReporting2Spec struct {
 	ContractID                        string                 `json:"contractID"`
 	Relay                             types.Network          `json:"relay"`
 	RelayConfig                       map[string]interface{} `json:"relayConfig"`
+	PluginType                        job.OCR2PluginType     `json:"pluginType"`
+	PluginConfig                      map[string]interface{} `json:"pluginConfig"`
 	P2PBootstrapPeers                 pq.StringArray         `json:"p2pBootstrapPeers"`
 	OCRKeyBundleID                    null.String            `json:"ocrKeyBundleID"`
 	TransmitterID                     null.String            `json:"transmitterID"`
@@ -189,6 +206,7 @@ type OffChainReporting2Spec struct {
 	BlockchainTimeout                 models.Interval        `json:"blockchainTimeout"`
 	ContractConfigTrackerPollInterval models.Interval        `json:"contractConfigTrackerPollInterval"`
 	ContractConfigConfirmations       uint16                 `json:"contractConfigConfirmations"`
+	ShadowObservationSource           string                 `json:"shadowObservationSource,omitempty"`
 	CreatedAt                         time.Time              `json:"createdAt"`
 	UpdatedAt                         time.Time              `json:"updatedAt"`
 }
@@ -200,12 +218,15 @@ func NewOffChainReporting2Spec(spec *job.OffchainReporting2OracleSpec) *OffChain
 		ContractID:                        spec.ContractID,
 		Relay:                             spec.Relay,
 		RelayConfig:                       spec.RelayConfig,
+		PluginType:                        spec.PluginType,
+		PluginConfig:                      spec.PluginConfig,
 		P2PBootstrapPeers:                 spec.P2PBootstrapPeers,
 		OCRKeyBundleID:                    spec.OCRKeyBundleID,
 		TransmitterID:                     spec.TransmitterID,
 		BlockchainTimeout:                 spec.BlockchainTimeout,
 		ContractConfigTrackerPollInterval: spec.ContractConfigTrackerPollInterval,
 		ContractConfigConfirmations:       spec.ContractConfigConfirmations,
+		ShadowObservationSource:           spec.ShadowObservationSource,
 		CreatedAt:                         spec.CreatedAt,
 		UpdatedAt:                         spec.UpdatedAt,
 	}
@@ -229,75 +250,97 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 
 // KeeperSpec defines the spec details of a Keeper Job
 type KeeperSpec struct {
-	ContractAddress ethkey.EIP55Address `json:"contractAddress"`
-	FromAddress     ethkey.EIP55Address `json:"fromAddress"`
-	CreatedAt       time.Time           `json:"createdAt"`
-	UpdatedAt       time.Time           `json:"updatedAt"`
-	EVMChainID      *utils.Big          `json:"evmChainID"`
+	ContractAddress  ethkey.EIP55Address     `json:"contractAddress"`
+	FromAddress      ethkey.EIP55Address     `json:"fromAddress"`
+	CreatedAt        time.Time               `json:"createdAt"`
+	UpdatedAt        time.Time               `json:"updatedAt"`
+	EVMChainID       *utils.Big              `json:"evmChainID"`
+	MaxGasPrice      *utils.Big              `json:"maxGasPrice,omitempty"`
+	PriorityTiers    job.KeeperPriorityTiers `json:"priorityTiers,omitempty"`
+	AllowedUpkeepIDs []int64                 `json:"allowedUpkeepIDs,omitempty"`
+	DeniedUpkeepIDs  []int64                 `json:"deniedUpkeepIDs,omitempty"`
 }
 
 // NewKeeperSpec generates a new KeeperSpec from a job.KeeperSpec
 func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 	return &KeeperSpec{
-		ContractAddress: spec.ContractAddress,
-		FromAddress:     spec.FromAddress,
-		CreatedAt:       spec.CreatedAt,
-		UpdatedAt:       spec.UpdatedAt,
-		EVMChainID:      spec.EVMChainID,
+		ContractAddress:  spec.ContractAddress,
+		FromAddress:      spec.FromAddress,
+		CreatedAt:        spec.CreatedAt,
+		UpdatedAt:        spec.UpdatedAt,
+		EVMChainID:       spec.EVMChainID,
+		MaxGasPrice:      spec.MaxGasPrice,
+		PriorityTiers:    spec.PriorityTiers,
+		AllowedUpkeepIDs: spec.AllowedUpkeepIDs,
+		DeniedUpkeepIDs:  spec.DeniedUpkeepIDs,
 	}
 }
 
 // WebhookSpec defines the spec details of a Webhook Job
 type WebhookSpec struct {
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	RequestSchema *string   `json:"requestSchema,omitempty"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
 }
 
 // NewWebhookSpec generates a new WebhookSpec from a job.WebhookSpec
 func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 	return &WebhookSpec{
-		CreatedAt: spec.CreatedAt,
-		UpdatedAt: spec.UpdatedAt,
+		RequestSchema: spec.RequestSchema.Ptr(),
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
 	}
 }
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule  string                `json:"schedule" tom:"schedule"`
+	Timezone      string                `json:"timezone,omitempty"`
+	Jitter        models.Interval       `json:"jitter,omitempty"`
+	OverlapPolicy job.CronOverlapPolicy `json:"overlapPolicy,omitempty"`
+	CreatedAt     time.Time             `json:"createdAt"`
+	UpdatedAt     time.Time             `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:  spec.CronSchedule,
+		Timezone:      spec.Timezone,
+		Jitter:        spec.Jitter,
+		OverlapPolicy: spec.OverlapPolicy,
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
 	}
 }
 
 type VRFSpec struct {
-	CoordinatorAddress       ethkey.EIP55Address  `json:"coordinatorAddress"`
-	PublicKey                secp256k1.PublicKey  `json:"publicKey"`
-	FromAddress              *ethkey.EIP55Address `json:"fromAddress"`
-	PollPeriod               models.Duration      `json:"pollPeriod"`
-	MinIncomingConfirmations uint32               `json:"confirmations"`
-	CreatedAt                time.Time            `json:"createdAt"`
-	UpdatedAt                time.Time            `json:"updatedAt"`
-	EVMChainID               *utils.Big           `json:"evmChainID"`
+	CoordinatorAddress        ethkey.EIP55Address  `json:"coordinatorAddress"`
+	PublicKey                 secp256k1.PublicKey  `json:"publicKey"`
+	FromAddress               *ethkey.EIP55Address `json:"fromAddress"`
+	PollPeriod                models.Duration      `json:"pollPeriod"`
+	MinIncomingConfirmations  uint32               `json:"confirmations"`
+	BatchFulfillmentEnabled   bool                 `json:"batchFulfillmentEnabled"`
+	BatchCoordinatorAddress   *ethkey.EIP55Address `json:"batchCoordinatorAddress,omitempty"`
+	BatchFulfillmentGasBudget uint64               `json:"batchFulfillmentGasBudget,omitempty"`
+	CreatedAt                 time.Time            `json:"createdAt"`
+	UpdatedAt                 time.Time            `json:"updatedAt"`
+	EVMChainID                *utils.Big           `json:"evmChainID"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 	return &VRFSpec{
-		CoordinatorAddress:       spec.CoordinatorAddress,
-		PublicKey:                spec.PublicKey,
-		FromAddress:              spec.FromAddress,
-		PollPeriod:               models.MustMakeDuration(spec.PollPeriod),
-		MinIncomingConfirmations: spec.MinIncomingConfirmations,
-		CreatedAt:                spec.CreatedAt,
-		UpdatedAt:                spec.UpdatedAt,
-		EVMChainID:               spec.EVMChainID,
+		CoordinatorAddress:        spec.CoordinatorAddress,
+		PublicKey:                 spec.PublicKey,
+		FromAddress:               spec.FromAddress,
+		PollPeriod:                models.MustMakeDuration(spec.PollPeriod),
+		MinIncomingConfirmations:  spec.MinIncomingConfirmations,
+		BatchFulfillmentEnabled:   spec.BatchFulfillmentEnabled,
+		BatchCoordinatorAddress:   spec.BatchCoordinatorAddress,
+		BatchFulfillmentGasBudget: spec.BatchFulfillmentGasBudget,
+		CreatedAt:                 spec.CreatedAt,
+		UpdatedAt:                 spec.UpdatedAt,
+		EVMChainID:                spec.EVMChainID,
 	}
 }
 
@@ -90,6 +90,10 @@ func TestJob(t *testing.T) {
 							"contractAddress": "%s",
 							"minIncomingConfirmations": null,
 							"minContractPaymentLinkJuels": null,
+							"minContractPaymentPerWordLinkJuels": null,
+							"responseShape": "",
+							"responseWords": 0,
+							"callbackGasLimit": 0,
 							"requesters": null,
 							"initiator": "runlog",
 							"createdAt":"2000-01-01T00:00:00Z",
@@ -326,9 +330,12 @@ func TestJob(t *testing.T) {
 			job: job.Job{
 				ID: 1,
 				CronSpec: &job.CronSpec{
-					CronSchedule: cronSchedule,
-					CreatedAt:    timestamp,
-					UpdatedAt:    timestamp,
+					CronSchedule:  cronSchedule,
+					Timezone:      "Europe/London",
+					Jitter:        models.Interval(30 * time.Second),
+					OverlapPolicy: job.CronOverlapSkip,
+					CreatedAt:     timestamp,
+					UpdatedAt:     timestamp,
 				},
 				ExternalJobID: uuid.FromStringOrNil("0EEC7E1D-D0D2-476C-A1A8-72DFB6633F46"),
 				PipelineSpec: &pipeline.Spec{
@@ -358,6 +365,9 @@ func TestJob(t *testing.T) {
                         },
                         "cronSpec": {
                             "schedule": "%s",
+                            "timezone": "Europe/London",
+                            "jitter": "30s",
+                            "overlapPolicy": "skip",
                             "createdAt":"2000-01-01T00:00:00Z",
                             "updatedAt":"2000-01-01T00:00:00Z"
                         },
@@ -0,0 +1,61 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/ocrcommon"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// ShadowComparison is the outcome of one shadow pipeline observation.
+type ShadowComparison struct {
+	Timestamp        time.Time  `json:"timestamp"`
+	Live             *utils.Big `json:"live"`
+	Shadow           *utils.Big `json:"shadow"`
+	LiveError        string     `json:"liveError,omitempty"`
+	ShadowError      string     `json:"shadowError,omitempty"`
+	DeviationPercent *float64   `json:"deviationPercent"`
+}
+
+// ShadowStatsResource is a JSONAPI resource of the shadow pipeline stats of
+// an OCR job.
+type ShadowStatsResource struct {
+	JAID
+	Observations         uint64             `json:"observations"`
+	Compared             uint64             `json:"compared"`
+	LiveErrors           uint64             `json:"liveErrors"`
+	ShadowErrors         uint64             `json:"shadowErrors"`
+	MeanDeviationPercent float64            `json:"meanDeviationPercent"`
+	MaxDeviationPercent  float64            `json:"maxDeviationPercent"`
+	Recent               []ShadowComparison `json:"recent"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r ShadowStatsResource) GetName() string {
+	return "shadow_observations"
+}
+
+// NewShadowStatsResource returns a new ShadowStatsResource for stats.
+func NewShadowStatsResource(stats ocrcommon.ShadowStats) ShadowStatsResource {
+	recent := make([]ShadowComparison, 0, len(stats.Recent))
+	for _, c := range stats.Recent {
+		recent = append(recent, ShadowComparison{
+			Timestamp:        c.Timestamp,
+			Live:             utils.NewBig(c.Live),
+			Shadow:           utils.NewBig(c.Shadow),
+			LiveError:        c.LiveError,
+			ShadowError:      c.ShadowError,
+			DeviationPercent: c.DeviationPercent,
+		})
+	}
+	return ShadowStatsResource{
+		JAID:                 NewJAIDInt32(stats.JobID),
+		Observations:         stats.Observations,
+		Compared:             stats.Compared,
+		LiveErrors:           stats.LiveErrors,
+		ShadowErrors:         stats.ShadowErrors,
+		MeanDeviationPercent: stats.MeanDeviationPercent,
+		MaxDeviationPercent:  stats.MaxDeviationPercent,
+		Recent:               recent,
+	}
+}
@@ -101,3 +101,57 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineRunReplayResource compares a pipeline run with its replay.
+type PipelineRunReplayResource struct {
+	JAID
+	CurrentSpec    bool                          `json:"currentSpec"`
+	OutputsChanged bool                          `json:"outputsChanged"`
+	Original       PipelineRunResource           `json:"original"`
+	Replayed       PipelineRunResource           `json:"replayed"`
+	TaskRuns       []PipelineTaskRunDiffResource `json:"taskRuns"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineRunReplayResource) GetName() string {
+	return "pipelineRunReplay"
+}
+
+// PipelineTaskRunDiffResource compares the result of a task in a pipeline run
+// with its result in the replay.
+type PipelineTaskRunDiffResource struct {
+	DotID    string                   `json:"dotId"`
+	Type     pipeline.TaskType        `json:"type"`
+	Original *PipelineTaskRunResource `json:"original"`
+	Replayed *PipelineTaskRunResource `json:"replayed"`
+	Changed  bool                     `json:"changed"`
+}
+
+func NewPipelineRunReplayResource(replay pipeline.Replay, lggr logger.Logger) PipelineRunReplayResource {
+	var trs []PipelineTaskRunDiffResource
+	for _, diff := range replay.TaskRuns {
+		tr := PipelineTaskRunDiffResource{
+			DotID:   diff.DotID,
+			Type:    diff.Type,
+			Changed: diff.Changed,
+		}
+		if diff.Original != nil {
+			r := NewPipelineTaskRunResource(*diff.Original)
+			tr.Original = &r
+		}
+		if diff.Replayed != nil {
+			r := NewPipelineTaskRunResource(*diff.Replayed)
+			tr.Replayed = &r
+		}
+		trs = append(trs, tr)
+	}
+
+	return PipelineRunReplayResource{
+		JAID:           NewJAIDInt64(replay.Original.ID),
+		CurrentSpec:    replay.CurrentSpec,
+		OutputsChanged: replay.OutputsChanged,
+		Original:       NewPipelineRunResource(replay.Original, lggr),
+		Replayed:       NewPipelineRunResource(replay.Replayed, lggr),
+		TaskRuns:       trs,
+	}
+}
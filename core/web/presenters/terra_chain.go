@@ -3,7 +3,11 @@ package presenters
 import (
 	"time"
 
+	"gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink-terra/pkg/terra/db"
+
+	"github.com/smartcontractkit/chainlink/core/chains/terra/terratxm"
 )
 
 // TerraChainResource is an Terra chain JSONAPI resource.
@@ -57,3 +61,36 @@ func NewTerraNodeResource(node db.Node) TerraNodeResource {
 		UpdatedAt:     node.UpdatedAt,
 	}
 }
+
+// TerraMsgResource is a Terra msg JSONAPI resource.
+type TerraMsgResource struct {
+	JAID
+	TerraChainID   string      `json:"terraChainID"`
+	ContractID     string      `json:"contractID"`
+	State          string      `json:"state"`
+	TxHash         *string     `json:"txHash"`
+	BroadcastCount int         `json:"broadcastCount"`
+	GasPrice       null.String `json:"gasPrice"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r TerraMsgResource) GetName() string {
+	return "terra_msg"
+}
+
+// NewTerraMsgResource returns a new TerraMsgResource for msg.
+func NewTerraMsgResource(msg terratxm.QueuedMsg) TerraMsgResource {
+	return TerraMsgResource{
+		JAID:           NewJAIDInt64(msg.ID),
+		TerraChainID:   msg.ChainID,
+		ContractID:     msg.ContractID,
+		State:          string(msg.State),
+		TxHash:         msg.TxHash,
+		BroadcastCount: msg.BroadcastCount,
+		GasPrice:       msg.GasPrice,
+		CreatedAt:      msg.CreatedAt,
+		UpdatedAt:      msg.UpdatedAt,
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
-// Authenticates the user from the session cookie.
+// Authenticates the user from the session cookie or API token.
 func authenticateUser(ctx context.Context) error {
 	_, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
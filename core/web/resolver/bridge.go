@@ -49,6 +49,11 @@ func (r *BridgeResolver) OutgoingToken() string {
 	return r.bridge.OutgoingToken
 }
 
+// SendOutgoingToken resolves whether the bridge is sent its outgoing token.
+func (r *BridgeResolver) SendOutgoingToken() bool {
+	return r.bridge.SendOutgoingToken
+}
+
 // MinimumContractPayment resolves the bridge's minimum contract payment.
 func (r *BridgeResolver) MinimumContractPayment() string {
 	return r.bridge.MinimumContractPayment.String()
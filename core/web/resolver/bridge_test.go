@@ -12,6 +12,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -421,6 +422,10 @@ func Test_DeleteBridgeMutation(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobIDsWithBridge", name.String()).Return([]int32{}, nil)
 				f.App.On("JobORM").Return(f.Mocks.jobORM)
 				f.App.On("BridgeORM").Return(f.Mocks.bridgeORM)
+				runner := &pipelineMocks.Runner{}
+				t.Cleanup(func() { runner.AssertExpectations(t) })
+				runner.On("ForgetBridge", name.String()).Once()
+				f.App.On("PipelineRunner").Return(runner)
 			},
 			query:     mutation,
 			variables: variables,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/secrets"
 	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
 )
 
@@ -85,6 +86,11 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 
 		return errors.New("MinimumContractPayment must be positive")
 	}
+	if secrets.IsRef(bt.OutgoingToken) {
+		if err := secrets.CheckBridgeRef(bt.OutgoingToken); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
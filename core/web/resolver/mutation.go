@@ -53,6 +53,8 @@ type createBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	OutgoingToken          *string
+	SendOutgoingToken      *bool
 }
 
 // CreateBridge creates a new bridge.
@@ -80,6 +82,12 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
+	if args.Input.OutgoingToken != nil {
+		btr.OutgoingToken = *args.Input.OutgoingToken
+	}
+	if args.Input.SendOutgoingToken != nil {
+		btr.SendOutgoingToken = *args.Input.SendOutgoingToken
+	}
 
 	bta, bt, err := bridges.NewBridgeType(btr)
 	if err != nil {
@@ -200,6 +208,8 @@ type updateBridgeInput struct {
 	URL                    string
 	Confirmations          int32
 	MinimumContractPayment string
+	OutgoingToken          *string
+	SendOutgoingToken      *bool
 }
 
 func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
@@ -229,6 +239,12 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 		Confirmations:          uint32(args.Input.Confirmations),
 		MinimumContractPayment: minContractPayment,
 	}
+	if args.Input.OutgoingToken != nil {
+		btr.OutgoingToken = *args.Input.OutgoingToken
+	}
+	if args.Input.SendOutgoingToken != nil {
+		btr.SendOutgoingToken = *args.Input.SendOutgoingToken
+	}
 
 	taskType, err := bridges.NewTaskType(string(args.ID))
 	if err != nil {
@@ -439,6 +455,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	if err = orm.DeleteBridgeType(&bt); err != nil {
 		return nil, err
 	}
+	r.App.PipelineRunner().ForgetBridge(bt.Name.String())
 
 	return NewDeleteBridgePayload(&bt, nil), nil
 }
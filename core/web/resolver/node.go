@@ -5,6 +5,7 @@ import (
 
 	"github.com/graph-gophers/graphql-go"
 
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 )
@@ -170,3 +171,62 @@ func NewDeleteNodeSuccessResolver(node *types.Node) *DeleteNodeSuccessResolver {
 func (r *DeleteNodeSuccessResolver) Node() *NodeResolver {
 	return NewNode(*r.node)
 }
+
+// -- NodeStateChanged Subscription --
+
+type NodeState string
+
+const (
+	NodeStateUndialed       NodeState = "UNDIALED"
+	NodeStateDialed         NodeState = "DIALED"
+	NodeStateInvalidChainID NodeState = "INVALID_CHAIN_ID"
+	NodeStateAlive          NodeState = "ALIVE"
+	NodeStateDead           NodeState = "DEAD"
+	NodeStateClosed         NodeState = "CLOSED"
+)
+
+func NewNodeState(state evmclient.NodeState) NodeState {
+	switch state {
+	case evmclient.NodeStateDialed:
+		return NodeStateDialed
+	case evmclient.NodeStateInvalidChainID:
+		return NodeStateInvalidChainID
+	case evmclient.NodeStateAlive:
+		return NodeStateAlive
+	case evmclient.NodeStateDead:
+		return NodeStateDead
+	case evmclient.NodeStateClosed:
+		return NodeStateClosed
+	default:
+		return NodeStateUndialed
+	}
+}
+
+// NodeStateChangeResolver resolves the NodeStateChange type.
+type NodeStateChangeResolver struct {
+	change evmclient.NodeStateChange
+}
+
+func NewNodeStateChange(change evmclient.NodeStateChange) *NodeStateChangeResolver {
+	return &NodeStateChangeResolver{change: change}
+}
+
+// Name resolves the name of the node which changed state.
+func (r *NodeStateChangeResolver) Name() string {
+	return r.change.Name
+}
+
+// State resolves the state the node changed to.
+func (r *NodeStateChangeResolver) State() NodeState {
+	return NewNodeState(r.change.To)
+}
+
+// PreviousState resolves the state the node changed from.
+func (r *NodeStateChangeResolver) PreviousState() NodeState {
+	return NewNodeState(r.change.From)
+}
+
+// ChangedAt resolves the time of the state change.
+func (r *NodeStateChangeResolver) ChangedAt() graphql.Time {
+	return graphql.Time{Time: r.change.At}
+}
@@ -107,6 +107,21 @@ func (r *CronSpecResolver) Schedule() string {
 	return r.spec.CronSchedule
 }
 
+// Timezone resolves the spec's timezone.
+func (r *CronSpecResolver) Timezone() string {
+	return r.spec.Timezone
+}
+
+// Jitter resolves the spec's jitter.
+func (r *CronSpecResolver) Jitter() string {
+	return r.spec.Jitter.Duration().String()
+}
+
+// OverlapPolicy resolves the spec's overlap policy.
+func (r *CronSpecResolver) OverlapPolicy() string {
+	return string(r.spec.OverlapPolicy)
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *CronSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -167,6 +182,33 @@ func (r *DirectRequestSpecResolver) Requesters() *[]string {
 	return &requesters
 }
 
+// MinContractPaymentPerWord resolves the spec's min contract payment per
+// response word.
+func (r *DirectRequestSpecResolver) MinContractPaymentPerWord() *string {
+	if r.spec.MinContractPaymentPerWord == nil {
+		return nil
+	}
+
+	payment := r.spec.MinContractPaymentPerWord.String()
+
+	return &payment
+}
+
+// ResponseShape resolves the spec's response shape.
+func (r *DirectRequestSpecResolver) ResponseShape() string {
+	return string(r.spec.ResponseShape)
+}
+
+// ResponseWords resolves the spec's number of response words.
+func (r *DirectRequestSpecResolver) ResponseWords() int32 {
+	return int32(r.spec.ResponseWords)
+}
+
+// CallbackGasLimit resolves the spec's callback gas limit.
+func (r *DirectRequestSpecResolver) CallbackGasLimit() int32 {
+	return int32(r.spec.CallbackGasLimit)
+}
+
 type FluxMonitorSpecResolver struct {
 	spec job.FluxMonitorSpec
 }
@@ -467,6 +509,15 @@ func (r *OCRSpecResolver) P2PBootstrapPeers() *[]string {
 	return &peers
 }
 
+// ShadowObservationSource resolves the spec's shadow observation source
+func (r *OCRSpecResolver) ShadowObservationSource() *string {
+	if r.spec.ShadowObservationSource == "" {
+		return nil
+	}
+
+	return &r.spec.ShadowObservationSource
+}
+
 // TransmitterAddress resolves the spec's transmitter address
 func (r *OCRSpecResolver) TransmitterAddress() *string {
 	if r.spec.TransmitterAddress == nil {
@@ -563,6 +614,16 @@ func (r *OCR2SpecResolver) P2PBootstrapPeers() *[]string {
 	return &peers
 }
 
+// PluginType resolves the spec's reporting plugin type
+func (r *OCR2SpecResolver) PluginType() string {
+	return string(r.spec.PluginType)
+}
+
+// PluginConfig resolves the spec's reporting plugin config
+func (r *OCR2SpecResolver) PluginConfig() gqlscalar.Map {
+	return gqlscalar.Map(r.spec.PluginConfig)
+}
+
 // Relay resolves the spec's relay
 func (r *OCR2SpecResolver) Relay() string {
 	return string(r.spec.Relay)
@@ -573,6 +634,15 @@ func (r *OCR2SpecResolver) RelayConfig() gqlscalar.Map {
 	return gqlscalar.Map(r.spec.RelayConfig)
 }
 
+// ShadowObservationSource resolves the spec's shadow observation source
+func (r *OCR2SpecResolver) ShadowObservationSource() *string {
+	if r.spec.ShadowObservationSource == "" {
+		return nil
+	}
+
+	return &r.spec.ShadowObservationSource
+}
+
 // TransmitterAddress resolves the spec's transmitter id
 func (r *OCR2SpecResolver) TransmitterID() *string {
 	if !r.spec.TransmitterID.Valid {
@@ -597,6 +667,26 @@ func (r *VRFSpecResolver) MinIncomingConfirmationsEnv() bool {
 	return r.spec.ConfirmationsEnv
 }
 
+// BatchCoordinatorAddress resolves the spec's batch coordinator address.
+func (r *VRFSpecResolver) BatchCoordinatorAddress() *string {
+	if r.spec.BatchCoordinatorAddress == nil {
+		return nil
+	}
+
+	addr := r.spec.BatchCoordinatorAddress.String()
+	return &addr
+}
+
+// BatchFulfillmentEnabled resolves the spec's batch fulfillment enabled flag.
+func (r *VRFSpecResolver) BatchFulfillmentEnabled() bool {
+	return r.spec.BatchFulfillmentEnabled
+}
+
+// BatchFulfillmentGasBudget resolves the spec's batch fulfillment gas budget.
+func (r *VRFSpecResolver) BatchFulfillmentGasBudget() int32 {
+	return int32(r.spec.BatchFulfillmentGasBudget)
+}
+
 // CoordinatorAddress resolves the spec's coordinator address.
 func (r *VRFSpecResolver) CoordinatorAddress() string {
 	return r.spec.CoordinatorAddress.String()
@@ -653,6 +743,11 @@ type WebhookSpecResolver struct {
 	spec job.WebhookSpec
 }
 
+// RequestSchema resolves the spec's request schema.
+func (r *WebhookSpecResolver) RequestSchema() *string {
+	return r.spec.RequestSchema.Ptr()
+}
+
 // CreatedAt resolves the spec's created at timestamp.
 func (r *WebhookSpecResolver) CreatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.CreatedAt}
@@ -37,8 +37,10 @@ func TestResolver_CronSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
 					Type: job.Cron,
 					CronSpec: &job.CronSpec{
-						CronSchedule: "CRON_TZ=UTC 0 0 1 1 *",
-						CreatedAt:    f.Timestamp(),
+						CronSchedule:  "CRON_TZ=UTC 0 0 1 1 *",
+						Jitter:        models.Interval(10 * time.Second),
+						OverlapPolicy: job.CronOverlapQueueOne,
+						CreatedAt:     f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -50,6 +52,9 @@ func TestResolver_CronSpec(t *testing.T) {
 								__typename
 								... on CronSpec {
 									schedule
+									timezone
+									jitter
+									overlapPolicy
 									createdAt
 								}
 							}
@@ -63,6 +68,9 @@ func TestResolver_CronSpec(t *testing.T) {
 						"spec": {
 							"__typename": "CronSpec",
 							"schedule": "CRON_TZ=UTC 0 0 1 1 *",
+							"timezone": "",
+							"jitter": "10s",
+							"overlapPolicy": "queueOne",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
@@ -662,7 +670,8 @@ func TestResolver_WebhookSpec(t *testing.T) {
 				f.Mocks.jobORM.On("FindJobTx", id).Return(job.Job{
 					Type: job.Webhook,
 					WebhookSpec: &job.WebhookSpec{
-						CreatedAt: f.Timestamp(),
+						RequestSchema: null.StringFrom(`{"type":"object"}`),
+						CreatedAt:     f.Timestamp(),
 					},
 				}, nil)
 			},
@@ -673,6 +682,7 @@ func TestResolver_WebhookSpec(t *testing.T) {
 							spec {
 								__typename
 								... on WebhookSpec {
+									requestSchema
 									createdAt
 								}
 							}
@@ -685,6 +695,7 @@ func TestResolver_WebhookSpec(t *testing.T) {
 					"job": {
 						"spec": {
 							"__typename": "WebhookSpec",
+							"requestSchema": "{\"type\":\"object\"}",
 							"createdAt": "2021-01-01T00:00:00Z"
 						}
 					}
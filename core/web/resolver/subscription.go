@@ -0,0 +1,241 @@
+package resolver
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	evmclient "github.com/smartcontractkit/chainlink/core/chains/evm/client"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils/stringutils"
+)
+
+// subscriptionBufferSize is the number of events buffered for each subscriber
+// fed by an in-process hook. Hooks must not block, so events are dropped when
+// a subscriber falls this far behind.
+const subscriptionBufferSize = 100
+
+// JobRunCreated streams pipeline runs as they are inserted into the database,
+// optionally restricted to a single job.
+func (r *Resolver) JobRunCreated(ctx context.Context, args struct{ JobID *graphql.ID }) (<-chan *JobRunResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := optionalJobID(args.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := r.notifiedIDs(ctx, pg.ChannelInsertOnPipelineRun)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *JobRunResolver)
+	go func() {
+		defer close(out)
+		for id := range ids {
+			run, err := r.App.JobORM().FindPipelineRunByID(id)
+			if err != nil {
+				r.App.GetLogger().Errorw("GraphQL subscription failed to load pipeline run", "runID", id, "err", err)
+				continue
+			}
+			if jobID != nil && run.PipelineSpec.JobID != *jobID {
+				continue
+			}
+
+			select {
+			case out <- NewJobRun(run, r.App):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// JobRunFinished streams pipeline runs as they finish, optionally restricted
+// to a single job.
+func (r *Resolver) JobRunFinished(ctx context.Context, args struct{ JobID *graphql.ID }) (<-chan *JobRunResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := optionalJobID(args.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *JobRunResolver, subscriptionBufferSize)
+	unregister := r.App.PipelineRunner().OnRunFinished(func(run *pipeline.Run) {
+		// Suspended runs are persisted too, but have not finished yet
+		if run.Pending {
+			return
+		}
+		if jobID != nil && run.PipelineSpec.JobID != *jobID {
+			return
+		}
+
+		select {
+		case out <- NewJobRun(*run, r.App):
+		default:
+			r.App.GetLogger().Warnw("GraphQL subscriber is too slow, dropping finished pipeline run", "runID", run.ID)
+		}
+	})
+	go func() {
+		<-ctx.Done()
+		unregister()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+// EthTransactionStateChanged streams Ethereum transactions as they are created
+// and each time their state changes.
+func (r *Resolver) EthTransactionStateChanged(ctx context.Context) (<-chan *EthTransactionResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	ids, err := r.notifiedIDs(ctx, pg.ChannelEthTxStateChange)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *EthTransactionResolver)
+	go func() {
+		defer close(out)
+		for id := range ids {
+			etx, err := r.App.BPTXMORM().FindEthTxWithAttempts(id)
+			if err != nil {
+				r.App.GetLogger().Errorw("GraphQL subscription failed to load eth tx", "ethTxID", id, "err", err)
+				continue
+			}
+
+			select {
+			case out <- NewEthTransaction(etx):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// JobErrorRecorded streams job errors each time they occur, optionally
+// restricted to a single job.
+func (r *Resolver) JobErrorRecorded(ctx context.Context, args struct{ JobID *graphql.ID }) (<-chan *JobErrorResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	jobID, err := optionalJobID(args.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := r.notifiedIDs(ctx, pg.ChannelUpsertOnJobSpecError)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan *JobErrorResolver)
+	go func() {
+		defer close(out)
+		for id := range ids {
+			specErr, err := r.App.JobORM().FindSpecError(id)
+			if err != nil {
+				r.App.GetLogger().Errorw("GraphQL subscription failed to load job error", "jobErrorID", id, "err", err)
+				continue
+			}
+			if jobID != nil && specErr.JobID != *jobID {
+				continue
+			}
+
+			select {
+			case out <- NewJobError(specErr):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// NodeStateChanged streams the state transitions of EVM nodes.
+func (r *Resolver) NodeStateChanged(ctx context.Context) (<-chan *NodeStateChangeResolver, error) {
+	if err := authenticateUser(ctx); err != nil {
+		return nil, err
+	}
+
+	out := make(chan *NodeStateChangeResolver, subscriptionBufferSize)
+	unregister := evmclient.OnNodeStateChange(func(change evmclient.NodeStateChange) {
+		select {
+		case out <- NewNodeStateChange(change):
+		default:
+			r.App.GetLogger().Warnw("GraphQL subscriber is too slow, dropping node state change", "node", change.Name)
+		}
+	})
+	go func() {
+		<-ctx.Done()
+		unregister()
+		close(out)
+	}()
+
+	return out, nil
+}
+
+// notifiedIDs subscribes to a Postgres channel whose payloads are row IDs and
+// forwards them until ctx is done.
+func (r *Resolver) notifiedIDs(ctx context.Context, channel string) (<-chan int64, error) {
+	sub, err := r.App.GetEventBroadcaster().Subscribe(channel, "")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(chan int64)
+	go func() {
+		defer close(ids)
+		defer sub.Close()
+		for {
+			select {
+			case event := <-sub.Events():
+				id, err := strconv.ParseInt(event.Payload, 10, 64)
+				if err != nil {
+					r.App.GetLogger().Errorw("GraphQL subscription received an invalid notification", "channel", channel, "payload", event.Payload)
+					continue
+				}
+
+				select {
+				case ids <- id:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ids, nil
+}
+
+func optionalJobID(id *graphql.ID) (*int32, error) {
+	if id == nil {
+		return nil, nil
+	}
+
+	jobID, err := stringutils.ToInt32(string(*id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &jobID, nil
+}
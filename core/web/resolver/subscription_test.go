@@ -0,0 +1,135 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pg"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelineMocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
+)
+
+// nextResponse waits for the next response of a subscription.
+func nextResponse(t *testing.T, responses <-chan interface{}) *graphql.Response {
+	t.Helper()
+
+	select {
+	case resp, ok := <-responses:
+		require.True(t, ok, "subscription closed")
+		return resp.(*graphql.Response)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for subscription response")
+		return nil
+	}
+}
+
+func TestResolver_JobRunFinished(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		subscription JobRunFinished {
+			jobRunFinished(jobID: "1") {
+				id
+				status
+			}
+		}`
+
+	t.Run("not authorized", func(t *testing.T) {
+		f := setupFramework(t)
+
+		responses, err := f.RootSchema.Subscribe(f.Ctx, query, "", nil)
+		require.NoError(t, err)
+
+		resp := nextResponse(t, responses)
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "Unauthorized", resp.Errors[0].Message)
+	})
+
+	t.Run("streams finished runs of the job", func(t *testing.T) {
+		f := setupFramework(t)
+		f.injectAuthenticatedUser()
+
+		var hook func(*pipeline.Run)
+		unregistered := make(chan struct{})
+		runner := &pipelineMocks.Runner{}
+		t.Cleanup(func() { runner.AssertExpectations(t) })
+		f.App.On("PipelineRunner").Return(runner)
+		runner.On("OnRunFinished", mock.Anything).
+			Run(func(args mock.Arguments) {
+				hook = args.Get(0).(func(*pipeline.Run))
+			}).
+			Return(func() { close(unregistered) })
+
+		ctx, cancel := context.WithCancel(f.Ctx)
+		defer cancel()
+		responses, err := f.RootSchema.Subscribe(ctx, query, "", nil)
+		require.NoError(t, err)
+		require.NotNil(t, hook)
+
+		// Suspended runs and runs of other jobs are not sent
+		hook(&pipeline.Run{ID: 3, State: pipeline.RunStatusSuspended, Pending: true, PipelineSpec: pipeline.Spec{JobID: 1}})
+		hook(&pipeline.Run{ID: 4, State: pipeline.RunStatusCompleted, PipelineSpec: pipeline.Spec{JobID: 2}})
+		hook(&pipeline.Run{ID: 5, State: pipeline.RunStatusCompleted, PipelineSpec: pipeline.Spec{JobID: 1}})
+
+		resp := nextResponse(t, responses)
+		require.Empty(t, resp.Errors)
+		assert.JSONEq(t, `{"jobRunFinished": {"id": "5", "status": "COMPLETED"}}`, string(resp.Data))
+
+		cancel()
+		select {
+		case <-unregistered:
+		case <-time.After(5 * time.Second):
+			t.Fatal("hook was not unregistered")
+		}
+	})
+}
+
+func TestResolver_JobErrorRecorded(t *testing.T) {
+	t.Parallel()
+
+	f := setupFramework(t)
+	f.injectAuthenticatedUser()
+
+	eventBroadcaster := pg.NewNullEventBroadcaster()
+	f.App.On("GetEventBroadcaster").Return(eventBroadcaster)
+	f.App.On("JobORM").Return(f.Mocks.jobORM)
+	f.Mocks.jobORM.On("FindSpecError", int64(100)).Return(job.SpecError{
+		ID:          100,
+		JobID:       2,
+		Description: "other job",
+	}, nil)
+	f.Mocks.jobORM.On("FindSpecError", int64(200)).Return(job.SpecError{
+		ID:          200,
+		JobID:       1,
+		Description: "no contract code at given address",
+		Occurrences: 2,
+		CreatedAt:   f.Timestamp(),
+		UpdatedAt:   f.Timestamp(),
+	}, nil)
+
+	ctx, cancel := context.WithCancel(f.Ctx)
+	defer cancel()
+	responses, err := f.RootSchema.Subscribe(ctx, `
+		subscription JobErrorRecorded {
+			jobErrorRecorded(jobID: "1") {
+				id
+				description
+				occurrences
+			}
+		}`, "", nil)
+	require.NoError(t, err)
+
+	eventBroadcaster.Sub.Ch <- pg.Event{Channel: pg.ChannelUpsertOnJobSpecError, Payload: "100"}
+	eventBroadcaster.Sub.Ch <- pg.Event{Channel: pg.ChannelUpsertOnJobSpecError, Payload: "200"}
+
+	resp := nextResponse(t, responses)
+	require.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"jobErrorRecorded": {"id": "200", "description": "no contract code at given address", "occurrences": 2}}`, string(resp.Data))
+}
@@ -82,17 +82,23 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 
 	guiAssetRoutes(engine, config, app.GetLogger())
 
+	gqlSchema := graphqlSchema(app)
 	api.POST("/query",
 		auth.AuthenticateGQL(app.SessionORM()),
 		loader.Middleware(app),
-		graphqlHandler(app),
+		graphqlHandler(gqlSchema),
+	)
+	api.GET("/query",
+		auth.AuthenticateGQL(app.SessionORM()),
+		loader.Middleware(app),
+		graphqlSubscriptionHandler(app, gqlSchema),
 	)
 
 	return engine
 }
 
-// Defining the Graphql handler
-func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
+// Defining the Graphql schema
+func graphqlSchema(app chainlink.Application) *graphql.Schema {
 	rootSchema := schema.MustGetRootSchema()
 
 	// Disable introspection and set a max query depth in production.
@@ -103,13 +109,16 @@ func graphqlHandler(app chainlink.Application) gin.HandlerFunc {
 		)
 	}
 
-	schema := graphql.MustParseSchema(rootSchema,
+	return graphql.MustParseSchema(rootSchema,
 		&resolver.Resolver{
 			App: app,
 		},
 		schemaOpts...,
 	)
+}
 
+// Defining the Graphql handler
+func graphqlHandler(schema *graphql.Schema) gin.HandlerFunc {
 	h := relay.Handler{Schema: schema}
 
 	return func(c *gin.Context) {
@@ -243,6 +252,7 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
 		authv2.POST("/external_initiators", eia.Create)
 		authv2.DELETE("/external_initiators/:Name", eia.Destroy)
+		authv2.POST("/external_initiators/:Name/resync", eia.Resync)
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
@@ -254,6 +264,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		ts := TransfersController{app}
 		authv2.POST("/transfers", ts.Create)
 
+		ac := AlertsController{app}
+		authv2.GET("/alerts", ac.Index)
+		authv2.GET("/alerts/silences", ac.IndexSilences)
+		authv2.POST("/alerts/silences", ac.CreateSilence)
+		authv2.DELETE("/alerts/silences/:ID", ac.DestroySilence)
+
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
 		authv2.PATCH("/config", cc.Patch)
@@ -336,10 +352,18 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/jobs", jc.Create)
 		authv2.DELETE("/jobs/:ID", jc.Delete)
 
+		osc := OCRShadowController{app}
+		authv2.GET("/jobs/:ID/shadow_observations", osc.Show)
+
+		bhsc := BlockhashStoreController{app}
+		authv2.GET("/jobs/:ID/blockhash_store/backwards_runs", bhsc.Index)
+		authv2.POST("/jobs/:ID/blockhash_store/backwards_runs", bhsc.Create)
+
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
 		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.POST("/jobs/:ID/runs/:runID/replay", prc.Replay)
 
 		// FeaturesController
 		fc := FeaturesController{app}
@@ -383,6 +407,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.POST("/nodes/terra", tnc.Create)
 		authv2.DELETE("/nodes/terra/:ID", tnc.Delete)
 
+		tmc := TerraMsgsController{app}
+		authv2.GET("/chains/terra/:ID/msgs", paginatedRequest(tmc.Index))
+
 		// Debug routes accessible via authentication
 		metricRoutes(authv2)
 	}
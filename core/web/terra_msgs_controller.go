@@ -0,0 +1,75 @@
+package web
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra/db"
+
+	"github.com/smartcontractkit/chainlink/core/chains/terra/terratxm"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// TerraMsgsController lists the msgs queued by the Terra transaction manager.
+type TerraMsgsController struct {
+	App chainlink.Application
+}
+
+// defaultTerraMsgStates are the states of the msgs which have not been
+// processed to completion yet.
+var defaultTerraMsgStates = []db.State{db.Unstarted, db.Broadcasted}
+
+// Index lists the msgs of a Terra chain, oldest first. By default only
+// unstarted and broadcasted msgs are listed, which can be changed with a comma
+// separated list of states, e.g. ?state=errored,confirmed
+// Example:
+//  "<application>/chains/terra/:ID/msgs?state=unstarted"
+func (mc *TerraMsgsController) Index(c *gin.Context, size, page, offset int) {
+	chainID := c.Param("ID")
+	if _, err := mc.App.TerraORM().Chain(chainID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, fmt.Errorf("Terra chain %s not found", chainID))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	states, err := parseTerraMsgStates(c.Query("state"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	orm := terratxm.NewORM(chainID, mc.App.GetSqlxDB(), mc.App.GetLogger(), mc.App.GetConfig())
+	msgs, count, err := orm.SelectQueuedMsgs(states, offset, size)
+
+	var resources []presenters.TerraMsgResource
+	for _, msg := range msgs {
+		resources = append(resources, presenters.NewTerraMsgResource(msg))
+	}
+
+	paginatedResponse(c, "terra_msg", size, page, resources, count, err)
+}
+
+func parseTerraMsgStates(s string) ([]db.State, error) {
+	if s == "" {
+		return defaultTerraMsgStates, nil
+	}
+	var states []db.State
+	for _, str := range strings.Split(s, ",") {
+		switch state := db.State(strings.TrimSpace(str)); state {
+		case db.Unstarted, db.Broadcasted, db.Confirmed, db.Errored:
+			states = append(states, state)
+		default:
+			return nil, errors.Errorf("invalid state %q, must be one of %s, %s, %s or %s", str, db.Unstarted, db.Broadcasted, db.Confirmed, db.Errored)
+		}
+	}
+	return states, nil
+}
@@ -0,0 +1,70 @@
+package web_test
+
+import (
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-terra/pkg/terra/db"
+
+	"github.com/smartcontractkit/chainlink/core/chains/terra/terratxm"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/terratest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func Test_TerraMsgsController_Index(t *testing.T) {
+	t.Parallel()
+
+	controller := setupTerraChainsControllerTest(t)
+	chainID := fmt.Sprintf("Chainlinktest-%d", rand.Int31n(999999))
+	terratest.MustInsertChain(t, controller.app.GetSqlxDB(), &db.Chain{ID: chainID, Enabled: true})
+
+	orm := terratxm.NewORM(chainID, controller.app.GetSqlxDB(), logger.TestLogger(t), controller.app.GetConfig())
+	unstarted, err := orm.InsertMsg("contract", []byte{0x01})
+	require.NoError(t, err)
+	errored, err := orm.InsertMsg("contract", []byte{0x02})
+	require.NoError(t, err)
+	require.NoError(t, orm.UpdateMsgsWithState([]int64{errored}, db.Errored, nil))
+
+	for _, tt := range []struct {
+		query string
+		want  []int64
+	}{
+		{"", []int64{unstarted}},
+		{"?state=errored", []int64{errored}},
+		{"?state=unstarted,errored", []int64{unstarted, errored}},
+	} {
+		resp, cleanup := controller.client.Get(fmt.Sprintf("/v2/chains/terra/%s/msgs%s", chainID, tt.query))
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var msgs []presenters.TerraMsgResource
+		var links jsonapi.Links
+		require.NoError(t, web.ParsePaginatedResponse(cltest.ParseResponseBody(t, resp), &msgs, &links))
+		var ids []int64
+		for _, m := range msgs {
+			assert.Equal(t, chainID, m.TerraChainID)
+			id, err := strconv.ParseInt(m.ID, 10, 64)
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		assert.Equal(t, tt.want, ids, tt.query)
+	}
+
+	resp, cleanup := controller.client.Get(fmt.Sprintf("/v2/chains/terra/%s/msgs?state=pending", chainID))
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	resp, cleanup = controller.client.Get("/v2/chains/terra/missing/msgs")
+	t.Cleanup(cleanup)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}